@@ -0,0 +1,82 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	blogv1 "github.com/BhaveetKumar/gRPC-server-go/proto/blog/v1"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func runIssueKey(ctx context.Context, client blogv1.BlogServiceClient, args []string) {
+	fs := flag.NewFlagSet("issue-key", flag.ExitOnError)
+	name := fs.String("name", "", "what the key is for")
+	owner := fs.String("owner", "", "caller the key acts as")
+	scopes := fs.String("scopes", "", "comma separated scopes: posts:read, posts:write, admin")
+	ttl := fs.Duration("ttl", 0, "how long the key is valid; 0 never expires")
+	_ = fs.Parse(args)
+
+	req := &blogv1.IssueApiKeyRequest{Name: *name, Owner: *owner, Scopes: splitList(*scopes)}
+	if *ttl > 0 {
+		req.Ttl = durationpb.New(*ttl)
+	}
+	resp, err := client.IssueApiKey(ctx, req)
+	if err != nil {
+		log.Fatalf("issue-key failed: %v", err)
+	}
+
+	// The key is only shown this once.
+	fmt.Printf("issued key %s; keep it safe, it cannot be shown again:\n%s\n", resp.GetApiKey().GetKeyId(), resp.GetRawKey())
+}
+
+func runListKeys(ctx context.Context, client blogv1.BlogServiceClient, args []string) {
+	fs := flag.NewFlagSet("keys", flag.ExitOnError)
+	pageSize := fs.Int("page-size", 0, "maximum number of keys to return")
+	pageToken := fs.String("page-token", "", "token from a previous keys call")
+	_ = fs.Parse(args)
+
+	resp, err := client.ListApiKeys(ctx, &blogv1.ListApiKeysRequest{PageSize: int32(*pageSize), PageToken: *pageToken})
+	if err != nil {
+		log.Fatalf("keys failed: %v", err)
+	}
+
+	for _, key := range resp.GetApiKeys() {
+		state := "active"
+		switch {
+		case key.GetRevokeTime() != nil:
+			state = "revoked " + formatKeyTime(key.GetRevokeTime())
+		case key.GetExpireTime() != nil && key.GetExpireTime().AsTime().Before(time.Now()):
+			state = "expired " + formatKeyTime(key.GetExpireTime())
+		}
+		fmt.Printf("%s  %-20s  %-12s  %-30s  last used %s  %s\n", key.GetKeyId(), key.GetName(), key.GetOwner(),
+			strings.Join(key.GetScopes(), ","), formatKeyTime(key.GetLastUsedTime()), state)
+	}
+	if resp.GetNextPageToken() != "" {
+		fmt.Printf("next page token: %s\n", resp.GetNextPageToken())
+	}
+}
+
+func runRevokeKey(ctx context.Context, client blogv1.BlogServiceClient, args []string) {
+	fs := flag.NewFlagSet("revoke-key", flag.ExitOnError)
+	id := fs.String("id", "", "key id")
+	_ = fs.Parse(args)
+
+	resp, err := client.RevokeApiKey(ctx, &blogv1.RevokeApiKeyRequest{KeyId: *id})
+	if err != nil {
+		log.Fatalf("revoke-key failed: %v", err)
+	}
+
+	fmt.Printf("revoked key %s at %s\n", resp.GetApiKey().GetKeyId(), formatKeyTime(resp.GetApiKey().GetRevokeTime()))
+}
+
+func formatKeyTime(ts *timestamppb.Timestamp) string {
+	if ts == nil {
+		return "never"
+	}
+	return ts.AsTime().Local().Format(time.RFC3339)
+}
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+
+	blogv1 "github.com/BhaveetKumar/gRPC-server-go/proto/blog/v1"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func runCreateAuthor(ctx context.Context, client blogv1.BlogServiceClient, args []string) {
+	fs := flag.NewFlagSet("create-author", flag.ExitOnError)
+	name := fs.String("name", "", "author name")
+	bio := fs.String("bio", "", "short biography")
+	avatar := fs.String("avatar", "", "avatar image URL")
+	_ = fs.Parse(args)
+
+	resp, err := client.CreateAuthor(ctx, &blogv1.CreateAuthorRequest{Name: *name, Bio: *bio, AvatarUrl: *avatar})
+	if err != nil {
+		log.Fatalf("create-author failed: %v", err)
+	}
+
+	fmt.Printf("created author: %+v\n", resp.GetAuthor())
+}
+
+func runGetAuthor(ctx context.Context, client blogv1.BlogServiceClient, args []string) {
+	fs := flag.NewFlagSet("author", flag.ExitOnError)
+	id := fs.String("id", "", "author id")
+	_ = fs.Parse(args)
+
+	resp, err := client.GetAuthor(ctx, &blogv1.GetAuthorRequest{AuthorId: *id})
+	if err != nil {
+		log.Fatalf("author failed: %v", err)
+	}
+
+	fmt.Printf("author: %+v\n", resp.GetAuthor())
+}
+
+func runUpdateAuthor(ctx context.Context, client blogv1.BlogServiceClient, args []string) {
+	fs := flag.NewFlagSet("update-author", flag.ExitOnError)
+	id := fs.String("id", "", "author id")
+	name := fs.String("name", "", "author name; renaming also renames the bylines of their posts")
+	bio := fs.String("bio", "", "short biography")
+	avatar := fs.String("avatar", "", "avatar image URL")
+	mask := fs.String("mask", "", "comma separated fields to update (name, bio, avatar_url)")
+	etag := fs.String("etag", "", "only update if the author still has this etag")
+	_ = fs.Parse(args)
+
+	req := &blogv1.UpdateAuthorRequest{AuthorId: *id, Name: *name, Bio: *bio, AvatarUrl: *avatar, Etag: *etag}
+	if *mask != "" {
+		req.UpdateMask = &fieldmaskpb.FieldMask{Paths: splitList(*mask)}
+	}
+
+	resp, err := client.UpdateAuthor(ctx, req)
+	if err != nil {
+		log.Fatalf("update-author failed: %v", err)
+	}
+
+	fmt.Printf("updated author: %+v\n", resp.GetAuthor())
+}
+
+func runDeleteAuthor(ctx context.Context, client blogv1.BlogServiceClient, args []string) {
+	fs := flag.NewFlagSet("delete-author", flag.ExitOnError)
+	id := fs.String("id", "", "author id")
+	etag := fs.String("etag", "", "only delete if the author still has this etag")
+	_ = fs.Parse(args)
+
+	if _, err := client.DeleteAuthor(ctx, &blogv1.DeleteAuthorRequest{AuthorId: *id, Etag: *etag}); err != nil {
+		log.Fatalf("delete-author failed: %v", err)
+	}
+
+	fmt.Println("deleted author")
+}
+
+func runListAuthors(ctx context.Context, client blogv1.BlogServiceClient, args []string) {
+	fs := flag.NewFlagSet("authors", flag.ExitOnError)
+	pageSize := fs.Int("page-size", 0, "maximum number of authors to return")
+	pageToken := fs.String("page-token", "", "token from a previous authors call")
+	_ = fs.Parse(args)
+
+	resp, err := client.ListAuthors(ctx, &blogv1.ListAuthorsRequest{PageSize: int32(*pageSize), PageToken: *pageToken})
+	if err != nil {
+		log.Fatalf("authors failed: %v", err)
+	}
+
+	for _, author := range resp.GetAuthors() {
+		fmt.Printf("%s  %s\n", author.GetAuthorId(), author.GetName())
+	}
+	if resp.GetNextPageToken() != "" {
+		fmt.Printf("next page token: %s\n", resp.GetNextPageToken())
+	}
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	blogv1 "github.com/BhaveetKumar/gRPC-server-go/proto/blog/v1"
+)
+
+func runComment(ctx context.Context, client blogv1.BlogServiceClient, args []string) {
+	fs := flag.NewFlagSet("comment", flag.ExitOnError)
+	postID := fs.String("post", "", "post id")
+	parentID := fs.String("parent", "", "comment id to reply to")
+	author := fs.String("author", "", "comment author")
+	content := fs.String("content", "", "comment text")
+	_ = fs.Parse(args)
+
+	req := &blogv1.AddCommentRequest{
+		PostId:   *postID,
+		ParentId: *parentID,
+		Author:   *author,
+		Content:  *content,
+	}
+
+	resp, err := client.AddComment(ctx, req)
+	if err != nil {
+		log.Fatalf("comment failed: %v", err)
+	}
+
+	fmt.Printf("added comment: %+v\n", resp.GetComment())
+}
+
+func runComments(ctx context.Context, client blogv1.BlogServiceClient, args []string) {
+	fs := flag.NewFlagSet("comments", flag.ExitOnError)
+	postID := fs.String("post", "", "post id")
+	parentID := fs.String("parent", "", "only list the replies below this comment")
+	pageSize := fs.Int("page-size", 0, "maximum number of comments to return")
+	pageToken := fs.String("page-token", "", "token from a previous comments call")
+	_ = fs.Parse(args)
+
+	req := &blogv1.ListCommentsRequest{
+		PostId:    *postID,
+		ParentId:  *parentID,
+		PageSize:  int32(*pageSize),
+		PageToken: *pageToken,
+	}
+
+	resp, err := client.ListComments(ctx, req)
+	if err != nil {
+		log.Fatalf("comments failed: %v", err)
+	}
+
+	for _, comment := range resp.GetComments() {
+		indent := strings.Repeat("  ", int(comment.GetDepth()))
+		if comment.GetDeleted() {
+			fmt.Printf("%s[deleted] %s\n", indent, comment.GetCommentId())
+			continue
+		}
+		fmt.Printf("%s%s  %s  %s\n", indent, comment.GetCommentId(), comment.GetAuthor(),
+			comment.GetCreateTime().AsTime().Format(time.RFC3339))
+		for _, line := range strings.Split(comment.GetContent(), "\n") {
+			fmt.Printf("%s  %s\n", indent, line)
+		}
+	}
+	if resp.GetNextPageToken() != "" {
+		fmt.Printf("next page token: %s\n", resp.GetNextPageToken())
+	}
+}
+
+func runEditComment(ctx context.Context, client blogv1.BlogServiceClient, args []string) {
+	fs := flag.NewFlagSet("edit-comment", flag.ExitOnError)
+	id := fs.String("id", "", "comment id")
+	content := fs.String("content", "", "new comment text")
+	etag := fs.String("etag", "", "only edit if the comment still has this etag")
+	_ = fs.Parse(args)
+
+	resp, err := client.EditComment(ctx, &blogv1.EditCommentRequest{CommentId: *id, Content: *content, Etag: *etag})
+	if err != nil {
+		log.Fatalf("edit-comment failed: %v", err)
+	}
+
+	fmt.Printf("edited comment: %+v\n", resp.GetComment())
+}
+
+func runDeleteComment(ctx context.Context, client blogv1.BlogServiceClient, args []string) {
+	fs := flag.NewFlagSet("delete-comment", flag.ExitOnError)
+	id := fs.String("id", "", "comment id")
+	etag := fs.String("etag", "", "only delete if the comment still has this etag")
+	_ = fs.Parse(args)
+
+	if _, err := client.DeleteComment(ctx, &blogv1.DeleteCommentRequest{CommentId: *id, Etag: *etag}); err != nil {
+		log.Fatalf("delete-comment failed: %v", err)
+	}
+
+	fmt.Println("deleted comment")
+}
@@ -0,0 +1,168 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	blogv1 "github.com/BhaveetKumar/gRPC-server-go/proto/blog/v1"
+)
+
+const (
+	ansiReset   = "\x1b[0m"
+	ansiRed     = "\x1b[31m"
+	ansiGreen   = "\x1b[32m"
+	ansiCyan    = "\x1b[36m"
+	ansiBold    = "\x1b[1m"
+	ansiReverse = "\x1b[7m"
+)
+
+// palette colours diff output; the zero value prints plain text.
+type palette struct {
+	enabled bool
+}
+
+func (p palette) paint(color, text string) string {
+	if !p.enabled || text == "" {
+		return text
+	}
+	return color + text + ansiReset
+}
+
+func runDiff(ctx context.Context, client blogv1.BlogServiceClient, args []string) {
+	fs := flag.NewFlagSet("diff", flag.ExitOnError)
+	id := fs.String("id", "", "post id")
+	from := fs.Int64("from", 0, "older revision")
+	to := fs.Int64("to", 0, "newer revision, 0 for the newest")
+	format := fs.String("format", "hunks", "content diff format: hunks or unified")
+	contextLines := fs.Int("context", 0, "unchanged lines around each change, 0 for the server default")
+	color := fs.String("color", "auto", "colorize output: auto, always or never")
+	_ = fs.Parse(args)
+
+	var diffFormat blogv1.DiffFormat
+	switch *format {
+	case "hunks":
+		diffFormat = blogv1.DiffFormat_DIFF_FORMAT_HUNKS
+	case "unified":
+		diffFormat = blogv1.DiffFormat_DIFF_FORMAT_UNIFIED
+	default:
+		log.Fatalf("unknown format: %s", *format)
+	}
+
+	var p palette
+	switch *color {
+	case "auto":
+		p.enabled = isTerminal(os.Stdout) && os.Getenv("NO_COLOR") == ""
+	case "always":
+		p.enabled = true
+	case "never":
+	default:
+		log.Fatalf("unknown color mode: %s", *color)
+	}
+
+	req := &blogv1.DiffPostRevisionsRequest{
+		PostId:       *id,
+		FromRevision: *from,
+		ToRevision:   *to,
+		Format:       diffFormat,
+		ContextLines: int32(*contextLines),
+	}
+	resp, err := client.DiffPostRevisions(ctx, req)
+	if err != nil {
+		log.Fatalf("diff failed: %v", err)
+	}
+
+	fmt.Print(renderDiff(resp, p))
+}
+
+func isTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
+
+func renderDiff(resp *blogv1.DiffPostRevisionsResponse, p palette) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s\n", p.paint(ansiBold, fmt.Sprintf("post %s: revision %d -> %d", resp.GetPostId(), resp.GetFromRevision(), resp.GetToRevision())))
+
+	for _, field := range resp.GetFields() {
+		fmt.Fprintf(&b, "%s:\n", field.GetField())
+		fmt.Fprintf(&b, "%s\n", p.paint(ansiRed, "- "+field.GetOldValue()))
+		fmt.Fprintf(&b, "%s\n", p.paint(ansiGreen, "+ "+field.GetNewValue()))
+	}
+
+	if len(resp.GetAddedTags()) > 0 || len(resp.GetRemovedTags()) > 0 {
+		b.WriteString("tags:")
+		for _, tag := range resp.GetRemovedTags() {
+			b.WriteString(" " + p.paint(ansiRed, "-"+tag))
+		}
+		for _, tag := range resp.GetAddedTags() {
+			b.WriteString(" " + p.paint(ansiGreen, "+"+tag))
+		}
+		b.WriteString("\n")
+	}
+
+	if unified := resp.GetUnifiedDiff(); unified != "" {
+		for _, line := range strings.SplitAfter(unified, "\n") {
+			b.WriteString(paintUnifiedLine(line, p))
+		}
+		return b.String()
+	}
+
+	for _, hunk := range resp.GetHunks() {
+		header := fmt.Sprintf("@@ -%d,%d +%d,%d @@", hunk.GetOldStart(), hunk.GetOldLines(), hunk.GetNewStart(), hunk.GetNewLines())
+		fmt.Fprintf(&b, "%s\n", p.paint(ansiCyan, header))
+		for _, line := range hunk.GetLines() {
+			fmt.Fprintf(&b, "%s\n", renderLine(line, p))
+		}
+	}
+	return b.String()
+}
+
+func paintUnifiedLine(line string, p palette) string {
+	text := strings.TrimSuffix(line, "\n")
+	suffix := line[len(text):]
+	switch {
+	case strings.HasPrefix(text, "+++"), strings.HasPrefix(text, "---"):
+		return p.paint(ansiBold, text) + suffix
+	case strings.HasPrefix(text, "@@"):
+		return p.paint(ansiCyan, text) + suffix
+	case strings.HasPrefix(text, "+"):
+		return p.paint(ansiGreen, text) + suffix
+	case strings.HasPrefix(text, "-"):
+		return p.paint(ansiRed, text) + suffix
+	default:
+		return line
+	}
+}
+
+// renderLine prints a hunk line with its marker. Changed words within a
+// replaced line are shown in reverse video.
+func renderLine(line *blogv1.DiffLine, p palette) string {
+	var marker, color string
+	switch line.GetOp() {
+	case blogv1.DiffOp_DIFF_OP_INSERT:
+		marker, color = "+", ansiGreen
+	case blogv1.DiffOp_DIFF_OP_DELETE:
+		marker, color = "-", ansiRed
+	default:
+		return " " + line.GetText()
+	}
+
+	if len(line.GetWords()) == 0 || !p.enabled {
+		return p.paint(color, marker+line.GetText())
+	}
+
+	var b strings.Builder
+	b.WriteString(p.paint(color, marker))
+	for _, word := range line.GetWords() {
+		if word.GetOp() == blogv1.DiffOp_DIFF_OP_EQUAL {
+			b.WriteString(p.paint(color, word.GetText()))
+		} else {
+			b.WriteString(p.paint(color+ansiReverse, word.GetText()))
+		}
+	}
+	return b.String()
+}
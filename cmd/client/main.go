@@ -4,19 +4,28 @@ import (
 	"context"
 	"flag"
 	"fmt"
+	"io"
 	"log"
 	"os"
+	"strings"
 	"time"
 
+	"github.com/BhaveetKumar/gRPC-server-go/internal/clock"
 	"github.com/BhaveetKumar/gRPC-server-go/internal/config"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/tlsconfig"
 	blogv1 "github.com/BhaveetKumar/gRPC-server-go/proto/blog/v1"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func main() {
 	if len(os.Args) < 2 {
 		log.Println("usage: client <command> [flags]")
-		log.Println("commands: create, get, update, delete")
+		log.Println("commands: create, get, update, delete, undelete, purge, publish, unpublish, archive, schedule, pending, approve, reject, list, watch, search, revisions, revision, restore, diff, comment, comments, edit-comment, delete-comment, create-author, author, update-author, delete-author, authors, tags, rename-tag, merge-tags, issue-key, keys, revoke-key")
 		os.Exit(1)
 	}
 
@@ -30,7 +39,21 @@ func main() {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(cfg.Client.TimeoutSeconds)*time.Second)
 	defer cancel()
 
-	conn, err := grpc.DialContext(ctx, cfg.Client.ServerAddress, grpc.WithInsecure(), grpc.WithBlock())
+	if cfg.Client.Author != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "x-author", cfg.Client.Author)
+	}
+	if cfg.Client.Token != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+cfg.Client.Token)
+	} else if cfg.Client.APIKey != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "x-api-key", cfg.Client.APIKey)
+	}
+
+	creds, err := transportCredentials(cfg.Client)
+	if err != nil {
+		log.Fatalf("failed to set up tls: %v", err)
+	}
+
+	conn, err := grpc.DialContext(ctx, cfg.Client.ServerAddress, grpc.WithTransportCredentials(creds), grpc.WithBlock())
 	if err != nil {
 		log.Fatalf("failed to connect to server: %v", err)
 	}
@@ -47,6 +70,68 @@ func main() {
 		runUpdate(ctx, client, os.Args[2:])
 	case "delete":
 		runDelete(ctx, client, os.Args[2:])
+	case "undelete":
+		runUndelete(ctx, client, os.Args[2:])
+	case "purge":
+		runPurge(ctx, client, os.Args[2:])
+	case "publish":
+		runPublish(ctx, client, os.Args[2:])
+	case "unpublish":
+		runUnpublish(ctx, client, os.Args[2:])
+	case "archive":
+		runArchive(ctx, client, os.Args[2:])
+	case "schedule":
+		runSchedule(ctx, client, os.Args[2:])
+	case "pending":
+		runPending(ctx, client, os.Args[2:])
+	case "approve":
+		runApprove(ctx, client, os.Args[2:])
+	case "reject":
+		runReject(ctx, client, os.Args[2:])
+	case "list":
+		runList(ctx, client, os.Args[2:])
+	case "watch":
+		runWatch(context.WithoutCancel(ctx), client, os.Args[2:])
+	case "search":
+		runSearch(ctx, client, os.Args[2:])
+	case "revisions":
+		runRevisions(ctx, client, os.Args[2:])
+	case "revision":
+		runRevision(ctx, client, os.Args[2:])
+	case "restore":
+		runRestore(ctx, client, os.Args[2:])
+	case "diff":
+		runDiff(ctx, client, os.Args[2:])
+	case "comment":
+		runComment(ctx, client, os.Args[2:])
+	case "comments":
+		runComments(ctx, client, os.Args[2:])
+	case "edit-comment":
+		runEditComment(ctx, client, os.Args[2:])
+	case "delete-comment":
+		runDeleteComment(ctx, client, os.Args[2:])
+	case "create-author":
+		runCreateAuthor(ctx, client, os.Args[2:])
+	case "author":
+		runGetAuthor(ctx, client, os.Args[2:])
+	case "update-author":
+		runUpdateAuthor(ctx, client, os.Args[2:])
+	case "delete-author":
+		runDeleteAuthor(ctx, client, os.Args[2:])
+	case "authors":
+		runListAuthors(ctx, client, os.Args[2:])
+	case "tags":
+		runListTags(ctx, client, os.Args[2:])
+	case "rename-tag":
+		runRenameTag(ctx, client, os.Args[2:])
+	case "merge-tags":
+		runMergeTags(ctx, client, os.Args[2:])
+	case "issue-key":
+		runIssueKey(ctx, client, os.Args[2:])
+	case "keys":
+		runListKeys(ctx, client, os.Args[2:])
+	case "revoke-key":
+		runRevokeKey(ctx, client, os.Args[2:])
 	default:
 		log.Fatalf("unknown command: %s", command)
 	}
@@ -57,7 +142,8 @@ func runCreate(ctx context.Context, client blogv1.BlogServiceClient, args []stri
 	title := fs.String("title", "", "post title")
 	content := fs.String("content", "", "post content")
 	author := fs.String("author", "", "post author")
-	date := fs.String("date", "", "publication date")
+	authorID := fs.String("author-id", "", "post author by id, instead of -author")
+	date := fs.String("date", "", "publication date, YYYY-MM-DD or RFC 3339")
 	tags := fs.String("tags", "", "comma separated tags")
 	_ = fs.Parse(args)
 
@@ -65,8 +151,9 @@ func runCreate(ctx context.Context, client blogv1.BlogServiceClient, args []stri
 		Title:           *title,
 		Content:         *content,
 		Author:          *author,
+		AuthorId:        *authorID,
 		PublicationDate: *date,
-		Tags:            splitTags(*tags),
+		Tags:            splitList(*tags),
 	}
 
 	resp, err := client.CreatePost(ctx, req)
@@ -80,8 +167,21 @@ func runCreate(ctx context.Context, client blogv1.BlogServiceClient, args []stri
 func runGet(ctx context.Context, client blogv1.BlogServiceClient, args []string) {
 	fs := flag.NewFlagSet("get", flag.ExitOnError)
 	id := fs.String("id", "", "post id")
+	slug := fs.String("slug", "", "post slug, current or former, instead of -id")
 	_ = fs.Parse(args)
 
+	if *slug != "" {
+		resp, err := client.GetPostBySlug(ctx, &blogv1.GetPostBySlugRequest{Slug: *slug})
+		if err != nil {
+			log.Fatalf("get failed: %v", err)
+		}
+		if resp.GetRedirect() {
+			fmt.Printf("%s moved to %s\n", *slug, resp.GetPost().GetSlug())
+		}
+		fmt.Printf("post: %+v\n", resp.GetPost())
+		return
+	}
+
 	req := &blogv1.GetPostRequest{PostId: *id}
 	resp, err := client.GetPost(ctx, req)
 	if err != nil {
@@ -97,15 +197,32 @@ func runUpdate(ctx context.Context, client blogv1.BlogServiceClient, args []stri
 	title := fs.String("title", "", "post title")
 	content := fs.String("content", "", "post content")
 	author := fs.String("author", "", "post author")
+	authorID := fs.String("author-id", "", "post author by id, instead of -author")
+	date := fs.String("date", "", "publication date")
 	tags := fs.String("tags", "", "comma separated tags")
+	status := fs.String("status", "", "post status: draft, in_review, scheduled, published, archived")
+	mask := fs.String("mask", "", "comma separated fields to update (title, content, author, publication_date, tags, status)")
+	etag := fs.String("etag", "", "only update if the post still has this etag")
 	_ = fs.Parse(args)
 
+	postStatus, ok := parseStatus(*status)
+	if !ok {
+		log.Fatalf("unknown status: %s", *status)
+	}
+
 	req := &blogv1.UpdatePostRequest{
-		PostId:  *id,
-		Title:   *title,
-		Content: *content,
-		Author:  *author,
-		Tags:    splitTags(*tags),
+		PostId:          *id,
+		Title:           *title,
+		Content:         *content,
+		Author:          *author,
+		AuthorId:        *authorID,
+		PublicationDate: *date,
+		Tags:            splitList(*tags),
+		Etag:            *etag,
+		Status:          postStatus,
+	}
+	if *mask != "" {
+		req.UpdateMask = &fieldmaskpb.FieldMask{Paths: splitList(*mask)}
 	}
 
 	resp, err := client.UpdatePost(ctx, req)
@@ -119,9 +236,10 @@ func runUpdate(ctx context.Context, client blogv1.BlogServiceClient, args []stri
 func runDelete(ctx context.Context, client blogv1.BlogServiceClient, args []string) {
 	fs := flag.NewFlagSet("delete", flag.ExitOnError)
 	id := fs.String("id", "", "post id")
+	etag := fs.String("etag", "", "only delete if the post still has this etag")
 	_ = fs.Parse(args)
 
-	req := &blogv1.DeletePostRequest{PostId: *id}
+	req := &blogv1.DeletePostRequest{PostId: *id, Etag: *etag}
 	resp, err := client.DeletePost(ctx, req)
 	if err != nil {
 		log.Fatalf("delete failed: %v", err)
@@ -130,26 +248,322 @@ func runDelete(ctx context.Context, client blogv1.BlogServiceClient, args []stri
 	fmt.Printf("delete success: %v\n", resp.GetSuccess())
 }
 
-func splitTags(raw string) []string {
-	if raw == "" {
-		return nil
+func runUndelete(ctx context.Context, client blogv1.BlogServiceClient, args []string) {
+	fs := flag.NewFlagSet("undelete", flag.ExitOnError)
+	id := fs.String("id", "", "post id")
+	etag := fs.String("etag", "", "only restore if the post still has this etag")
+	_ = fs.Parse(args)
+
+	resp, err := client.RestorePost(ctx, &blogv1.RestorePostRequest{PostId: *id, Etag: *etag})
+	if err != nil {
+		log.Fatalf("undelete failed: %v", err)
 	}
 
-	var result []string
-	current := ""
-	for i := 0; i < len(raw); i++ {
-		if raw[i] == ',' {
-			if current != "" {
-				result = append(result, current)
-				current = ""
-			}
-			continue
+	fmt.Printf("restored post: %+v\n", resp.GetPost())
+}
+
+func runPurge(ctx context.Context, client blogv1.BlogServiceClient, args []string) {
+	fs := flag.NewFlagSet("purge", flag.ExitOnError)
+	id := fs.String("id", "", "post id")
+	etag := fs.String("etag", "", "only purge if the post still has this etag")
+	_ = fs.Parse(args)
+
+	if _, err := client.PurgePost(ctx, &blogv1.PurgePostRequest{PostId: *id, Etag: *etag}); err != nil {
+		log.Fatalf("purge failed: %v", err)
+	}
+
+	fmt.Println("purged post")
+}
+
+func runPublish(ctx context.Context, client blogv1.BlogServiceClient, args []string) {
+	fs := flag.NewFlagSet("publish", flag.ExitOnError)
+	id := fs.String("id", "", "post id")
+	etag := fs.String("etag", "", "only publish if the post still has this etag")
+	_ = fs.Parse(args)
+
+	resp, err := client.PublishPost(ctx, &blogv1.PublishPostRequest{PostId: *id, Etag: *etag})
+	if err != nil {
+		log.Fatalf("publish failed: %v", err)
+	}
+
+	fmt.Printf("published post: %+v\n", resp.GetPost())
+}
+
+func runUnpublish(ctx context.Context, client blogv1.BlogServiceClient, args []string) {
+	fs := flag.NewFlagSet("unpublish", flag.ExitOnError)
+	id := fs.String("id", "", "post id")
+	etag := fs.String("etag", "", "only unpublish if the post still has this etag")
+	_ = fs.Parse(args)
+
+	resp, err := client.UnpublishPost(ctx, &blogv1.UnpublishPostRequest{PostId: *id, Etag: *etag})
+	if err != nil {
+		log.Fatalf("unpublish failed: %v", err)
+	}
+
+	fmt.Printf("unpublished post: %+v\n", resp.GetPost())
+}
+
+func runArchive(ctx context.Context, client blogv1.BlogServiceClient, args []string) {
+	fs := flag.NewFlagSet("archive", flag.ExitOnError)
+	id := fs.String("id", "", "post id")
+	etag := fs.String("etag", "", "only archive if the post still has this etag")
+	_ = fs.Parse(args)
+
+	resp, err := client.ArchivePost(ctx, &blogv1.ArchivePostRequest{PostId: *id, Etag: *etag})
+	if err != nil {
+		log.Fatalf("archive failed: %v", err)
+	}
+
+	fmt.Printf("archived post: %+v\n", resp.GetPost())
+}
+
+func runSchedule(ctx context.Context, client blogv1.BlogServiceClient, args []string) {
+	fs := flag.NewFlagSet("schedule", flag.ExitOnError)
+	id := fs.String("id", "", "post id")
+	at := fs.String("at", "", "when to publish, as an RFC 3339 timestamp")
+	etag := fs.String("etag", "", "only schedule if the post still has this etag")
+	_ = fs.Parse(args)
+
+	publishAt, err := time.Parse(time.RFC3339, *at)
+	if err != nil {
+		log.Fatalf("invalid -at: %v", err)
+	}
+
+	req := &blogv1.SchedulePostRequest{PostId: *id, PublishAt: timestamppb.New(publishAt), Etag: *etag}
+	resp, err := client.SchedulePost(ctx, req)
+	if err != nil {
+		log.Fatalf("schedule failed: %v", err)
+	}
+
+	fmt.Printf("scheduled post: %+v\n", resp.GetPost())
+}
+
+func runList(ctx context.Context, client blogv1.BlogServiceClient, args []string) {
+	fs := flag.NewFlagSet("list", flag.ExitOnError)
+	pageSize := fs.Int("page-size", 0, "maximum number of posts to return")
+	pageToken := fs.String("page-token", "", "token from a previous list call")
+	author := fs.String("author", "", "only posts by this author")
+	authorID := fs.String("author-id", "", "only posts by the author with this id")
+	tag := fs.String("tag", "", "only posts with this tag")
+	after := fs.String("after", "", "only posts published on or after this date")
+	before := fs.String("before", "", "only posts published on or before this date")
+	order := fs.String("order", "date_desc", "sort order: date_desc, date_asc, title_asc, title_desc, id_asc, id_desc")
+	status := fs.String("status", "", "only posts with this status")
+	deleted := fs.Bool("deleted", false, "also list your posts that are in the trash")
+	_ = fs.Parse(args)
+
+	orderBy, ok := parseOrder(*order)
+	if !ok {
+		log.Fatalf("unknown order: %s", *order)
+	}
+	postStatus, ok := parseStatus(*status)
+	if !ok {
+		log.Fatalf("unknown status: %s", *status)
+	}
+
+	req := &blogv1.ListPostsRequest{
+		PageSize:        int32(*pageSize),
+		PageToken:       *pageToken,
+		Author:          *author,
+		AuthorId:        *authorID,
+		Tag:             *tag,
+		PublishedAfter:  *after,
+		PublishedBefore: *before,
+		OrderBy:         orderBy,
+		Status:          postStatus,
+		ShowDeleted:     *deleted,
+	}
+
+	resp, err := client.ListPosts(ctx, req)
+	if err != nil {
+		log.Fatalf("list failed: %v", err)
+	}
+
+	for _, post := range resp.GetPosts() {
+		fmt.Printf("post: %+v\n", post)
+	}
+	if resp.GetNextPageToken() != "" {
+		fmt.Printf("next page token: %s\n", resp.GetNextPageToken())
+	}
+}
+
+func runWatch(ctx context.Context, client blogv1.BlogServiceClient, args []string) {
+	fs := flag.NewFlagSet("watch", flag.ExitOnError)
+	author := fs.String("author", "", "only events for posts by this author")
+	tag := fs.String("tag", "", "only events for posts with this tag")
+	resume := fs.String("resume", "", "resume token of the last event received")
+	_ = fs.Parse(args)
+
+	req := &blogv1.WatchPostsRequest{
+		Author:      *author,
+		Tag:         *tag,
+		ResumeToken: *resume,
+	}
+
+	stream, err := client.WatchPosts(ctx, req)
+	if err != nil {
+		log.Fatalf("watch failed: %v", err)
+	}
+
+	for {
+		event, err := stream.Recv()
+		if err == io.EOF {
+			return
+		}
+		if err != nil {
+			log.Fatalf("watch failed: %v", err)
+		}
+
+		fmt.Printf("event %s [resume token %s]: %+v\n", event.GetType(), event.GetResumeToken(), event.GetPost())
+	}
+}
+
+func runSearch(ctx context.Context, client blogv1.BlogServiceClient, args []string) {
+	fs := flag.NewFlagSet("search", flag.ExitOnError)
+	query := fs.String("q", "", "search query, e.g. 'grpc AND (stream* OR \"server push\")'")
+	pageSize := fs.Int("page-size", 0, "maximum number of results to return")
+	pageToken := fs.String("page-token", "", "token from a previous search call")
+	_ = fs.Parse(args)
+
+	req := &blogv1.SearchPostsRequest{
+		Query:     *query,
+		PageSize:  int32(*pageSize),
+		PageToken: *pageToken,
+	}
+
+	resp, err := client.SearchPosts(ctx, req)
+	if err != nil {
+		log.Fatalf("search failed: %v", err)
+	}
+
+	for _, result := range resp.GetResults() {
+		fmt.Printf("%.3f %s %q\n  %s\n", result.GetScore(), result.GetPost().GetPostId(), result.GetPost().GetTitle(), result.GetSnippet())
+	}
+	if resp.GetNextPageToken() != "" {
+		fmt.Printf("next page token: %s\n", resp.GetNextPageToken())
+	}
+}
+
+func runRevisions(ctx context.Context, client blogv1.BlogServiceClient, args []string) {
+	fs := flag.NewFlagSet("revisions", flag.ExitOnError)
+	id := fs.String("id", "", "post id")
+	pageSize := fs.Int("page-size", 0, "maximum number of revisions to return")
+	pageToken := fs.String("page-token", "", "token from a previous revisions call")
+	_ = fs.Parse(args)
+
+	req := &blogv1.ListPostRevisionsRequest{
+		PostId:    *id,
+		PageSize:  int32(*pageSize),
+		PageToken: *pageToken,
+	}
+
+	resp, err := client.ListPostRevisions(ctx, req)
+	if err != nil {
+		log.Fatalf("revisions failed: %v", err)
+	}
+
+	for _, rev := range resp.GetRevisions() {
+		editor := rev.GetEditor()
+		if editor == "" {
+			editor = "-"
 		}
-		current += string(raw[i])
+		fmt.Printf("revision %d  %s  %s  changed: %s\n", rev.GetRevision(),
+			rev.GetCreateTime().AsTime().Format(time.RFC3339), editor, strings.Join(rev.GetChangedFields(), ","))
+	}
+	if resp.GetNextPageToken() != "" {
+		fmt.Printf("next page token: %s\n", resp.GetNextPageToken())
 	}
-	if current != "" {
-		result = append(result, current)
+}
+
+func runRevision(ctx context.Context, client blogv1.BlogServiceClient, args []string) {
+	fs := flag.NewFlagSet("revision", flag.ExitOnError)
+	id := fs.String("id", "", "post id")
+	revision := fs.Int64("rev", 0, "revision number")
+	_ = fs.Parse(args)
+
+	resp, err := client.GetPostRevision(ctx, &blogv1.GetPostRevisionRequest{PostId: *id, Revision: *revision})
+	if err != nil {
+		log.Fatalf("revision failed: %v", err)
+	}
+
+	fmt.Printf("revision: %+v\n", resp.GetRevision())
+}
+
+func runRestore(ctx context.Context, client blogv1.BlogServiceClient, args []string) {
+	fs := flag.NewFlagSet("restore", flag.ExitOnError)
+	id := fs.String("id", "", "post id")
+	revision := fs.Int64("rev", 0, "revision to restore")
+	etag := fs.String("etag", "", "only restore if the post still has this etag")
+	_ = fs.Parse(args)
+
+	req := &blogv1.RestorePostRevisionRequest{PostId: *id, Revision: *revision, Etag: *etag}
+	resp, err := client.RestorePostRevision(ctx, req)
+	if err != nil {
+		log.Fatalf("restore failed: %v", err)
 	}
 
+	fmt.Printf("restored post: %+v\n", resp.GetPost())
+}
+
+// transportCredentials returns TLS credentials when cfg enables TLS and
+// plaintext ones otherwise.
+func transportCredentials(cfg config.ClientConfig) (credentials.TransportCredentials, error) {
+	if !cfg.TLS {
+		return insecure.NewCredentials(), nil
+	}
+	reloader, err := tlsconfig.NewReloader(tlsconfig.Files{CertFile: cfg.TLSCertFile, KeyFile: cfg.TLSKeyFile, CAFile: cfg.TLSCAFile}, clock.Real())
+	if err != nil {
+		return nil, err
+	}
+	return credentials.NewTLS(reloader.ClientConfig(cfg.TLSServerName)), nil
+}
+
+func parseOrder(raw string) (blogv1.PostOrder, bool) {
+	switch raw {
+	case "date_desc":
+		return blogv1.PostOrder_POST_ORDER_PUBLICATION_DATE_DESC, true
+	case "date_asc":
+		return blogv1.PostOrder_POST_ORDER_PUBLICATION_DATE_ASC, true
+	case "title_asc":
+		return blogv1.PostOrder_POST_ORDER_TITLE_ASC, true
+	case "title_desc":
+		return blogv1.PostOrder_POST_ORDER_TITLE_DESC, true
+	case "id_asc":
+		return blogv1.PostOrder_POST_ORDER_ID_ASC, true
+	case "id_desc":
+		return blogv1.PostOrder_POST_ORDER_ID_DESC, true
+	default:
+		return blogv1.PostOrder_POST_ORDER_UNSPECIFIED, false
+	}
+}
+
+func parseStatus(raw string) (blogv1.PostStatus, bool) {
+	switch raw {
+	case "":
+		return blogv1.PostStatus_POST_STATUS_UNSPECIFIED, true
+	case "draft":
+		return blogv1.PostStatus_POST_STATUS_DRAFT, true
+	case "in_review":
+		return blogv1.PostStatus_POST_STATUS_IN_REVIEW, true
+	case "scheduled":
+		return blogv1.PostStatus_POST_STATUS_SCHEDULED, true
+	case "published":
+		return blogv1.PostStatus_POST_STATUS_PUBLISHED, true
+	case "archived":
+		return blogv1.PostStatus_POST_STATUS_ARCHIVED, true
+	default:
+		return blogv1.PostStatus_POST_STATUS_UNSPECIFIED, false
+	}
+}
+
+// splitList parses a comma-separated flag, trimming the spaces around each
+// item and dropping empty ones.
+func splitList(raw string) []string {
+	var result []string
+	for _, item := range strings.Split(raw, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			result = append(result, item)
+		}
+	}
 	return result
 }
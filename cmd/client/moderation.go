@@ -0,0 +1,58 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+
+	blogv1 "github.com/BhaveetKumar/gRPC-server-go/proto/blog/v1"
+)
+
+func runPending(ctx context.Context, client blogv1.BlogServiceClient, args []string) {
+	fs := flag.NewFlagSet("pending", flag.ExitOnError)
+	pageSize := fs.Int("page-size", 0, "maximum number of posts to return")
+	pageToken := fs.String("page-token", "", "token from a previous pending call")
+	_ = fs.Parse(args)
+
+	resp, err := client.ListPendingPosts(ctx, &blogv1.ListPendingPostsRequest{PageSize: int32(*pageSize), PageToken: *pageToken})
+	if err != nil {
+		log.Fatalf("pending failed: %v", err)
+	}
+
+	for _, post := range resp.GetPosts() {
+		fmt.Printf("%s  %s  by %s  etag %s\n  %s\n", post.GetPostId(), post.GetTitle(), post.GetAuthor(), post.GetEtag(), post.GetModerationReason())
+	}
+	if resp.GetNextPageToken() != "" {
+		fmt.Printf("next page token: %s\n", resp.GetNextPageToken())
+	}
+}
+
+func runApprove(ctx context.Context, client blogv1.BlogServiceClient, args []string) {
+	fs := flag.NewFlagSet("approve", flag.ExitOnError)
+	id := fs.String("id", "", "post id")
+	etag := fs.String("etag", "", "only approve if the post still has this etag")
+	_ = fs.Parse(args)
+
+	resp, err := client.ApprovePost(ctx, &blogv1.ApprovePostRequest{PostId: *id, Etag: *etag})
+	if err != nil {
+		log.Fatalf("approve failed: %v", err)
+	}
+
+	fmt.Printf("approved post: %+v\n", resp.GetPost())
+}
+
+func runReject(ctx context.Context, client blogv1.BlogServiceClient, args []string) {
+	fs := flag.NewFlagSet("reject", flag.ExitOnError)
+	id := fs.String("id", "", "post id")
+	reason := fs.String("reason", "", "reason shown to the author")
+	etag := fs.String("etag", "", "only reject if the post still has this etag")
+	_ = fs.Parse(args)
+
+	resp, err := client.RejectPost(ctx, &blogv1.RejectPostRequest{PostId: *id, Reason: *reason, Etag: *etag})
+	if err != nil {
+		log.Fatalf("reject failed: %v", err)
+	}
+
+	fmt.Printf("rejected post: %+v\n", resp.GetPost())
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+
+	blogv1 "github.com/BhaveetKumar/gRPC-server-go/proto/blog/v1"
+)
+
+func runListTags(ctx context.Context, client blogv1.BlogServiceClient, args []string) {
+	fs := flag.NewFlagSet("tags", flag.ExitOnError)
+	pageSize := fs.Int("page-size", 0, "maximum number of tags to return")
+	pageToken := fs.String("page-token", "", "token from a previous tags call")
+	byName := fs.Bool("by-name", false, "order by name instead of most used first")
+	_ = fs.Parse(args)
+
+	req := &blogv1.ListTagsRequest{PageSize: int32(*pageSize), PageToken: *pageToken}
+	if *byName {
+		req.OrderBy = blogv1.TagOrder_TAG_ORDER_NAME
+	}
+
+	resp, err := client.ListTags(ctx, req)
+	if err != nil {
+		log.Fatalf("tags failed: %v", err)
+	}
+
+	for _, tag := range resp.GetTags() {
+		fmt.Printf("%6d  %s\n", tag.GetPostCount(), tag.GetTag())
+	}
+	if resp.GetNextPageToken() != "" {
+		fmt.Printf("next page token: %s\n", resp.GetNextPageToken())
+	}
+}
+
+func runRenameTag(ctx context.Context, client blogv1.BlogServiceClient, args []string) {
+	fs := flag.NewFlagSet("rename-tag", flag.ExitOnError)
+	from := fs.String("from", "", "tag to rename")
+	to := fs.String("to", "", "new tag name")
+	_ = fs.Parse(args)
+
+	resp, err := client.RenameTag(ctx, &blogv1.RenameTagRequest{From: *from, To: *to})
+	if err != nil {
+		log.Fatalf("rename-tag failed: %v", err)
+	}
+
+	fmt.Printf("renamed tag on %d posts\n", resp.GetPostsChanged())
+}
+
+func runMergeTags(ctx context.Context, client blogv1.BlogServiceClient, args []string) {
+	fs := flag.NewFlagSet("merge-tags", flag.ExitOnError)
+	sources := fs.String("sources", "", "comma separated tags to merge")
+	target := fs.String("target", "", "tag to merge them into")
+	_ = fs.Parse(args)
+
+	resp, err := client.MergeTags(ctx, &blogv1.MergeTagsRequest{Sources: splitList(*sources), Target: *target})
+	if err != nil {
+		log.Fatalf("merge-tags failed: %v", err)
+	}
+
+	fmt.Printf("merged tags on %d posts\n", resp.GetPostsChanged())
+}
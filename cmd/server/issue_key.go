@@ -0,0 +1,47 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/BhaveetKumar/gRPC-server-go/internal/config"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/service"
+)
+
+// runIssueKey issues an API key straight into the configured storage and
+// prints it. It is how the first admin key is made; after that keys can be
+// issued over the IssueApiKey RPC.
+func runIssueKey(cfg *config.AppConfig, args []string) {
+	fs := flag.NewFlagSet("issue-key", flag.ExitOnError)
+	name := fs.String("name", "", "what the key is for")
+	owner := fs.String("owner", "", "caller the key acts as")
+	scopes := fs.String("scopes", "", "comma separated scopes: posts:read, posts:write, admin")
+	ttl := fs.Duration("ttl", 0, "how long the key is valid; 0 never expires")
+	_ = fs.Parse(args)
+
+	if cfg.Storage.Backend == config.StorageBackendMemory {
+		log.Fatalf("issue-key needs the file or sql storage backend; memory keys would be gone when it exits")
+	}
+
+	store, err := openStorage(cfg)
+	if err != nil {
+		log.Fatalf("failed to open %s storage: %v", cfg.Storage.Backend, err)
+	}
+	defer store.close()
+
+	key, raw, err := service.NewAPIKeyService(store.apiKeys).IssueAPIKey(context.Background(), *name, *owner, strings.Split(*scopes, ","), *ttl)
+	if err != nil {
+		log.Fatalf("failed to issue key: %v", err)
+	}
+
+	expires := "never"
+	if !key.ExpiresAt.IsZero() {
+		expires = key.ExpiresAt.Format(time.RFC3339)
+	}
+	log.Printf("issued key %s for %s with scopes %s, expiring %s", key.ID, key.Owner, strings.Join(key.Scopes, ","), expires)
+	fmt.Println(raw)
+}
@@ -1,38 +1,170 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net"
 	"os"
 	"os/signal"
+	"sync"
 	"syscall"
+	"time"
 
+	"github.com/BhaveetKumar/gRPC-server-go/internal/auth"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/authz"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/clock"
 	"github.com/BhaveetKumar/gRPC-server-go/internal/config"
 	"github.com/BhaveetKumar/gRPC-server-go/internal/handler"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/idgen"
 	"github.com/BhaveetKumar/gRPC-server-go/internal/logger"
-	"github.com/BhaveetKumar/gRPC-server-go/internal/repository/memory"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/moderation"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/ratelimit"
 	"github.com/BhaveetKumar/gRPC-server-go/internal/service"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/tlsconfig"
 	blogv1 "github.com/BhaveetKumar/gRPC-server-go/proto/blog/v1"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 )
 
 func main() {
-	log.Println("starting gRPC blog server")
-
 	cfg, err := config.Load("")
 	if err != nil {
 		log.Fatalf("failed to load config: %v", err)
 	}
 
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "migrate":
+			runMigrate(cfg.Database)
+			return
+		case "mint-token":
+			runMintToken(cfg, os.Args[2:])
+			return
+		case "issue-key":
+			runIssueKey(cfg, os.Args[2:])
+			return
+		default:
+			log.Fatalf("unknown command: %s", os.Args[1])
+		}
+	}
+
+	log.Println("starting gRPC blog server")
+
 	baseLogger := logger.NewWithConfig(cfg.Log.EnableRequestID)
-	repo := memory.NewPostRepository()
-	postService := service.NewPostService(repo)
-	blogHandler := handler.NewBlogHandler(postService, baseLogger)
+	store, err := openStorage(cfg)
+	if err != nil {
+		log.Fatalf("failed to open %s storage: %v", cfg.Storage.Backend, err)
+	}
+	defer store.close()
+
+	retention := service.RevisionRetention{
+		MaxCount: cfg.Revisions.MaxPerPost,
+		MaxAge:   time.Duration(cfg.Revisions.MaxAgeDays) * 24 * time.Hour,
+	}
+	trashRetention := time.Duration(cfg.Trash.RetentionDays) * 24 * time.Hour
+	ids, err := idgen.New(cfg.IDs.Generator, int64(cfg.IDs.SnowflakeNode), clock.Real())
+	if err != nil {
+		log.Fatalf("failed to create id generator: %v", err)
+	}
+	postService := service.NewPostService(store.posts,
+		service.WithRevisions(store.revisions, retention),
+		service.WithTrashRetention(trashRetention),
+		service.WithComments(store.comments),
+		service.WithAuthors(store.authors),
+		service.WithSlugs(store.slugs),
+		service.WithIDGenerator(ids),
+		service.WithModeration(moderationPipeline(cfg.Moderation)),
+		service.WithModerators(cfg.Moderation.Moderators...),
+		service.WithDailyWriteQuota(cfg.Quotas.DailyPostWrites),
+		service.WithLogger(baseLogger))
+	migrated, err := postService.MigrateAuthors(context.Background())
+	if err != nil {
+		log.Fatalf("failed to link posts to authors: %v", err)
+	}
+	if migrated > 0 {
+		log.Printf("linked %d posts to authors", migrated)
+	}
+	slugged, err := postService.MigrateSlugs(context.Background())
+	if err != nil {
+		log.Fatalf("failed to give posts slugs: %v", err)
+	}
+	if slugged > 0 {
+		log.Printf("gave %d posts slugs", slugged)
+	}
+	retagged, err := postService.MigrateTags(context.Background())
+	if err != nil {
+		log.Fatalf("failed to normalize tags: %v", err)
+	}
+	if retagged > 0 {
+		log.Printf("normalized the tags of %d posts", retagged)
+	}
+	if err := postService.RebuildIndexes(context.Background()); err != nil {
+		log.Fatalf("failed to build search and tag indexes: %v", err)
+	}
+	commentService := service.NewCommentService(store.comments, postService)
+	authorService := service.NewAuthorService(store.authors, postService)
+	apiKeyService := service.NewAPIKeyService(store.apiKeys)
+	blogHandler := handler.NewBlogHandler(postService, commentService, authorService, apiKeyService, baseLogger)
+
+	backgroundCtx, stopBackground := context.WithCancel(context.Background())
+	var background sync.WaitGroup
+	background.Add(2)
+	go func() {
+		defer background.Done()
+		postService.RunScheduler(backgroundCtx)
+	}()
+	go func() {
+		defer background.Done()
+		postService.RunPurger(backgroundCtx)
+	}()
 
-	grpcServer := grpc.NewServer(
-		grpc.UnaryInterceptor(logger.UnaryServerInterceptor(baseLogger)),
-	)
+	limits, err := rateLimits(cfg.RateLimit)
+	if err != nil {
+		log.Fatalf("failed to set up rate limits: %v", err)
+	}
+	// Calls are rate limited once the caller is authenticated, before
+	// authorization or the handler spends anything on them.
+	limiter := ratelimit.NewLimiter(limits, clock.Real())
+
+	unaryInterceptors := []grpc.UnaryServerInterceptor{logger.UnaryServerInterceptor(baseLogger)}
+	streamInterceptors := []grpc.StreamServerInterceptor{logger.StreamServerInterceptor(baseLogger)}
+	if authenticator, err := newAuthenticator(cfg.Auth, apiKeyService); err != nil {
+		log.Fatalf("failed to set up authentication: %v", err)
+	} else if authenticator != nil {
+		policies := blogHandler.Policies()
+		unaryInterceptors = append(unaryInterceptors,
+			auth.UnaryServerInterceptor(authenticator, baseLogger),
+			ratelimit.UnaryServerInterceptor(limiter, baseLogger),
+			authz.UnaryServerInterceptor(policies, baseLogger))
+		streamInterceptors = append(streamInterceptors,
+			auth.StreamServerInterceptor(authenticator, baseLogger),
+			ratelimit.StreamServerInterceptor(limiter, baseLogger),
+			authz.StreamServerInterceptor(policies, baseLogger))
+	} else {
+		log.Println("authentication is not configured; callers are trusted to name themselves and nothing is authorized")
+		unaryInterceptors = append(unaryInterceptors, ratelimit.UnaryServerInterceptor(limiter, baseLogger))
+		streamInterceptors = append(streamInterceptors, ratelimit.StreamServerInterceptor(limiter, baseLogger))
+	}
+	serverOpts := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(unaryInterceptors...),
+		grpc.ChainStreamInterceptor(streamInterceptors...),
+	}
+	if cfg.Server.TLSCertFile != "" {
+		creds, reloader, err := serverCredentials(cfg.Server)
+		if err != nil {
+			log.Fatalf("failed to set up tls: %v", err)
+		}
+		background.Add(1)
+		go func() {
+			defer background.Done()
+			reloader.Run(backgroundCtx, tlsconfig.DefaultReloadInterval, baseLogger)
+		}()
+		serverOpts = append(serverOpts, grpc.Creds(creds))
+	} else {
+		log.Println("tls is not configured; serving plaintext")
+	}
+	grpcServer := grpc.NewServer(serverOpts...)
 
 	blogv1.RegisterBlogServiceServer(grpcServer, blogHandler)
 
@@ -55,4 +187,106 @@ func main() {
 	<-sigCh
 	log.Println("shutting down gRPC server")
 	grpcServer.GracefulStop()
+
+	stopBackground()
+	background.Wait()
+}
+
+// newAuthenticator returns an authenticator for the credentials cfg
+// accepts, or nil when authentication is off.
+func newAuthenticator(cfg config.AuthConfig, keys service.APIKeyService) (*auth.Authenticator, error) {
+	verifier, err := tokenVerifier(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	var keyVerifier auth.KeyVerifier
+	if cfg.APIKeys {
+		keyVerifier = keys
+	}
+	if verifier == nil && keyVerifier == nil {
+		return nil, nil
+	}
+	return auth.NewAuthenticator(verifier, keyVerifier, cfg.AllowAnonymous), nil
+}
+
+// tokenVerifier returns the verifier for the bearer tokens cfg accepts, or
+// nil when tokens are not accepted.
+func tokenVerifier(cfg config.AuthConfig) (*auth.Verifier, error) {
+	if cfg.HS256Secret == "" && cfg.JWKSFile == "" {
+		return nil, nil
+	}
+
+	opts := []auth.VerifierOption{auth.WithIssuer(cfg.Issuer), auth.WithAudience(cfg.Audience)}
+	if cfg.DefaultRole != "" {
+		role, ok := auth.ParseRole(cfg.DefaultRole)
+		if !ok {
+			return nil, fmt.Errorf("unknown default role %q", cfg.DefaultRole)
+		}
+		opts = append(opts, auth.WithDefaultRole(role))
+	}
+	if cfg.HS256Secret != "" {
+		opts = append(opts, auth.WithHS256Secret([]byte(cfg.HS256Secret)))
+	}
+	if cfg.JWKSFile != "" {
+		keys, err := auth.LoadJWKS(cfg.JWKSFile)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, auth.WithPublicKeys(keys...))
+	}
+	return auth.NewVerifier(clock.Real(), opts...)
+}
+
+// rateLimits converts cfg into the limiter's limits, checking that every
+// method it limits is a BlogService method.
+func rateLimits(cfg config.RateLimitConfig) (ratelimit.Limits, error) {
+	known := make(map[string]bool)
+	for _, m := range blogv1.BlogService_ServiceDesc.Methods {
+		known[m.MethodName] = true
+	}
+	for _, st := range blogv1.BlogService_ServiceDesc.Streams {
+		known[st.StreamName] = true
+	}
+
+	limits := ratelimit.Limits{Default: ratelimit.Limit(cfg.Default), Methods: make(map[string]ratelimit.Limit)}
+	for method, limit := range cfg.Methods {
+		if !known[method] {
+			return ratelimit.Limits{}, fmt.Errorf("unknown method %q", method)
+		}
+		limits.Methods[method] = ratelimit.Limit(limit)
+	}
+	return limits, nil
+}
+
+// serverCredentials loads the TLS files in cfg and returns credentials that
+// follow the reloader as the files are rotated.
+func serverCredentials(cfg config.ServerConfig) (credentials.TransportCredentials, *tlsconfig.Reloader, error) {
+	reloader, err := tlsconfig.NewReloader(tlsconfig.Files{CertFile: cfg.TLSCertFile, KeyFile: cfg.TLSKeyFile, CAFile: cfg.TLSClientCAFile}, clock.Real())
+	if err != nil {
+		return nil, nil, err
+	}
+	tlsCfg, err := reloader.ServerConfig(cfg.TLSRequireClientCert)
+	if err != nil {
+		return nil, nil, err
+	}
+	return credentials.NewTLS(tlsCfg), reloader, nil
+}
+
+// moderationPipeline builds the moderation rules enabled in cfg.
+func moderationPipeline(cfg config.ModerationConfig) *moderation.Pipeline {
+	pipeline := moderation.NewPipeline()
+	if len(cfg.BannedWords) > 0 {
+		pipeline.Register(moderation.BannedWords(cfg.BannedWords...))
+	}
+	if cfg.MaxLinks > 0 {
+		pipeline.Register(moderation.MaxLinks(cfg.MaxLinks))
+	}
+	if cfg.MaxRepeats > 0 {
+		pipeline.Register(moderation.RepeatedText(cfg.MaxRepeats))
+	}
+	if cfg.FirstPostApproval {
+		pipeline.Register(moderation.FirstPostApproval())
+	}
+	return pipeline
 }
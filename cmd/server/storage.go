@@ -0,0 +1,123 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/BhaveetKumar/gRPC-server-go/internal/config"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/repository"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/repository/file"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/repository/memory"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/repository/sqldb"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// storage is the set of repositories the services run on; close releases
+// whatever the backend holds open.
+type storage struct {
+	posts     repository.PostRepository
+	revisions repository.RevisionRepository
+	authors   repository.AuthorRepository
+	slugs     repository.SlugRepository
+	apiKeys   repository.APIKeyRepository
+	comments  repository.CommentRepository
+	close     func()
+}
+
+func openStorage(cfg *config.AppConfig) (*storage, error) {
+	switch cfg.Storage.Backend {
+	case config.StorageBackendMemory:
+		return &storage{
+			posts:     memory.NewPostRepository(),
+			revisions: memory.NewRevisionRepository(),
+			authors:   memory.NewAuthorRepository(),
+			slugs:     memory.NewSlugRepository(),
+			apiKeys:   memory.NewAPIKeyRepository(),
+			comments:  memory.NewCommentRepository(),
+			close:     func() {},
+		}, nil
+	case config.StorageBackendFile:
+		repo, err := file.NewPostRepository(cfg.Storage.DataDir, cfg.Storage.SnapshotEvery)
+		if err != nil {
+			return nil, err
+		}
+		closeRepo := func() {
+			if err := repo.Close(); err != nil {
+				log.Printf("failed to close storage: %v", err)
+			}
+		}
+		return &storage{posts: repo, revisions: repo, authors: repo, slugs: repo, apiKeys: repo, comments: repo, close: closeRepo}, nil
+	case config.StorageBackendSQL:
+		db, err := openDatabase(cfg.Database)
+		if err != nil {
+			return nil, err
+		}
+		if err := migrate(db, cfg.Database.Driver); err != nil {
+			db.Close()
+			return nil, err
+		}
+		closeDB := func() {
+			if err := db.Close(); err != nil {
+				log.Printf("failed to close database: %v", err)
+			}
+		}
+		return &storage{
+			posts:     sqldb.NewPostRepository(db, cfg.Database.Driver),
+			revisions: sqldb.NewRevisionRepository(db, cfg.Database.Driver),
+			authors:   sqldb.NewAuthorRepository(db, cfg.Database.Driver),
+			slugs:     sqldb.NewSlugRepository(db, cfg.Database.Driver),
+			apiKeys:   sqldb.NewAPIKeyRepository(db, cfg.Database.Driver),
+			comments:  sqldb.NewCommentRepository(db, cfg.Database.Driver),
+			close:     closeDB,
+		}, nil
+	default:
+		return nil, fmt.Errorf("unknown storage backend %q", cfg.Storage.Backend)
+	}
+}
+
+func openDatabase(cfg config.DatabaseConfig) (*sql.DB, error) {
+	db, err := sql.Open(cfg.Driver, cfg.DSN)
+	if err != nil {
+		return nil, fmt.Errorf("open database: %w", err)
+	}
+
+	db.SetMaxOpenConns(cfg.MaxOpenConns)
+	db.SetMaxIdleConns(cfg.MaxIdleConns)
+	db.SetConnMaxLifetime(time.Duration(cfg.ConnMaxLifetimeSeconds) * time.Second)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := db.PingContext(ctx); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("ping database: %w", err)
+	}
+
+	return db, nil
+}
+
+func migrate(db *sql.DB, driver string) error {
+	applied, err := sqldb.Migrate(context.Background(), db, driver)
+	if err != nil {
+		return fmt.Errorf("migrate database: %w", err)
+	}
+	for _, version := range applied {
+		log.Printf("applied migration %d", version)
+	}
+	return nil
+}
+
+func runMigrate(cfg config.DatabaseConfig) {
+	db, err := openDatabase(cfg)
+	if err != nil {
+		log.Fatalf("failed to open database: %v", err)
+	}
+	defer db.Close()
+
+	if err := migrate(db, cfg.Driver); err != nil {
+		log.Fatalf("%v", err)
+	}
+	log.Println("database schema is up to date")
+}
@@ -0,0 +1,35 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/BhaveetKumar/gRPC-server-go/internal/domain"
+)
+
+// KeyVerifier checks raw API keys. service.APIKeyService implements it.
+type KeyVerifier interface {
+	VerifyAPIKey(ctx context.Context, raw string) (*domain.APIKey, error)
+}
+
+// keyPrincipal returns the principal an API key acts as. Its role is the
+// highest its scopes call for: admin keys are admins, keys that may write
+// are authors and the rest are readers.
+func keyPrincipal(key *domain.APIKey) *Principal {
+	role := RoleReader
+	for _, scope := range key.Scopes {
+		switch {
+		case scope == domain.ScopeAdmin:
+			role = RoleAdmin
+		case scope == domain.ScopePostsWrite && role < RoleAuthor:
+			role = RoleAuthor
+		}
+	}
+
+	return &Principal{
+		Subject:   key.Owner,
+		Role:      role,
+		ExpiresAt: key.ExpiresAt,
+		KeyID:     key.ID,
+		Scopes:    append([]string(nil), key.Scopes...),
+	}
+}
@@ -0,0 +1,137 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	apperrors "github.com/BhaveetKumar/gRPC-server-go/internal/errors"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/logger"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	authorizationKey = "authorization"
+	// apiKeyKey carries an API key, the alternative to a bearer token.
+	apiKeyKey = "x-api-key"
+	// callerKey is the unauthenticated author header; once tokens are
+	// checked only the verified principal may say who the caller is.
+	callerKey = "x-author"
+)
+
+// Authenticator checks the credentials of incoming calls: bearer tokens
+// when it has a Verifier and API keys when it has a KeyVerifier.
+type Authenticator struct {
+	tokens         *Verifier
+	keys           KeyVerifier
+	allowAnonymous bool
+}
+
+// NewAuthenticator returns an Authenticator accepting whichever of tokens
+// and keys is not nil. Calls without credentials go through as anonymous
+// when allowAnonymous is set.
+func NewAuthenticator(tokens *Verifier, keys KeyVerifier, allowAnonymous bool) *Authenticator {
+	return &Authenticator{tokens: tokens, keys: keys, allowAnonymous: allowAnonymous}
+}
+
+// UnaryServerInterceptor checks the credentials of every call and puts the
+// caller's principal into the context. Bad credentials are always rejected
+// with Unauthenticated.
+func UnaryServerInterceptor(a *Authenticator, log *logger.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := a.authenticate(ctx)
+		if err != nil {
+			return nil, refuse(info.FullMethod, err, log)
+		}
+		return handler(ctx, req)
+	}
+}
+
+func StreamServerInterceptor(a *Authenticator, log *logger.Logger) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := a.authenticate(ss.Context())
+		if err != nil {
+			return refuse(info.FullMethod, err, log)
+		}
+		return handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// refuse turns an authentication failure into a status: Unauthenticated,
+// unless the credentials could not be checked at all.
+func refuse(method string, err error, log *logger.Logger) error {
+	log.Error(fmt.Sprintf("authentication failed for %s: %v", method, err))
+	if errors.Is(err, apperrors.ErrInternal) {
+		return apperrors.ToStatus(apperrors.ErrInternal, log)
+	}
+	return apperrors.ToStatus(apperrors.ErrUnauthenticated, log)
+}
+
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+// authenticate returns ctx with the caller's principal. Credentials are
+// dropped from the metadata once read, so nothing further down the chain
+// can log them. Errors never include the credentials either.
+func (a *Authenticator) authenticate(ctx context.Context) (context.Context, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	md = md.Copy()
+	md.Delete(callerKey)
+	tokens, keys := md.Get(authorizationKey), md.Get(apiKeyKey)
+	md.Delete(authorizationKey)
+	md.Delete(apiKeyKey)
+	ctx = metadata.NewIncomingContext(ctx, md)
+
+	switch {
+	case len(tokens) == 0 && len(keys) == 0:
+		if !a.allowAnonymous {
+			return nil, fmt.Errorf("no credentials")
+		}
+		return ctx, nil
+	case len(tokens)+len(keys) > 1:
+		return nil, fmt.Errorf("more than one credential")
+	case len(keys) == 1:
+		return a.authenticateKey(ctx, keys[0])
+	default:
+		return a.authenticateToken(ctx, tokens[0])
+	}
+}
+
+func (a *Authenticator) authenticateToken(ctx context.Context, header string) (context.Context, error) {
+	if a.tokens == nil {
+		return nil, fmt.Errorf("bearer tokens are not accepted")
+	}
+
+	scheme, token, ok := strings.Cut(header, " ")
+	if !ok || !strings.EqualFold(scheme, "bearer") {
+		return nil, fmt.Errorf("authorization is not a bearer token")
+	}
+	principal, err := a.tokens.Verify(strings.TrimSpace(token))
+	if err != nil {
+		return nil, err
+	}
+	return WithPrincipal(ctx, principal), nil
+}
+
+func (a *Authenticator) authenticateKey(ctx context.Context, raw string) (context.Context, error) {
+	if a.keys == nil {
+		return nil, fmt.Errorf("api keys are not accepted")
+	}
+
+	key, err := a.keys.VerifyAPIKey(ctx, strings.TrimSpace(raw))
+	if errors.Is(err, apperrors.ErrUnauthenticated) {
+		return nil, fmt.Errorf("api key: %w", err)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: check api key: %v", apperrors.ErrInternal, err)
+	}
+	return WithPrincipal(ctx, keyPrincipal(key)), nil
+}
@@ -0,0 +1,159 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/BhaveetKumar/gRPC-server-go/internal/clock"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/domain"
+	apperrors "github.com/BhaveetKumar/gRPC-server-go/internal/errors"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/logger"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// call runs the unary interceptor with md as the incoming metadata and
+// returns the context the handler saw.
+func call(t *testing.T, allowAnonymous bool, md metadata.MD) (context.Context, error) {
+	t.Helper()
+
+	verifier, err := NewVerifier(clock.NewFake(tokenEpoch), WithHS256Secret([]byte("secret")))
+	if err != nil {
+		t.Fatalf("new verifier failed: %v", err)
+	}
+	return callWith(t, NewAuthenticator(verifier, nil, allowAnonymous), md)
+}
+
+func callWith(t *testing.T, a *Authenticator, md metadata.MD) (context.Context, error) {
+	t.Helper()
+
+	interceptor := UnaryServerInterceptor(a, logger.New())
+
+	var seen context.Context
+	_, err := interceptor(metadata.NewIncomingContext(context.Background(), md), nil, &grpc.UnaryServerInfo{FullMethod: "/blog.v1.BlogService/DeletePost"},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			seen = ctx
+			return nil, nil
+		})
+	return seen, err
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	token := mustMint(t, claimsFor("alice"), []byte("secret"), "")
+
+	ctx, err := call(t, false, metadata.Pairs("authorization", "Bearer "+token, "x-author", "mallory"))
+	if err != nil {
+		t.Fatalf("expected a valid token to be accepted, got %v", err)
+	}
+	if principal := PrincipalFromContext(ctx); principal == nil || principal.Subject != "alice" {
+		t.Fatalf("expected alice in the context, got %+v", principal)
+	}
+	if md, _ := metadata.FromIncomingContext(ctx); len(md.Get("x-author")) != 0 {
+		t.Fatal("expected the unverified author header to be dropped")
+	}
+	if md, _ := metadata.FromIncomingContext(ctx); len(md.Get("authorization")) != 0 {
+		t.Fatal("expected the bearer token to be dropped")
+	}
+
+	ctx, err = call(t, true, metadata.Pairs("x-author", "mallory"))
+	if err != nil || PrincipalFromContext(ctx) != nil {
+		t.Fatalf("expected an anonymous call to go through, got %v", err)
+	}
+
+	for name, md := range map[string]metadata.MD{
+		"no token":       metadata.MD{},
+		"bad token":      metadata.Pairs("authorization", "Bearer "+token+"x"),
+		"basic auth":     metadata.Pairs("authorization", "Basic YWxpY2U6cHc="),
+		"two tokens":     metadata.Pairs("authorization", "Bearer "+token, "authorization", "Bearer "+token),
+		"missing scheme": metadata.Pairs("authorization", token),
+	} {
+		if _, err := call(t, false, md); status.Code(err) != codes.Unauthenticated {
+			t.Fatalf("%s: expected unauthenticated, got %v", name, err)
+		}
+	}
+	if _, err := call(t, true, metadata.Pairs("authorization", "Bearer nonsense")); status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("expected a bad token to be rejected even when anonymous calls are allowed, got %v", err)
+	}
+}
+
+// fakeKeys accepts the keys it holds by their raw value.
+type fakeKeys map[string]*domain.APIKey
+
+func (f fakeKeys) VerifyAPIKey(_ context.Context, raw string) (*domain.APIKey, error) {
+	if raw == "broken" {
+		return nil, errors.New("database is down")
+	}
+	key, ok := f[raw]
+	if !ok {
+		return nil, apperrors.ErrUnauthenticated
+	}
+	return key, nil
+}
+
+func TestUnaryServerInterceptor_APIKeys(t *testing.T) {
+	keys := fakeKeys{
+		"read":  {ID: "k1", Owner: "export bot", Scopes: []string{domain.ScopePostsRead}},
+		"write": {ID: "k2", Owner: "export bot", Scopes: []string{domain.ScopePostsRead, domain.ScopePostsWrite}},
+		"admin": {ID: "k3", Owner: "ops", Scopes: []string{domain.ScopeAdmin}},
+	}
+	verifier, err := NewVerifier(clock.NewFake(tokenEpoch), WithHS256Secret([]byte("secret")))
+	if err != nil {
+		t.Fatalf("new verifier failed: %v", err)
+	}
+	a := NewAuthenticator(verifier, keys, false)
+
+	for raw, want := range map[string]Role{"read": RoleReader, "write": RoleAuthor, "admin": RoleAdmin} {
+		ctx, err := callWith(t, a, metadata.Pairs("x-api-key", raw, "x-author", "mallory"))
+		if err != nil {
+			t.Fatalf("%s: expected the key to be accepted, got %v", raw, err)
+		}
+		principal := PrincipalFromContext(ctx)
+		if principal == nil || principal.Subject != keys[raw].Owner || principal.Role != want || principal.KeyID != keys[raw].ID {
+			t.Fatalf("%s: expected the key's owner as %v, got %+v", raw, want, principal)
+		}
+		if md, _ := metadata.FromIncomingContext(ctx); len(md.Get("x-api-key")) != 0 || len(md.Get("x-author")) != 0 {
+			t.Fatalf("%s: expected the key and author headers to be dropped", raw)
+		}
+	}
+
+	token := mustMint(t, claimsFor("alice"), []byte("secret"), "")
+	for name, md := range map[string]metadata.MD{
+		"unknown key": metadata.Pairs("x-api-key", "guess"),
+		"two keys":    metadata.Pairs("x-api-key", "read", "x-api-key", "write"),
+		"key + token": metadata.Pairs("x-api-key", "read", "authorization", "Bearer "+token),
+	} {
+		if _, err := callWith(t, a, md); status.Code(err) != codes.Unauthenticated {
+			t.Fatalf("%s: expected unauthenticated, got %v", name, err)
+		}
+	}
+	if _, err := callWith(t, a, metadata.Pairs("x-api-key", "broken")); status.Code(err) != codes.Internal {
+		t.Fatalf("expected a failing key store to be an internal error, got %v", err)
+	}
+
+	tokensOnly := NewAuthenticator(verifier, nil, true)
+	if _, err := callWith(t, tokensOnly, metadata.Pairs("x-api-key", "read")); status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("expected keys to be refused when not enabled, got %v", err)
+	}
+	keysOnly := NewAuthenticator(nil, keys, true)
+	if _, err := callWith(t, keysOnly, metadata.Pairs("authorization", "Bearer "+token)); status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("expected tokens to be refused when not enabled, got %v", err)
+	}
+}
+
+func TestPrincipal_HasScope(t *testing.T) {
+	token := &Principal{Subject: "alice", Role: RoleReader}
+	reader := keyPrincipal(&domain.APIKey{ID: "k1", Owner: "bot", Scopes: []string{domain.ScopePostsRead}})
+	admin := keyPrincipal(&domain.APIKey{ID: "k2", Owner: "ops", Scopes: []string{domain.ScopeAdmin}})
+
+	for _, scope := range []string{domain.ScopePostsRead, domain.ScopePostsWrite, domain.ScopeAdmin} {
+		if !token.HasScope(scope) || !admin.HasScope(scope) {
+			t.Fatalf("expected tokens and admin keys to have %s", scope)
+		}
+	}
+	if !reader.HasScope(domain.ScopePostsRead) || reader.HasScope(domain.ScopePostsWrite) || reader.HasScope(domain.ScopeAdmin) {
+		t.Fatalf("expected a read key to only read, got %v", reader.Scopes)
+	}
+}
@@ -0,0 +1,279 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/BhaveetKumar/gRPC-server-go/internal/clock"
+)
+
+// Signing algorithms accepted in tokens.
+const (
+	HS256 = "HS256"
+	RS256 = "RS256"
+	ES256 = "ES256"
+)
+
+// clockSkew is how far the token times may be off from the server's clock.
+const clockSkew = 30 * time.Second
+
+// Claims are the registered JWT claims the server understands.
+type Claims struct {
+	Subject   string   `json:"sub"`
+	Issuer    string   `json:"iss,omitempty"`
+	Audience  Audience `json:"aud,omitempty"`
+	ExpiresAt int64    `json:"exp"`
+	NotBefore int64    `json:"nbf,omitempty"`
+	IssuedAt  int64    `json:"iat,omitempty"`
+	// Roles are the names of the caller's roles; the highest one counts.
+	Roles []string `json:"roles,omitempty"`
+}
+
+// Audience is the aud claim, which may be a single string or a list.
+type Audience []string
+
+func (a *Audience) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*a = Audience{single}
+		return nil
+	}
+	var list []string
+	if err := json.Unmarshal(data, &list); err != nil {
+		return err
+	}
+	*a = list
+	return nil
+}
+
+func (a Audience) contains(aud string) bool {
+	for _, v := range a {
+		if v == aud {
+			return true
+		}
+	}
+	return false
+}
+
+type header struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid,omitempty"`
+	Typ string `json:"typ,omitempty"`
+}
+
+// Verifier checks the signature and claims of JWT bearer tokens.
+type Verifier struct {
+	clock    clock.Clock
+	secret   []byte
+	keys     []PublicKey
+	issuer   string
+	audience string
+	// defaultRole is given to tokens without a known role.
+	defaultRole Role
+}
+
+type VerifierOption func(*Verifier)
+
+// WithHS256Secret accepts tokens signed with HMAC-SHA256 under secret.
+func WithHS256Secret(secret []byte) VerifierOption {
+	return func(v *Verifier) {
+		v.secret = secret
+	}
+}
+
+// WithPublicKeys accepts RS256 and ES256 tokens signed by one of keys.
+func WithPublicKeys(keys ...PublicKey) VerifierOption {
+	return func(v *Verifier) {
+		v.keys = append(v.keys, keys...)
+	}
+}
+
+// WithIssuer only accepts tokens issued by issuer.
+func WithIssuer(issuer string) VerifierOption {
+	return func(v *Verifier) {
+		v.issuer = issuer
+	}
+}
+
+// WithAudience only accepts tokens meant for audience.
+func WithAudience(audience string) VerifierOption {
+	return func(v *Verifier) {
+		v.audience = audience
+	}
+}
+
+// WithDefaultRole sets the role of tokens that carry no known role; it is
+// RoleReader unless set.
+func WithDefaultRole(role Role) VerifierOption {
+	return func(v *Verifier) {
+		v.defaultRole = role
+	}
+}
+
+func NewVerifier(c clock.Clock, opts ...VerifierOption) (*Verifier, error) {
+	v := &Verifier{clock: c, defaultRole: RoleReader}
+	for _, opt := range opts {
+		opt(v)
+	}
+	if len(v.secret) == 0 && len(v.keys) == 0 {
+		return nil, errors.New("jwt verifier needs a secret or public keys")
+	}
+	return v, nil
+}
+
+// Verify checks token and returns the principal it was issued to.
+func (v *Verifier) Verify(token string) (*Principal, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, errors.New("malformed token")
+	}
+
+	var h header
+	if err := decodeSegment(parts[0], &h); err != nil {
+		return nil, fmt.Errorf("malformed token header: %w", err)
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("malformed token signature: %w", err)
+	}
+	if err := v.verifySignature(h, []byte(parts[0]+"."+parts[1]), signature); err != nil {
+		return nil, err
+	}
+
+	var claims Claims
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return nil, fmt.Errorf("malformed token claims: %w", err)
+	}
+	if err := v.checkClaims(claims); err != nil {
+		return nil, err
+	}
+
+	return &Principal{
+		Subject:   claims.Subject,
+		Role:      highestRole(claims.Roles, v.defaultRole),
+		Issuer:    claims.Issuer,
+		ExpiresAt: time.Unix(claims.ExpiresAt, 0),
+	}, nil
+}
+
+func (v *Verifier) verifySignature(h header, signed, signature []byte) error {
+	switch h.Alg {
+	case HS256:
+		if len(v.secret) == 0 {
+			return errors.New("HS256 tokens are not accepted")
+		}
+		mac := hmac.New(sha256.New, v.secret)
+		mac.Write(signed)
+		if !hmac.Equal(signature, mac.Sum(nil)) {
+			return errors.New("invalid token signature")
+		}
+		return nil
+	case RS256, ES256:
+		digest := sha256.Sum256(signed)
+		for _, key := range v.keys {
+			if !key.accepts(h) {
+				continue
+			}
+			if key.verify(digest[:], signature) {
+				return nil
+			}
+		}
+		return errors.New("invalid token signature")
+	default:
+		// Including "none".
+		return fmt.Errorf("unsupported token algorithm %q", h.Alg)
+	}
+}
+
+func (v *Verifier) checkClaims(claims Claims) error {
+	now := v.clock.Now()
+	switch {
+	case claims.Subject == "":
+		return errors.New("token has no subject")
+	case claims.ExpiresAt == 0:
+		return errors.New("token has no expiry")
+	case now.After(time.Unix(claims.ExpiresAt, 0).Add(clockSkew)):
+		return errors.New("token expired")
+	case claims.NotBefore != 0 && now.Add(clockSkew).Before(time.Unix(claims.NotBefore, 0)):
+		return errors.New("token not valid yet")
+	case v.issuer != "" && claims.Issuer != v.issuer:
+		return fmt.Errorf("token issuer %q not accepted", claims.Issuer)
+	case v.audience != "" && !claims.Audience.contains(v.audience):
+		return errors.New("token not meant for this audience")
+	}
+	return nil
+}
+
+// Mint signs claims into a token. key is the HS256 secret as a []byte, an
+// *rsa.PrivateKey for RS256 or a P-256 *ecdsa.PrivateKey for ES256; kid, if
+// set, names the key in the token header.
+func Mint(claims Claims, key any, kid string) (string, error) {
+	h := header{Kid: kid, Typ: "JWT"}
+	switch k := key.(type) {
+	case []byte:
+		h.Alg = HS256
+	case *rsa.PrivateKey:
+		h.Alg = RS256
+	case *ecdsa.PrivateKey:
+		if k.Curve != elliptic.P256() {
+			return "", errors.New("ES256 needs a P-256 key")
+		}
+		h.Alg = ES256
+	default:
+		return "", fmt.Errorf("unsupported signing key %T", key)
+	}
+
+	headerJSON, err := json.Marshal(h)
+	if err != nil {
+		return "", err
+	}
+	claimsJSON, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+	signed := base64.RawURLEncoding.EncodeToString(headerJSON) + "." + base64.RawURLEncoding.EncodeToString(claimsJSON)
+
+	var signature []byte
+	digest := sha256.Sum256([]byte(signed))
+	switch k := key.(type) {
+	case []byte:
+		mac := hmac.New(sha256.New, k)
+		mac.Write([]byte(signed))
+		signature = mac.Sum(nil)
+	case *rsa.PrivateKey:
+		signature, err = rsa.SignPKCS1v15(rand.Reader, k, crypto.SHA256, digest[:])
+	case *ecdsa.PrivateKey:
+		var r, s *big.Int
+		r, s, err = ecdsa.Sign(rand.Reader, k, digest[:])
+		if err == nil {
+			// JWS wants the fixed-width r || s rather than ASN.1.
+			signature = make([]byte, 64)
+			r.FillBytes(signature[:32])
+			s.FillBytes(signature[32:])
+		}
+	}
+	if err != nil {
+		return "", err
+	}
+	return signed + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+func decodeSegment(segment string, v any) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
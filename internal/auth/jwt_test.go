@@ -0,0 +1,217 @@
+package auth
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/BhaveetKumar/gRPC-server-go/internal/clock"
+)
+
+var tokenEpoch = time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+
+func claimsFor(subject string) Claims {
+	return Claims{Subject: subject, IssuedAt: tokenEpoch.Unix(), ExpiresAt: tokenEpoch.Add(time.Hour).Unix()}
+}
+
+func mustMint(t *testing.T, claims Claims, key any, kid string) string {
+	t.Helper()
+
+	token, err := Mint(claims, key, kid)
+	if err != nil {
+		t.Fatalf("mint failed: %v", err)
+	}
+	return token
+}
+
+func encodeInt(n *big.Int) string {
+	return base64.RawURLEncoding.EncodeToString(n.Bytes())
+}
+
+// writeJWKS writes the public halves of keys, by key ID, as a JWKS file.
+func writeJWKS(t *testing.T, keys map[string]any) string {
+	t.Helper()
+
+	var set struct {
+		Keys []map[string]string `json:"keys"`
+	}
+	for kid, key := range keys {
+		switch k := key.(type) {
+		case *rsa.PrivateKey:
+			set.Keys = append(set.Keys, map[string]string{"kty": "RSA", "kid": kid, "use": "sig", "n": encodeInt(k.N), "e": encodeInt(big.NewInt(int64(k.E)))})
+		case *ecdsa.PrivateKey:
+			set.Keys = append(set.Keys, map[string]string{"kty": "EC", "kid": kid, "crv": "P-256", "x": encodeInt(k.X), "y": encodeInt(k.Y)})
+		}
+	}
+	// Keys that cannot sign tokens are skipped.
+	set.Keys = append(set.Keys, map[string]string{"kty": "oct", "kid": "symmetric", "k": "c2VjcmV0"})
+
+	data, err := json.Marshal(set)
+	if err != nil {
+		t.Fatalf("marshal jwks: %v", err)
+	}
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatalf("write jwks: %v", err)
+	}
+	return path
+}
+
+func TestVerifier_HS256(t *testing.T) {
+	clk := clock.NewFake(tokenEpoch)
+	verifier, err := NewVerifier(clk, WithHS256Secret([]byte("secret")), WithIssuer("blog"), WithAudience("api"))
+	if err != nil {
+		t.Fatalf("new verifier failed: %v", err)
+	}
+
+	claims := claimsFor("alice")
+	claims.Issuer = "blog"
+	claims.Audience = Audience{"other", "api"}
+	principal, err := verifier.Verify(mustMint(t, claims, []byte("secret"), ""))
+	if err != nil {
+		t.Fatalf("verify failed: %v", err)
+	}
+	if principal.Subject != "alice" || principal.Issuer != "blog" || !principal.ExpiresAt.Equal(tokenEpoch.Add(time.Hour)) {
+		t.Fatalf("unexpected principal: %+v", principal)
+	}
+
+	wrongIssuer := claims
+	wrongIssuer.Issuer = "elsewhere"
+	wrongAudience := claims
+	wrongAudience.Audience = Audience{"other"}
+	noSubject := claims
+	noSubject.Subject = ""
+	noExpiry := claims
+	noExpiry.ExpiresAt = 0
+	notYet := claims
+	notYet.NotBefore = tokenEpoch.Add(time.Minute).Unix()
+
+	valid := mustMint(t, claims, []byte("secret"), "")
+	parts := strings.Split(valid, ".")
+	unsigned := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"none"}`)) + "." + parts[1] + "."
+
+	for name, token := range map[string]string{
+		"wrong secret":   mustMint(t, claims, []byte("guess"), ""),
+		"wrong issuer":   mustMint(t, wrongIssuer, []byte("secret"), ""),
+		"wrong audience": mustMint(t, wrongAudience, []byte("secret"), ""),
+		"no subject":     mustMint(t, noSubject, []byte("secret"), ""),
+		"no expiry":      mustMint(t, noExpiry, []byte("secret"), ""),
+		"not yet valid":  mustMint(t, notYet, []byte("secret"), ""),
+		"alg none":       unsigned,
+		"tampered":       parts[0] + "." + base64.RawURLEncoding.EncodeToString([]byte(`{"sub":"admin","exp":9999999999}`)) + "." + parts[2],
+		"malformed":      "not-a-token",
+	} {
+		if _, err := verifier.Verify(token); err == nil {
+			t.Fatalf("%s: expected the token to be rejected", name)
+		}
+	}
+
+	// Tokens stay valid for a little while past their expiry to allow for
+	// clock skew, but not longer.
+	clk.Advance(time.Hour + clockSkew/2)
+	if _, err := verifier.Verify(valid); err != nil {
+		t.Fatalf("expected a token within the skew to be accepted, got %v", err)
+	}
+	clk.Advance(clockSkew)
+	if _, err := verifier.Verify(valid); err == nil {
+		t.Fatal("expected an expired token to be rejected")
+	}
+}
+
+func TestVerifier_JWKS(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generate rsa key: %v", err)
+	}
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate ec key: %v", err)
+	}
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate ec key: %v", err)
+	}
+
+	keys, err := LoadJWKS(writeJWKS(t, map[string]any{"rsa": rsaKey, "ec": ecKey}))
+	if err != nil {
+		t.Fatalf("load jwks failed: %v", err)
+	}
+	if len(keys) != 2 {
+		t.Fatalf("expected the two signing keys, got %d", len(keys))
+	}
+	verifier, err := NewVerifier(clock.NewFake(tokenEpoch), WithPublicKeys(keys...))
+	if err != nil {
+		t.Fatalf("new verifier failed: %v", err)
+	}
+
+	for name, token := range map[string]string{
+		"RS256":        mustMint(t, claimsFor("alice"), rsaKey, "rsa"),
+		"ES256":        mustMint(t, claimsFor("alice"), ecKey, "ec"),
+		"ES256 no kid": mustMint(t, claimsFor("alice"), ecKey, ""),
+	} {
+		if principal, err := verifier.Verify(token); err != nil || principal.Subject != "alice" {
+			t.Fatalf("%s: expected the token to be accepted, got %+v, %v", name, principal, err)
+		}
+	}
+
+	for name, token := range map[string]string{
+		"unknown key":    mustMint(t, claimsFor("alice"), otherKey, ""),
+		"wrong kid":      mustMint(t, claimsFor("alice"), ecKey, "rsa"),
+		"HS256 disabled": mustMint(t, claimsFor("alice"), []byte("secret"), ""),
+	} {
+		if _, err := verifier.Verify(token); err == nil {
+			t.Fatalf("%s: expected the token to be rejected", name)
+		}
+	}
+}
+
+func TestNewVerifier_NeedsKeys(t *testing.T) {
+	if _, err := NewVerifier(clock.Real()); err == nil {
+		t.Fatal("expected a verifier without keys to be rejected")
+	}
+	if _, err := LoadJWKS(filepath.Join(t.TempDir(), "missing.json")); err == nil {
+		t.Fatal("expected a missing jwks file to be rejected")
+	}
+}
+
+func TestVerifier_Roles(t *testing.T) {
+	verifier, err := NewVerifier(clock.NewFake(tokenEpoch), WithHS256Secret([]byte("secret")))
+	if err != nil {
+		t.Fatalf("new verifier failed: %v", err)
+	}
+	withDefault, err := NewVerifier(clock.NewFake(tokenEpoch), WithHS256Secret([]byte("secret")), WithDefaultRole(RoleAuthor))
+	if err != nil {
+		t.Fatalf("new verifier failed: %v", err)
+	}
+
+	for _, tc := range []struct {
+		verifier *Verifier
+		roles    []string
+		want     Role
+	}{
+		{verifier, nil, RoleReader},
+		{withDefault, nil, RoleAuthor},
+		{withDefault, []string{"superuser"}, RoleAuthor},
+		{verifier, []string{"Editor"}, RoleEditor},
+		{verifier, []string{"reader", "admin", "author"}, RoleAdmin},
+	} {
+		claims := claimsFor("alice")
+		claims.Roles = tc.roles
+		principal, err := tc.verifier.Verify(mustMint(t, claims, []byte("secret"), ""))
+		if err != nil {
+			t.Fatalf("verify failed: %v", err)
+		}
+		if principal.Role != tc.want {
+			t.Fatalf("roles %q: expected %s, got %s", tc.roles, tc.want, principal.Role)
+		}
+	}
+}
@@ -0,0 +1,61 @@
+// Package auth authenticates gRPC callers and carries the verified
+// principal through the request context.
+package auth
+
+import (
+	"context"
+	"time"
+
+	"github.com/BhaveetKumar/gRPC-server-go/internal/domain"
+)
+
+// Principal is a caller whose credentials have been verified.
+type Principal struct {
+	// Subject is the caller's name, used as the author the request acts
+	// as.
+	Subject   string
+	Role      Role
+	Issuer    string
+	ExpiresAt time.Time
+	// KeyID and Scopes are set for callers that presented an API key, which
+	// may only make the calls its scopes allow.
+	KeyID  string
+	Scopes []string
+}
+
+// HasScope reports whether the principal may make calls that need scope.
+// Token holders are limited by their role alone; API keys need the scope or
+// domain.ScopeAdmin.
+func (p *Principal) HasScope(scope string) bool {
+	if p.KeyID == "" {
+		return true
+	}
+	for _, s := range p.Scopes {
+		if s == scope || s == domain.ScopeAdmin {
+			return true
+		}
+	}
+	return false
+}
+
+// RoleFromContext returns the role of the caller, RoleNone for anonymous
+// requests.
+func RoleFromContext(ctx context.Context) Role {
+	if p := PrincipalFromContext(ctx); p != nil {
+		return p.Role
+	}
+	return RoleNone
+}
+
+type principalKey struct{}
+
+func WithPrincipal(ctx context.Context, p *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+// PrincipalFromContext returns the verified caller, or nil for anonymous
+// requests.
+func PrincipalFromContext(ctx context.Context) *Principal {
+	p, _ := ctx.Value(principalKey{}).(*Principal)
+	return p
+}
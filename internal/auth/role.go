@@ -0,0 +1,57 @@
+package auth
+
+import "strings"
+
+// Role is what a principal may do. Each role includes the ones below it.
+type Role int
+
+const (
+	// RoleNone is the role of anonymous callers.
+	RoleNone Role = iota
+	// RoleReader may read and comment.
+	RoleReader
+	// RoleAuthor may also write posts of their own.
+	RoleAuthor
+	// RoleEditor may also change and moderate anyone's posts.
+	RoleEditor
+	// RoleAdmin may do anything.
+	RoleAdmin
+)
+
+var roleNames = map[Role]string{
+	RoleNone:   "none",
+	RoleReader: "reader",
+	RoleAuthor: "author",
+	RoleEditor: "editor",
+	RoleAdmin:  "admin",
+}
+
+func (r Role) String() string {
+	return roleNames[r]
+}
+
+// ParseRole returns the role called name, ignoring case.
+func ParseRole(name string) (Role, bool) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	for role, roleName := range roleNames {
+		if role != RoleNone && roleName == name {
+			return role, true
+		}
+	}
+	return RoleNone, false
+}
+
+// highestRole returns the highest of the roles named, ignoring unknown
+// names, or def if none is known.
+func highestRole(names []string, def Role) Role {
+	best, found := RoleNone, false
+	for _, name := range names {
+		if role, ok := ParseRole(name); ok && role > best {
+			best, found = role, true
+		}
+	}
+	if !found {
+		return def
+	}
+	return best
+}
@@ -0,0 +1,97 @@
+// Package authz decides which callers may make which calls. Every RPC
+// method is given a Policy up front; the interceptors deny methods that have
+// none, so a new RPC is protected until someone declares who may call it.
+package authz
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/BhaveetKumar/gRPC-server-go/internal/auth"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/domain"
+	apperrors "github.com/BhaveetKumar/gRPC-server-go/internal/errors"
+)
+
+// Policy decides whether the caller in ctx may make a call with req. It
+// returns nil to allow the call, ErrUnauthenticated or ErrPermissionDenied
+// to refuse it, or the error that kept it from deciding. Streaming calls are
+// checked before their request is read, with a nil req.
+type Policy func(ctx context.Context, req any) error
+
+// Policies maps full RPC method names, such as "/blog.v1.BlogService/GetPost",
+// to their policy.
+type Policies map[string]Policy
+
+// OwnersFunc returns the authors a call with req acts for: the author of the
+// post it changes, say, and the byline it sets.
+type OwnersFunc func(ctx context.Context, req any) ([]string, error)
+
+// Public allows anyone, including anonymous callers.
+func Public() Policy {
+	return func(context.Context, any) error {
+		return nil
+	}
+}
+
+// Require allows callers with at least role.
+func Require(role auth.Role) Policy {
+	return func(ctx context.Context, _ any) error {
+		return requireRole(ctx, role)
+	}
+}
+
+// OwnerOr allows callers with at least min who are every author owners
+// names, and callers with at least override whoever the authors are.
+func OwnerOr(min, override auth.Role, owners OwnersFunc) Policy {
+	return func(ctx context.Context, req any) error {
+		if err := requireRole(ctx, min); err != nil {
+			return err
+		}
+		principal := auth.PrincipalFromContext(ctx)
+		if principal.Role >= override {
+			return nil
+		}
+
+		names, err := owners(ctx, req)
+		if err != nil {
+			return err
+		}
+		for _, name := range names {
+			if !domain.SameAuthor(name, principal.Subject) {
+				return apperrors.ErrPermissionDenied
+			}
+		}
+		return nil
+	}
+}
+
+// Scope allows what p allows, except for API keys without scope.
+func Scope(scope string, p Policy) Policy {
+	return func(ctx context.Context, req any) error {
+		if principal := auth.PrincipalFromContext(ctx); principal != nil && !principal.HasScope(scope) {
+			return fmt.Errorf("%w: api key lacks the %s scope", apperrors.ErrPermissionDenied, scope)
+		}
+		return p(ctx, req)
+	}
+}
+
+func requireRole(ctx context.Context, role auth.Role) error {
+	principal := auth.PrincipalFromContext(ctx)
+	switch {
+	case principal == nil:
+		return apperrors.ErrUnauthenticated
+	case principal.Role < role:
+		return apperrors.ErrPermissionDenied
+	default:
+		return nil
+	}
+}
+
+// check applies the policy of method, denying methods without one.
+func (p Policies) check(ctx context.Context, method string, req any) error {
+	policy, ok := p[method]
+	if !ok {
+		return fmt.Errorf("%w: no policy for %s", apperrors.ErrPermissionDenied, method)
+	}
+	return policy(ctx, req)
+}
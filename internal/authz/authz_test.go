@@ -0,0 +1,112 @@
+package authz
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/BhaveetKumar/gRPC-server-go/internal/auth"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/domain"
+	apperrors "github.com/BhaveetKumar/gRPC-server-go/internal/errors"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/logger"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func as(subject string, role auth.Role) context.Context {
+	return auth.WithPrincipal(context.Background(), &auth.Principal{Subject: subject, Role: role})
+}
+
+func ownedBy(names ...string) OwnersFunc {
+	return func(context.Context, any) ([]string, error) {
+		return names, nil
+	}
+}
+
+func TestPolicies(t *testing.T) {
+	anonymous := context.Background()
+	lookupFailed := errors.New("lookup failed")
+
+	for _, tc := range []struct {
+		name   string
+		policy Policy
+		ctx    context.Context
+		want   error
+	}{
+		{"public anonymous", Public(), anonymous, nil},
+		{"require anonymous", Require(auth.RoleReader), anonymous, apperrors.ErrUnauthenticated},
+		{"require too low", Require(auth.RoleEditor), as("alice", auth.RoleAuthor), apperrors.ErrPermissionDenied},
+		{"require higher", Require(auth.RoleEditor), as("alice", auth.RoleAdmin), nil},
+		{"owner", OwnerOr(auth.RoleAuthor, auth.RoleEditor, ownedBy("Alice ")), as("alice", auth.RoleAuthor), nil},
+		{"not owner", OwnerOr(auth.RoleAuthor, auth.RoleEditor, ownedBy("bob")), as("alice", auth.RoleAuthor), apperrors.ErrPermissionDenied},
+		{"one of two owners", OwnerOr(auth.RoleAuthor, auth.RoleEditor, ownedBy("alice", "bob")), as("alice", auth.RoleAuthor), apperrors.ErrPermissionDenied},
+		{"owner below min", OwnerOr(auth.RoleAuthor, auth.RoleEditor, ownedBy("alice")), as("alice", auth.RoleReader), apperrors.ErrPermissionDenied},
+		{"override", OwnerOr(auth.RoleAuthor, auth.RoleEditor, ownedBy("bob")), as("alice", auth.RoleEditor), nil},
+		{"owner anonymous", OwnerOr(auth.RoleReader, auth.RoleEditor, ownedBy("")), anonymous, apperrors.ErrUnauthenticated},
+		{"lookup error", OwnerOr(auth.RoleAuthor, auth.RoleEditor, func(context.Context, any) ([]string, error) {
+			return nil, lookupFailed
+		}), as("alice", auth.RoleAuthor), lookupFailed},
+	} {
+		if err := tc.policy(tc.ctx, nil); err != tc.want {
+			t.Fatalf("%s: expected %v, got %v", tc.name, tc.want, err)
+		}
+	}
+}
+
+func TestScope(t *testing.T) {
+	key := func(scopes ...string) context.Context {
+		return auth.WithPrincipal(context.Background(), &auth.Principal{Subject: "bot", Role: auth.RoleAdmin, KeyID: "k1", Scopes: scopes})
+	}
+	write := Scope(domain.ScopePostsWrite, Require(auth.RoleAuthor))
+
+	for _, tc := range []struct {
+		name string
+		ctx  context.Context
+		want error
+	}{
+		{"token", as("alice", auth.RoleAuthor), nil},
+		{"anonymous", context.Background(), apperrors.ErrUnauthenticated},
+		{"key with scope", key(domain.ScopePostsWrite), nil},
+		{"admin key", key(domain.ScopeAdmin), nil},
+		{"key without scope", key(domain.ScopePostsRead), apperrors.ErrPermissionDenied},
+	} {
+		if err := write(tc.ctx, nil); !errors.Is(err, tc.want) || (tc.want == nil && err != nil) {
+			t.Fatalf("%s: expected %v, got %v", tc.name, tc.want, err)
+		}
+	}
+}
+
+func TestInterceptors_DenyUndeclaredMethods(t *testing.T) {
+	policies := Policies{"/blog.v1.BlogService/GetPost": Public()}
+	log := logger.New()
+	unary := UnaryServerInterceptor(policies, log)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	}
+
+	if resp, err := unary(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/blog.v1.BlogService/GetPost"}, handler); err != nil || resp != "ok" {
+		t.Fatalf("expected a declared method to go through, got %v, %v", resp, err)
+	}
+	if _, err := unary(as("root", auth.RoleAdmin), nil, &grpc.UnaryServerInfo{FullMethod: "/blog.v1.BlogService/NewMethod"}, handler); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("expected an undeclared method to be denied even to admins, got %v", err)
+	}
+
+	stream := StreamServerInterceptor(policies, log)
+	err := stream(nil, &fakeStream{ctx: context.Background()}, &grpc.StreamServerInfo{FullMethod: "/blog.v1.BlogService/NewStream"}, func(interface{}, grpc.ServerStream) error {
+		t.Fatal("expected the handler not to run")
+		return nil
+	})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("expected an undeclared stream to be denied, got %v", err)
+	}
+}
+
+type fakeStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *fakeStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,43 @@
+package authz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apperrors "github.com/BhaveetKumar/gRPC-server-go/internal/errors"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/logger"
+	"google.golang.org/grpc"
+)
+
+// UnaryServerInterceptor applies policies to every call. It must run after
+// the authentication interceptor, which puts the caller into the context.
+func UnaryServerInterceptor(policies Policies, log *logger.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := policies.check(ctx, info.FullMethod, req); err != nil {
+			return nil, refuse(err, info.FullMethod, log)
+		}
+		return handler(ctx, req)
+	}
+}
+
+func StreamServerInterceptor(policies Policies, log *logger.Logger) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := policies.check(ss.Context(), info.FullMethod, nil); err != nil {
+			return refuse(err, info.FullMethod, log)
+		}
+		return handler(srv, ss)
+	}
+}
+
+func refuse(err error, method string, log *logger.Logger) error {
+	log.Error(fmt.Sprintf("refused %s: %v", method, err))
+	// Policies may wrap the sentinel with details for the log; the
+	// caller only gets the sentinel.
+	for _, sentinel := range []error{apperrors.ErrUnauthenticated, apperrors.ErrPermissionDenied} {
+		if errors.Is(err, sentinel) {
+			return apperrors.ToStatus(sentinel, log)
+		}
+	}
+	return apperrors.ToStatus(err, log)
+}
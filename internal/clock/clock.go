@@ -0,0 +1,26 @@
+// Package clock abstracts the passage of time so that code which waits for
+// a moment in the future can be tested without sleeping.
+package clock
+
+import "time"
+
+type Clock interface {
+	Now() time.Time
+	// After delivers the current time once d has elapsed. Non-positive
+	// durations fire immediately.
+	After(d time.Duration) <-chan time.Time
+}
+
+type realClock struct{}
+
+func Real() Clock {
+	return realClock{}
+}
+
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+func (realClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
@@ -0,0 +1,49 @@
+package clock
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFake_AfterFiresOnAdvance(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	f := NewFake(start)
+
+	ch := f.After(time.Hour)
+	if f.Waiters() != 1 {
+		t.Fatalf("expected 1 waiter, got %d", f.Waiters())
+	}
+
+	f.Advance(59 * time.Minute)
+	select {
+	case <-ch:
+		t.Fatal("fired before deadline")
+	default:
+	}
+
+	f.Advance(time.Minute)
+	select {
+	case at := <-ch:
+		if !at.Equal(start.Add(time.Hour)) {
+			t.Fatalf("unexpected fire time: %v", at)
+		}
+	default:
+		t.Fatal("expected waiter to fire at deadline")
+	}
+	if f.Waiters() != 0 {
+		t.Fatalf("expected no waiters, got %d", f.Waiters())
+	}
+}
+
+func TestFake_AfterNonPositiveFiresImmediately(t *testing.T) {
+	f := NewFake(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	select {
+	case <-f.After(0):
+	default:
+		t.Fatal("expected immediate fire")
+	}
+	if f.Waiters() != 0 {
+		t.Fatalf("expected no waiters, got %d", f.Waiters())
+	}
+}
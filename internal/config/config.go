@@ -1,22 +1,146 @@
 package config
 
+import "time"
+
 type ServerConfig struct {
 	Host string
 	Port int
+	// TLS is served when TLSCertFile and TLSKeyFile are set. Clients with
+	// a certificate are verified against TLSClientCAFile, and
+	// TLSRequireClientCert turns away those without one (mutual TLS).
+	TLSCertFile          string
+	TLSKeyFile           string
+	TLSClientCAFile      string
+	TLSRequireClientCert bool
 }
 
 type ClientConfig struct {
 	ServerAddress  string
 	TimeoutSeconds int
+	// Author is sent with every request so the server shows that author's
+	// unpublished posts.
+	Author string
+	// Token is sent as the bearer token of every request.
+	Token string
+	// APIKey is sent when Token is not set, to servers that accept API
+	// keys.
+	APIKey string
+	// TLS connects over TLS, verifying the server against TLSCAFile or the
+	// system roots. TLSCertFile and TLSKeyFile are presented to servers
+	// that ask for a client certificate, and TLSServerName overrides the
+	// name the server certificate is checked for.
+	TLS           bool
+	TLSCAFile     string
+	TLSCertFile   string
+	TLSKeyFile    string
+	TLSServerName string
 }
 
+const (
+	StorageBackendMemory = "memory"
+	StorageBackendFile   = "file"
+	StorageBackendSQL    = "sql"
+)
+
 type LogConfig struct {
 	EnableRequestID bool
 }
 
+type StorageConfig struct {
+	Backend       string
+	DataDir       string
+	SnapshotEvery int
+}
+
+type DatabaseConfig struct {
+	Driver                 string
+	DSN                    string
+	MaxOpenConns           int
+	MaxIdleConns           int
+	ConnMaxLifetimeSeconds int
+}
+
+// RevisionConfig bounds the revision history kept per post. Zero means no
+// limit.
+type RevisionConfig struct {
+	MaxPerPost int
+	MaxAgeDays int
+}
+
+// TrashConfig sets how long deleted posts stay restorable. Zero keeps them
+// until they are purged by hand.
+type TrashConfig struct {
+	RetentionDays int
+}
+
+// ModerationConfig enables the built-in moderation rules. Zero values and an
+// empty word list turn a rule off.
+type ModerationConfig struct {
+	BannedWords       []string
+	MaxLinks          int
+	MaxRepeats        int
+	FirstPostApproval bool
+	// Moderators are the callers allowed to review pending posts.
+	Moderators []string
+}
+
+// IDConfig selects how post IDs are generated: uuidv4, uuidv7, ulid or
+// snowflake. SnowflakeNode must differ between servers sharing storage.
+type IDConfig struct {
+	Generator     string
+	SnowflakeNode int
+}
+
+// AuthConfig turns on authentication when HS256Secret or JWKSFile is set,
+// or APIKeys is: requests must then carry a bearer token signed with the
+// secret or one of the keys, or an API key issued by the server. Issuer and
+// Audience, when set, must match a token's claims. AllowAnonymous lets
+// requests without credentials through as anonymous. DefaultRole is the
+// role of tokens without a roles claim.
+type AuthConfig struct {
+	HS256Secret    string
+	JWKSFile       string
+	Issuer         string
+	Audience       string
+	AllowAnonymous bool
+	DefaultRole    string
+	APIKeys        bool
+}
+
+// RateLimit lets a caller make Count calls every Per, in bursts of up to
+// Burst calls. The zero value is no limit.
+type RateLimit struct {
+	Count int
+	Per   time.Duration
+	Burst int
+}
+
+// RateLimitConfig limits how fast each caller may call each method. Methods
+// maps method names, such as CreatePost, to their limit; other methods get
+// Default.
+type RateLimitConfig struct {
+	Default RateLimit
+	Methods map[string]RateLimit
+}
+
+// QuotaConfig caps how many posts an author may create or update per UTC
+// day. Zero means no cap.
+type QuotaConfig struct {
+	DailyPostWrites int
+}
+
 type AppConfig struct {
 	Environment string
 	Server      ServerConfig
 	Client      ClientConfig
 	Log         LogConfig
+	Storage     StorageConfig
+	Database    DatabaseConfig
+	Revisions   RevisionConfig
+	Trash       TrashConfig
+	Moderation  ModerationConfig
+	IDs         IDConfig
+	Auth        AuthConfig
+	RateLimit   RateLimitConfig
+	Quotas      QuotaConfig
 }
@@ -6,6 +6,7 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"time"
 )
 
 const defaultConfigPath = ".env"
@@ -40,22 +41,186 @@ func Load(path string) (*AppConfig, error) {
 
 	port, _ := strconv.Atoi(env["SERVER_PORT"])
 	timeout, _ := strconv.Atoi(env["CLIENT_TIMEOUT_SECONDS"])
+	requireClientCert, _ := strconv.ParseBool(env["SERVER_TLS_REQUIRE_CLIENT_CERT"])
+	clientTLS, _ := strconv.ParseBool(env["CLIENT_TLS"])
 	enableRequestID, _ := strconv.ParseBool(env["LOG_ENABLE_REQUEST_ID"])
+	snapshotEvery, _ := strconv.Atoi(env["STORAGE_SNAPSHOT_EVERY"])
+
+	maxOpenConns, _ := strconv.Atoi(env["DB_MAX_OPEN_CONNS"])
+	maxIdleConns, _ := strconv.Atoi(env["DB_MAX_IDLE_CONNS"])
+	connMaxLifetime, _ := strconv.Atoi(env["DB_CONN_MAX_LIFETIME_SECONDS"])
+
+	revisionsMaxPerPost, _ := strconv.Atoi(env["REVISIONS_MAX_PER_POST"])
+	revisionsMaxAgeDays, _ := strconv.Atoi(env["REVISIONS_MAX_AGE_DAYS"])
+	trashRetentionDays, _ := strconv.Atoi(env["TRASH_RETENTION_DAYS"])
+
+	maxLinks, _ := strconv.Atoi(env["MODERATION_MAX_LINKS"])
+	maxRepeats, _ := strconv.Atoi(env["MODERATION_MAX_REPEATS"])
+	firstPostApproval, _ := strconv.ParseBool(env["MODERATION_FIRST_POST_APPROVAL"])
+
+	allowAnonymous, _ := strconv.ParseBool(env["AUTH_ALLOW_ANONYMOUS"])
+	apiKeys, _ := strconv.ParseBool(env["AUTH_API_KEYS"])
+	snowflakeNode, _ := strconv.Atoi(env["ID_SNOWFLAKE_NODE"])
+
+	dailyPostWrites, _ := strconv.Atoi(env["QUOTA_DAILY_POST_WRITES"])
+
+	defaultLimit, err := parseRateLimit(env["RATE_LIMIT_DEFAULT"])
+	if err != nil {
+		return nil, fmt.Errorf("RATE_LIMIT_DEFAULT: %w", err)
+	}
+	methodLimits, err := parseMethodLimits(env["RATE_LIMIT_METHODS"])
+	if err != nil {
+		return nil, fmt.Errorf("RATE_LIMIT_METHODS: %w", err)
+	}
+
+	backend := env["STORAGE_BACKEND"]
+	if backend == "" {
+		backend = StorageBackendMemory
+	}
 
 	cfg := &AppConfig{
 		Environment: env["ENVIRONMENT"],
 		Server: ServerConfig{
 			Host: env["SERVER_HOST"],
 			Port: port,
+
+			TLSCertFile:          env["SERVER_TLS_CERT_FILE"],
+			TLSKeyFile:           env["SERVER_TLS_KEY_FILE"],
+			TLSClientCAFile:      env["SERVER_TLS_CLIENT_CA_FILE"],
+			TLSRequireClientCert: requireClientCert,
 		},
 		Client: ClientConfig{
 			ServerAddress:  env["CLIENT_SERVER_ADDRESS"],
 			TimeoutSeconds: timeout,
+			Author:         env["CLIENT_AUTHOR"],
+			Token:          env["CLIENT_TOKEN"],
+			APIKey:         env["CLIENT_API_KEY"],
+
+			TLS:           clientTLS,
+			TLSCAFile:     env["CLIENT_TLS_CA_FILE"],
+			TLSCertFile:   env["CLIENT_TLS_CERT_FILE"],
+			TLSKeyFile:    env["CLIENT_TLS_KEY_FILE"],
+			TLSServerName: env["CLIENT_TLS_SERVER_NAME"],
 		},
 		Log: LogConfig{
 			EnableRequestID: enableRequestID,
 		},
+		Storage: StorageConfig{
+			Backend:       backend,
+			DataDir:       env["STORAGE_DATA_DIR"],
+			SnapshotEvery: snapshotEvery,
+		},
+		Database: DatabaseConfig{
+			Driver:                 env["DB_DRIVER"],
+			DSN:                    env["DB_DSN"],
+			MaxOpenConns:           maxOpenConns,
+			MaxIdleConns:           maxIdleConns,
+			ConnMaxLifetimeSeconds: connMaxLifetime,
+		},
+		Revisions: RevisionConfig{
+			MaxPerPost: revisionsMaxPerPost,
+			MaxAgeDays: revisionsMaxAgeDays,
+		},
+		Trash: TrashConfig{
+			RetentionDays: trashRetentionDays,
+		},
+		Moderation: ModerationConfig{
+			BannedWords:       splitList(env["MODERATION_BANNED_WORDS"]),
+			MaxLinks:          maxLinks,
+			MaxRepeats:        maxRepeats,
+			FirstPostApproval: firstPostApproval,
+			Moderators:        splitList(env["MODERATION_MODERATORS"]),
+		},
+		IDs: IDConfig{
+			Generator:     env["ID_GENERATOR"],
+			SnowflakeNode: snowflakeNode,
+		},
+		Auth: AuthConfig{
+			HS256Secret:    env["AUTH_JWT_HS256_SECRET"],
+			JWKSFile:       env["AUTH_JWT_JWKS_FILE"],
+			Issuer:         env["AUTH_JWT_ISSUER"],
+			Audience:       env["AUTH_JWT_AUDIENCE"],
+			AllowAnonymous: allowAnonymous,
+			DefaultRole:    env["AUTH_DEFAULT_ROLE"],
+			APIKeys:        apiKeys,
+		},
+		RateLimit: RateLimitConfig{
+			Default: defaultLimit,
+			Methods: methodLimits,
+		},
+		Quotas: QuotaConfig{
+			DailyPostWrites: dailyPostWrites,
+		},
 	}
 
 	return cfg, nil
 }
+
+// splitList parses a comma-separated value, dropping empty entries.
+func splitList(raw string) []string {
+	var items []string
+	for _, item := range strings.Split(raw, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// parseMethodLimits parses a comma-separated list of method=limit pairs,
+// such as "CreatePost=10/m,UpdatePost=30/m:10".
+func parseMethodLimits(raw string) (map[string]RateLimit, error) {
+	limits := make(map[string]RateLimit)
+	for _, item := range splitList(raw) {
+		method, spec, ok := strings.Cut(item, "=")
+		method = strings.TrimSpace(method)
+		if !ok || method == "" {
+			return nil, fmt.Errorf("%q is not method=limit", item)
+		}
+		limit, err := parseRateLimit(spec)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", method, err)
+		}
+		limits[method] = limit
+	}
+	return limits, nil
+}
+
+// parseRateLimit parses a limit written as count/unit[:burst], where unit is
+// s, m or h, such as "10/m" or "100/h:20". The burst defaults to the count.
+// An empty limit is no limit.
+func parseRateLimit(raw string) (RateLimit, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return RateLimit{}, nil
+	}
+
+	rate, burst, hasBurst := strings.Cut(raw, ":")
+	count, unit, ok := strings.Cut(rate, "/")
+	if !ok {
+		return RateLimit{}, fmt.Errorf("limit %q is not count/unit", raw)
+	}
+
+	var limit RateLimit
+	var err error
+	if limit.Count, err = strconv.Atoi(strings.TrimSpace(count)); err != nil || limit.Count <= 0 {
+		return RateLimit{}, fmt.Errorf("limit %q needs a positive count", raw)
+	}
+	switch strings.TrimSpace(unit) {
+	case "s":
+		limit.Per = time.Second
+	case "m":
+		limit.Per = time.Minute
+	case "h":
+		limit.Per = time.Hour
+	default:
+		return RateLimit{}, fmt.Errorf("limit %q needs a unit of s, m or h", raw)
+	}
+	limit.Burst = limit.Count
+	if hasBurst {
+		if limit.Burst, err = strconv.Atoi(strings.TrimSpace(burst)); err != nil || limit.Burst <= 0 {
+			return RateLimit{}, fmt.Errorf("limit %q needs a positive burst", raw)
+		}
+	}
+	return limit, nil
+}
@@ -0,0 +1,135 @@
+// Package diff computes line and word level differences between texts and
+// groups them into unified-diff style hunks.
+package diff
+
+import "context"
+
+// maxEditDistance bounds the search for a shortest edit script, which keeps
+// state growing with the square of the number of changes. Texts further
+// apart than this are diffed as one replacement of everything between their
+// common prefix and suffix.
+const maxEditDistance = 1000
+
+type Op int
+
+const (
+	Equal Op = iota
+	Insert
+	Delete
+)
+
+type Edit struct {
+	Op   Op
+	Text string
+}
+
+// Compute returns a shortest edit script turning a into b, using Myers'
+// O(ND) algorithm. Deletions are placed before insertions where both are
+// possible. Beyond maxEditDistance changes the script is no longer the
+// shortest: the differing middle is deleted and inserted whole.
+func Compute(ctx context.Context, a, b []string) ([]Edit, error) {
+	n, m := len(a), len(b)
+	limit := min(n+m, maxEditDistance)
+	offset := limit + 1
+	v := make([]int, 2*limit+3)
+
+	// trace[d] holds v[k] for k in [-d, d] as it was before step d, which
+	// is all the backtrack needs to find the move made at that step.
+	var trace [][]int
+	found := false
+search:
+	for d := 0; d <= limit; d++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		trace = append(trace, append([]int(nil), v[offset-d:offset+d+1]...))
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			v[offset+k] = x
+			if x >= n && y >= m {
+				found = true
+				break search
+			}
+		}
+	}
+	if !found {
+		return replaceAll(a, b), nil
+	}
+
+	edits := make([]Edit, 0, n+m)
+	x, y := n, m
+	for d := len(trace) - 1; d >= 0; d-- {
+		prev := trace[d]
+		at := func(k int) int { return prev[k+d] }
+
+		prevX, prevY := 0, 0
+		if d > 0 {
+			k := x - y
+			prevK := k - 1
+			if k == -d || (k != d && at(k-1) < at(k+1)) {
+				prevK = k + 1
+			}
+			prevX = at(prevK)
+			prevY = prevX - prevK
+		}
+
+		for x > prevX && y > prevY {
+			edits = append(edits, Edit{Op: Equal, Text: a[x-1]})
+			x--
+			y--
+		}
+		if d == 0 {
+			break
+		}
+		if x == prevX {
+			edits = append(edits, Edit{Op: Insert, Text: b[y-1]})
+			y--
+		} else {
+			edits = append(edits, Edit{Op: Delete, Text: a[x-1]})
+			x--
+		}
+	}
+
+	for i, j := 0, len(edits)-1; i < j; i, j = i+1, j-1 {
+		edits[i], edits[j] = edits[j], edits[i]
+	}
+	return edits, nil
+}
+
+// replaceAll returns an edit script that keeps the common prefix and suffix
+// of a and b and replaces everything between them.
+func replaceAll(a, b []string) []Edit {
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	edits := make([]Edit, 0, len(a)+len(b)-prefix-suffix)
+	for _, s := range a[:prefix] {
+		edits = append(edits, Edit{Op: Equal, Text: s})
+	}
+	for _, s := range a[prefix : len(a)-suffix] {
+		edits = append(edits, Edit{Op: Delete, Text: s})
+	}
+	for _, s := range b[prefix : len(b)-suffix] {
+		edits = append(edits, Edit{Op: Insert, Text: s})
+	}
+	for _, s := range a[len(a)-suffix:] {
+		edits = append(edits, Edit{Op: Equal, Text: s})
+	}
+	return edits
+}
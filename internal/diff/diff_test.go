@@ -0,0 +1,204 @@
+package diff
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+// apply rebuilds both sides from an edit script.
+func apply(edits []Edit) (a, b []string) {
+	for _, e := range edits {
+		if e.Op != Insert {
+			a = append(a, e.Text)
+		}
+		if e.Op != Delete {
+			b = append(b, e.Text)
+		}
+	}
+	return a, b
+}
+
+func mustCompute(t *testing.T, a, b []string) []Edit {
+	t.Helper()
+
+	edits, err := Compute(context.Background(), a, b)
+	if err != nil {
+		t.Fatalf("compute failed: %v", err)
+	}
+	return edits
+}
+
+func mustLines(t *testing.T, a, b string, contextLines int) []Hunk {
+	t.Helper()
+
+	hunks, err := Lines(context.Background(), a, b, contextLines)
+	if err != nil {
+		t.Fatalf("lines failed: %v", err)
+	}
+	return hunks
+}
+
+func changes(edits []Edit) int {
+	n := 0
+	for _, e := range edits {
+		if e.Op != Equal {
+			n++
+		}
+	}
+	return n
+}
+
+func TestCompute(t *testing.T) {
+	tests := []struct {
+		a, b    string
+		changes int
+	}{
+		{"", "", 0},
+		{"abc", "abc", 0},
+		{"", "abc", 3},
+		{"abc", "", 3},
+		{"abcabba", "cbabac", 5},
+		{"abcdef", "abxdef", 2},
+	}
+
+	for _, tt := range tests {
+		a, b := strings.Split(tt.a, ""), strings.Split(tt.b, "")
+		edits := mustCompute(t, a, b)
+
+		gotA, gotB := apply(edits)
+		if strings.Join(gotA, "") != tt.a || strings.Join(gotB, "") != tt.b {
+			t.Fatalf("%q -> %q: script does not reproduce inputs: %v", tt.a, tt.b, edits)
+		}
+		if changes(edits) != tt.changes {
+			t.Fatalf("%q -> %q: expected %d changes, got %d: %v", tt.a, tt.b, tt.changes, changes(edits), edits)
+		}
+	}
+}
+
+func TestCompute_RandomInputsRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	alphabet := []string{"a", "b", "c", "d"}
+	random := func() []string {
+		out := make([]string, rng.Intn(30))
+		for i := range out {
+			out[i] = alphabet[rng.Intn(len(alphabet))]
+		}
+		return out
+	}
+
+	for i := 0; i < 500; i++ {
+		a, b := random(), random()
+		gotA, gotB := apply(mustCompute(t, a, b))
+		if strings.Join(gotA, "") != strings.Join(a, "") || strings.Join(gotB, "") != strings.Join(b, "") {
+			t.Fatalf("round trip failed for %v -> %v", a, b)
+		}
+	}
+}
+
+func TestCompute_FarApartFallsBack(t *testing.T) {
+	var a, b []string
+	a = append(a, "same start")
+	b = append(b, "same start")
+	for i := 0; i < maxEditDistance; i++ {
+		a = append(a, fmt.Sprintf("old %d", i))
+		b = append(b, fmt.Sprintf("new %d", i))
+	}
+	a = append(a, "same end")
+	b = append(b, "same end")
+
+	edits := mustCompute(t, a, b)
+	gotA, gotB := apply(edits)
+	if strings.Join(gotA, "\n") != strings.Join(a, "\n") || strings.Join(gotB, "\n") != strings.Join(b, "\n") {
+		t.Fatal("script does not reproduce inputs")
+	}
+	if changes(edits) != 2*maxEditDistance || edits[0].Op != Equal || edits[len(edits)-1].Op != Equal {
+		t.Fatalf("expected the middle to be replaced whole, got %d changes", changes(edits))
+	}
+	if edits[1].Op != Delete || edits[maxEditDistance+1].Op != Insert {
+		t.Fatal("expected all deletions before the insertions")
+	}
+}
+
+func TestCompute_Canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := Compute(ctx, []string{"a"}, []string{"b"}); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected cancellation, got %v", err)
+	}
+	if _, err := Lines(ctx, "a\n", "b\n", 3); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected cancellation, got %v", err)
+	}
+}
+
+func TestLines_HunksAndUnified(t *testing.T) {
+	old := "one\ntwo\nthree\nfour\nfive\nsix\nseven\neight\nnine\nten\n"
+	new := "one\ntwo\nthree\nFOUR\nfive\nsix\nseven\neight\nnine\nten\neleven\n"
+
+	hunks := mustLines(t, old, new, 1)
+	if len(hunks) != 2 {
+		t.Fatalf("expected 2 hunks, got %d: %+v", len(hunks), hunks)
+	}
+
+	got := Unified("a", "b", hunks)
+	want := "--- a\n+++ b\n" +
+		"@@ -3,3 +3,3 @@\n three\n-four\n+FOUR\n five\n" +
+		"@@ -10,1 +10,2 @@\n ten\n+eleven\n"
+	if got != want {
+		t.Fatalf("unexpected unified diff:\n%s\nwant:\n%s", got, want)
+	}
+
+	// With more context the two changes share one hunk.
+	if merged := mustLines(t, old, new, 3); len(merged) != 1 {
+		t.Fatalf("expected overlapping hunks to merge, got %d", len(merged))
+	}
+	if mustLines(t, old, old, 3) != nil || Unified("a", "b", nil) != "" {
+		t.Fatal("identical texts should produce no hunks")
+	}
+}
+
+func TestLines_EmptySideStartsBeforeHunk(t *testing.T) {
+	hunks := mustLines(t, "", "new line\n", 3)
+	if len(hunks) != 1 {
+		t.Fatalf("expected one hunk, got %d", len(hunks))
+	}
+	h := hunks[0]
+	if h.OldStart != 0 || h.OldLines != 0 || h.NewStart != 1 || h.NewLines != 1 {
+		t.Fatalf("unexpected hunk range: %+v", h)
+	}
+}
+
+func TestLines_WordLevelChanges(t *testing.T) {
+	hunks := mustLines(t, "the quick brown fox", "the slow brown fox!", 0)
+	if len(hunks) != 1 || len(hunks[0].Lines) != 2 {
+		t.Fatalf("unexpected hunks: %+v", hunks)
+	}
+
+	del, ins := hunks[0].Lines[0], hunks[0].Lines[1]
+	var removed, added []string
+	for _, w := range del.Words {
+		if w.Op == Delete {
+			removed = append(removed, w.Text)
+		}
+	}
+	for _, w := range ins.Words {
+		if w.Op == Insert {
+			added = append(added, w.Text)
+		}
+	}
+	if strings.Join(removed, "|") != "quick" || strings.Join(added, "|") != "slow|!" {
+		t.Fatalf("unexpected word changes: removed %q, added %q", removed, added)
+	}
+}
+
+func TestSplitWords(t *testing.T) {
+	got := SplitWords("Hello,  wörld_1!")
+	want := []string{"Hello", ",", "  ", "wörld_1", "!"}
+	if strings.Join(got, "|") != strings.Join(want, "|") {
+		t.Fatalf("expected %q, got %q", want, got)
+	}
+}
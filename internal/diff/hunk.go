@@ -0,0 +1,218 @@
+package diff
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// Line is one line of a hunk. Changed lines that replace each other in place
+// also carry a word level diff against their counterpart: Equal and Delete
+// segments on a deleted line, Equal and Insert segments on an inserted one.
+type Line struct {
+	Op    Op
+	Text  string
+	Words []Edit
+}
+
+// Hunk is a run of changed lines with surrounding context. Starts are 1-based
+// line numbers; a side with no lines starts at the line before the hunk, as
+// in unified diffs.
+type Hunk struct {
+	OldStart int
+	OldLines int
+	NewStart int
+	NewLines int
+	Lines    []Line
+}
+
+// Lines diffs a and b line by line and groups the result into hunks with up
+// to context unchanged lines around each change. Hunks whose context would
+// overlap are merged. It gives up with ctx's error once ctx is done.
+func Lines(ctx context.Context, a, b string, contextLines int) ([]Hunk, error) {
+	if contextLines < 0 {
+		contextLines = 0
+	}
+
+	edits, err := Compute(ctx, SplitLines(a), SplitLines(b))
+	if err != nil {
+		return nil, err
+	}
+
+	oldAt := make([]int, len(edits)+1)
+	newAt := make([]int, len(edits)+1)
+	for i, e := range edits {
+		oldAt[i+1], newAt[i+1] = oldAt[i], newAt[i]
+		if e.Op != Insert {
+			oldAt[i+1]++
+		}
+		if e.Op != Delete {
+			newAt[i+1]++
+		}
+	}
+
+	var hunks []Hunk
+	for i := 0; i < len(edits); {
+		if edits[i].Op == Equal {
+			i++
+			continue
+		}
+
+		last := i
+		for j := i; j < len(edits); j++ {
+			if edits[j].Op != Equal {
+				last = j
+			} else if j-last > 2*contextLines {
+				break
+			}
+		}
+
+		start := max(i-contextLines, 0)
+		end := min(last+contextLines+1, len(edits))
+		hunk, err := newHunk(ctx, edits[start:end], oldAt[start], newAt[start])
+		if err != nil {
+			return nil, err
+		}
+		hunks = append(hunks, hunk)
+		i = end
+	}
+
+	return hunks, nil
+}
+
+func newHunk(ctx context.Context, edits []Edit, oldBefore, newBefore int) (Hunk, error) {
+	h := Hunk{Lines: make([]Line, 0, len(edits))}
+	for _, e := range edits {
+		h.Lines = append(h.Lines, Line{Op: e.Op, Text: e.Text})
+		if e.Op != Insert {
+			h.OldLines++
+		}
+		if e.Op != Delete {
+			h.NewLines++
+		}
+	}
+
+	h.OldStart, h.NewStart = oldBefore, newBefore
+	if h.OldLines > 0 {
+		h.OldStart++
+	}
+	if h.NewLines > 0 {
+		h.NewStart++
+	}
+
+	if err := refineWords(ctx, h.Lines); err != nil {
+		return Hunk{}, err
+	}
+	return h, nil
+}
+
+// refineWords pairs each block of deleted lines with the inserted lines that
+// directly follow it and diffs the pairs word by word.
+func refineWords(ctx context.Context, lines []Line) error {
+	for i := 0; i < len(lines); {
+		if lines[i].Op != Delete {
+			i++
+			continue
+		}
+
+		dels := i
+		for i < len(lines) && lines[i].Op == Delete {
+			i++
+		}
+		ins := i
+		for i < len(lines) && lines[i].Op == Insert {
+			i++
+		}
+
+		for k := 0; dels+k < ins && ins+k < i; k++ {
+			old, new := &lines[dels+k], &lines[ins+k]
+			words, err := Compute(ctx, SplitWords(old.Text), SplitWords(new.Text))
+			if err != nil {
+				return err
+			}
+			for _, e := range words {
+				if e.Op != Insert {
+					old.Words = append(old.Words, e)
+				}
+				if e.Op != Delete {
+					new.Words = append(new.Words, e)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// SplitLines splits s into lines without their terminators. A trailing
+// newline does not start an extra empty line.
+func SplitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
+
+// SplitWords splits s into runs of letters and digits, runs of whitespace and
+// single punctuation characters. Joining the result gives back s.
+func SplitWords(s string) []string {
+	var words []string
+	start := 0
+	prev := -1
+	for i, r := range s {
+		class := wordClass(r)
+		if i > start && (class != prev || class == classOther) {
+			words = append(words, s[start:i])
+			start = i
+		}
+		prev = class
+	}
+	if start < len(s) {
+		words = append(words, s[start:])
+	}
+	return words
+}
+
+const (
+	classWord = iota
+	classSpace
+	classOther
+)
+
+func wordClass(r rune) int {
+	switch {
+	case unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_':
+		return classWord
+	case unicode.IsSpace(r):
+		return classSpace
+	default:
+		return classOther
+	}
+}
+
+// Unified renders hunks as a unified diff between files named oldName and
+// newName. It returns the empty string when there are no hunks.
+func Unified(oldName, newName string, hunks []Hunk) string {
+	if len(hunks) == 0 {
+		return ""
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "--- %s\n+++ %s\n", oldName, newName)
+	for _, h := range hunks {
+		fmt.Fprintf(&b, "@@ -%d,%d +%d,%d @@\n", h.OldStart, h.OldLines, h.NewStart, h.NewLines)
+		for _, line := range h.Lines {
+			switch line.Op {
+			case Equal:
+				b.WriteByte(' ')
+			case Insert:
+				b.WriteByte('+')
+			case Delete:
+				b.WriteByte('-')
+			}
+			b.WriteString(line.Text)
+			b.WriteByte('\n')
+		}
+	}
+	return b.String()
+}
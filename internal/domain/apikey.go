@@ -0,0 +1,82 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/BhaveetKumar/gRPC-server-go/internal/errors"
+)
+
+// API key scopes. A key acts as its owner but only for the calls its scopes
+// allow; ScopeAdmin allows everything.
+const (
+	ScopePostsRead  = "posts:read"
+	ScopePostsWrite = "posts:write"
+	ScopeAdmin      = "admin"
+)
+
+// ValidScope reports whether scope is one of the known scopes.
+func ValidScope(scope string) bool {
+	return scope == ScopePostsRead || scope == ScopePostsWrite || scope == ScopeAdmin
+}
+
+// APIKey is a long-lived credential for callers that cannot obtain tokens,
+// such as batch jobs. Only a hash of its secret is stored; the secret itself
+// is shown once, when the key is issued.
+type APIKey struct {
+	ID string
+	// Name describes what the key is for.
+	Name string
+	// Owner is the caller the key acts as.
+	Owner      string
+	Scopes     []string
+	SecretHash string
+	CreatedAt  time.Time
+	// ExpiresAt is zero for keys that do not expire.
+	ExpiresAt  time.Time
+	LastUsedAt time.Time
+	RevokedAt  time.Time
+}
+
+func (k *APIKey) Validate() error {
+	if k == nil || k.ID == "" || k.Name == "" || k.Owner == "" || k.SecretHash == "" || len(k.Scopes) == 0 {
+		return errors.ErrInvalidInput
+	}
+	for _, scope := range k.Scopes {
+		if !ValidScope(scope) {
+			return errors.ErrInvalidInput
+		}
+	}
+	if !k.ExpiresAt.IsZero() && !k.ExpiresAt.After(k.CreatedAt) {
+		return errors.ErrInvalidInput
+	}
+	return nil
+}
+
+// Active reports whether the key may be used at now.
+func (k *APIKey) Active(now time.Time) bool {
+	return k.RevokedAt.IsZero() && (k.ExpiresAt.IsZero() || now.Before(k.ExpiresAt))
+}
+
+func (k *APIKey) Revoked() bool {
+	return !k.RevokedAt.IsZero()
+}
+
+// HasScope reports whether the key allows what scope names.
+func (k *APIKey) HasScope(scope string) bool {
+	for _, s := range k.Scopes {
+		if s == scope || s == ScopeAdmin {
+			return true
+		}
+	}
+	return false
+}
+
+func (k *APIKey) Clone() *APIKey {
+	if k == nil {
+		return nil
+	}
+
+	clone := *k
+	clone.Scopes = append([]string(nil), k.Scopes...)
+	return &clone
+}
@@ -0,0 +1,66 @@
+package domain
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/BhaveetKumar/gRPC-server-go/internal/errors"
+)
+
+// Author is the profile behind a post byline. Author names are unique
+// ignoring case and spacing, so "John Doe" and "john  doe" are one author.
+type Author struct {
+	ID        string
+	Name      string
+	Bio       string
+	AvatarURL string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	Version   int64
+}
+
+func (a *Author) Validate() error {
+	if a == nil || CleanAuthorName(a.Name) == "" {
+		return errors.ErrInvalidInput
+	}
+
+	if a.AvatarURL != "" {
+		u, err := url.Parse(a.AvatarURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return errors.ErrInvalidInput
+		}
+	}
+
+	return nil
+}
+
+func (a *Author) ETag() string {
+	return strconv.Quote(strconv.FormatInt(a.Version, 10))
+}
+
+func (a *Author) Clone() *Author {
+	if a == nil {
+		return nil
+	}
+
+	clone := *a
+	return &clone
+}
+
+// CleanAuthorName trims name and collapses runs of whitespace, which is how
+// author names are stored.
+func CleanAuthorName(name string) string {
+	return strings.Join(strings.Fields(name), " ")
+}
+
+// NormalizeAuthorName is the key author names are compared by.
+func NormalizeAuthorName(name string) string {
+	return strings.ToLower(CleanAuthorName(name))
+}
+
+// SameAuthor reports whether a and b name the same author.
+func SameAuthor(a, b string) bool {
+	return a != "" && NormalizeAuthorName(a) == NormalizeAuthorName(b)
+}
@@ -0,0 +1,45 @@
+package domain
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/BhaveetKumar/gRPC-server-go/internal/errors"
+)
+
+// Comment is a reader comment on a post. Replies point at the comment they
+// answer through ParentID, so threads nest to any depth.
+type Comment struct {
+	ID     string
+	PostID string
+	// ParentID is empty for a top-level comment.
+	ParentID  string
+	Author    string
+	Content   string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	Version   int64
+	// Deleted marks a tombstone: a deleted comment that is kept, without its
+	// author and content, so that its replies stay in place.
+	Deleted bool
+}
+
+func (c *Comment) Validate() error {
+	if c == nil || c.PostID == "" || c.Author == "" || c.Content == "" {
+		return errors.ErrInvalidInput
+	}
+	return nil
+}
+
+func (c *Comment) ETag() string {
+	return strconv.Quote(strconv.FormatInt(c.Version, 10))
+}
+
+func (c *Comment) Clone() *Comment {
+	if c == nil {
+		return nil
+	}
+
+	clone := *c
+	return &clone
+}
@@ -0,0 +1,21 @@
+package domain
+
+import "time"
+
+type PostEventType int
+
+const (
+	PostCreated PostEventType = iota + 1
+	PostUpdated
+	PostDeleted
+)
+
+type PostEvent struct {
+	// Epoch identifies the event stream that numbered the event; sequences
+	// only compare within one epoch, which starts over with the server.
+	Epoch      string
+	Sequence   uint64
+	Type       PostEventType
+	Post       *Post
+	OccurredAt time.Time
+}
@@ -0,0 +1,37 @@
+package domain
+
+import "time"
+
+type ModerationState string
+
+const (
+	ModerationApproved ModerationState = "approved"
+	ModerationPending  ModerationState = "pending"
+	ModerationRejected ModerationState = "rejected"
+)
+
+// Moderation records the outcome of moderating a post's latest content.
+// Posts stored before moderation existed have the zero value and count as
+// approved.
+type Moderation struct {
+	State ModerationState
+	// Reason explains a pending or rejected state.
+	Reason string
+	// Moderator is the caller that approved or rejected the post by hand;
+	// it is empty when the moderation rules decided.
+	Moderator string
+	// DecidedAt is when the post entered its current state.
+	DecidedAt time.Time
+}
+
+// Current returns the moderation state, reporting the zero value as approved.
+func (m Moderation) Current() ModerationState {
+	if m.State == "" {
+		return ModerationApproved
+	}
+	return m.State
+}
+
+func (m Moderation) Approved() bool {
+	return m.Current() == ModerationApproved
+}
@@ -1,14 +1,29 @@
 package domain
 
-import "github.com/BhaveetKumar/gRPC-server-go/internal/errors"
+import (
+	"strconv"
+	"time"
+
+	"github.com/BhaveetKumar/gRPC-server-go/internal/errors"
+)
 
 type Post struct {
-	ID              string
-	Title           string
-	Content         string
-	Author          string
+	ID      string
+	Title   string
+	Content string
+	Author  string
+	// AuthorID points to the Author record whose name is the Author byline.
+	AuthorID string
+	// Slug addresses the post in URLs. It is generated from the title and
+	// unique among all posts, including the slugs they held before.
+	Slug            string
 	PublicationDate string
 	Tags            []string
+	Status          PostStatus
+	Version         int64
+	// DeletedAt is set while the post is in the trash.
+	DeletedAt  time.Time
+	Moderation Moderation
 }
 
 func (p *Post) Validate() error {
@@ -20,5 +35,73 @@ func (p *Post) Validate() error {
 		return errors.ErrInvalidInput
 	}
 
+	if !p.Status.Valid() {
+		return errors.ErrInvalidInput
+	}
+
+	if p.PublicationDate != "" {
+		if _, err := ParsePublicationDate(p.PublicationDate); err != nil {
+			return errors.ErrInvalidInput
+		}
+	}
+
+	// A scheduled post is published by the scheduler at its publication
+	// time, so it must have one.
+	if p.Status == StatusScheduled && p.PublicationDate == "" {
+		return errors.ErrInvalidInput
+	}
+
 	return nil
 }
+
+// ParsePublicationDate accepts an RFC 3339 timestamp or a plain date, which
+// is taken as midnight UTC.
+func ParsePublicationDate(raw string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, raw); err == nil {
+		return t, nil
+	}
+	return time.Parse(time.DateOnly, raw)
+}
+
+// PublishAt returns the parsed publication date. ok is false when the post
+// has no date or one stored before dates were validated.
+func (p *Post) PublishAt() (t time.Time, ok bool) {
+	if p.PublicationDate == "" {
+		return time.Time{}, false
+	}
+	t, err := ParsePublicationDate(p.PublicationDate)
+	return t, err == nil
+}
+
+func (p *Post) ETag() string {
+	return strconv.Quote(strconv.FormatInt(p.Version, 10))
+}
+
+// VisibleTo reports whether caller may read the post. Anyone can read a
+// published post that passed moderation; every other post is only visible to
+// its author.
+func (p *Post) VisibleTo(caller string) bool {
+	return (p.Status == StatusPublished && p.Moderation.Approved()) || p.AuthoredBy(caller)
+}
+
+// AuthoredBy reports whether caller is the post's author, matching names the
+// way authors are matched.
+func (p *Post) AuthoredBy(caller string) bool {
+	return SameAuthor(caller, p.Author)
+}
+
+func (p *Post) Deleted() bool {
+	return !p.DeletedAt.IsZero()
+}
+
+func (p *Post) Clone() *Post {
+	if p == nil {
+		return nil
+	}
+
+	clone := *p
+	if p.Tags != nil {
+		clone.Tags = append([]string(nil), p.Tags...)
+	}
+	return &clone
+}
@@ -0,0 +1,25 @@
+package domain
+
+import "time"
+
+// Revision is an immutable copy of a post as it was stored by one write. The
+// revision number is the version the write produced, Post.Version.
+type Revision struct {
+	Post          *Post
+	Editor        string
+	CreatedAt     time.Time
+	ChangedFields []string
+}
+
+func (r *Revision) Clone() *Revision {
+	if r == nil {
+		return nil
+	}
+
+	clone := *r
+	clone.Post = r.Post.Clone()
+	if r.ChangedFields != nil {
+		clone.ChangedFields = append([]string(nil), r.ChangedFields...)
+	}
+	return &clone
+}
@@ -0,0 +1,76 @@
+package domain
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// MaxSlugLength bounds the slugs Slugify produces, leaving room for a
+// collision suffix within typical URL segment limits.
+const MaxSlugLength = 80
+
+// transliterations spells out the letters that do not decompose into an
+// ASCII letter and combining marks.
+var transliterations = map[rune]string{
+	'ß': "ss", 'æ': "ae", 'œ': "oe", 'ø': "o", 'ł': "l", 'đ': "d", 'ð': "d", 'þ': "th", 'ı': "i", 'ħ': "h", 'ŋ': "ng",
+
+	'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ё': "e", 'ж': "zh", 'з': "z", 'и': "i", 'й': "i",
+	'к': "k", 'л': "l", 'м': "m", 'н': "n", 'о': "o", 'п': "p", 'р': "r", 'с': "s", 'т': "t", 'у': "u", 'ф': "f",
+	'х': "kh", 'ц': "ts", 'ч': "ch", 'ш': "sh", 'щ': "shch", 'ъ': "", 'ы': "y", 'ь': "", 'э': "e", 'ю': "iu", 'я': "ia",
+	'і': "i", 'ї': "i", 'є': "ie", 'ґ': "g",
+
+	'α': "a", 'β': "v", 'γ': "g", 'δ': "d", 'ε': "e", 'ζ': "z", 'η': "i", 'θ': "th", 'ι': "i", 'κ': "k", 'λ': "l",
+	'μ': "m", 'ν': "n", 'ξ': "x", 'ο': "o", 'π': "p", 'ρ': "r", 'σ': "s", 'ς': "s", 'τ': "t", 'υ': "y", 'φ': "f",
+	'χ': "ch", 'ψ': "ps", 'ω': "o",
+}
+
+// Slugify turns a title into the slug used to address a post in URLs:
+// lower case ASCII letters and digits separated by single hyphens. Accents
+// are dropped and Cyrillic and Greek letters transliterated, so "Crème
+// Brûlée" becomes "creme-brulee"; characters with no ASCII spelling act as
+// separators. The result is at most MaxSlugLength long and is "" when the
+// title has nothing to spell.
+func Slugify(title string) string {
+	var b strings.Builder
+	pendingHyphen := false
+	write := func(s string) {
+		if pendingHyphen {
+			b.WriteByte('-')
+			pendingHyphen = false
+		}
+		b.WriteString(s)
+	}
+
+	for _, r := range norm.NFD.String(title) {
+		r = unicode.ToLower(r)
+		switch {
+		case unicode.Is(unicode.Mn, r):
+			// A combining mark left over from decomposing an accented
+			// letter.
+		case r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)):
+			write(string(r))
+		default:
+			// Hard and soft signs are spelled as nothing rather than
+			// splitting the word.
+			if spelled, ok := transliterations[r]; ok {
+				if spelled != "" {
+					write(spelled)
+				}
+				continue
+			}
+			pendingHyphen = b.Len() > 0
+		}
+	}
+
+	slug := b.String()
+	if len(slug) > MaxSlugLength {
+		slug = slug[:MaxSlugLength]
+		if cut := strings.LastIndexByte(slug, '-'); cut > 0 {
+			slug = slug[:cut]
+		}
+		slug = strings.TrimSuffix(slug, "-")
+	}
+	return slug
+}
@@ -0,0 +1,33 @@
+package domain
+
+type PostStatus string
+
+const (
+	StatusDraft     PostStatus = "draft"
+	StatusInReview  PostStatus = "in_review"
+	StatusScheduled PostStatus = "scheduled"
+	StatusPublished PostStatus = "published"
+	StatusArchived  PostStatus = "archived"
+)
+
+var statusTransitions = map[PostStatus][]PostStatus{
+	StatusDraft:     {StatusInReview, StatusScheduled, StatusPublished, StatusArchived},
+	StatusInReview:  {StatusDraft, StatusScheduled, StatusPublished, StatusArchived},
+	StatusScheduled: {StatusDraft, StatusPublished, StatusArchived},
+	StatusPublished: {StatusDraft, StatusArchived},
+	StatusArchived:  {StatusDraft},
+}
+
+func (s PostStatus) Valid() bool {
+	_, ok := statusTransitions[s]
+	return ok
+}
+
+func (s PostStatus) CanTransitionTo(next PostStatus) bool {
+	for _, allowed := range statusTransitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
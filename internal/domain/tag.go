@@ -0,0 +1,43 @@
+package domain
+
+import (
+	"strings"
+	"unicode"
+)
+
+// NormalizeTag returns the slug form tags are stored in: lower case letters
+// and digits, with every run of other characters in between turned into a
+// single hyphen, so "  Go Lang ", "go_lang" and "GO-LANG" are all "go-lang".
+// A tag without letters or digits normalizes to "".
+func NormalizeTag(tag string) string {
+	var b strings.Builder
+	pendingHyphen := false
+	for _, r := range tag {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			pendingHyphen = b.Len() > 0
+			continue
+		}
+		if pendingHyphen {
+			b.WriteByte('-')
+			pendingHyphen = false
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
+
+// NormalizeTags normalizes each of tags, dropping empty tags and repeats but
+// keeping the order in which tags first appear.
+func NormalizeTags(tags []string) []string {
+	var normalized []string
+	seen := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		tag = NormalizeTag(tag)
+		if tag == "" || seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		normalized = append(normalized, tag)
+	}
+	return normalized
+}
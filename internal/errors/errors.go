@@ -1,10 +1,55 @@
 package errors
 
-import "errors"
+import (
+	"errors"
+	"time"
+)
 
 var (
 	ErrPostNotFound  = errors.New("post not found")
 	ErrInvalidInput  = errors.New("invalid input")
 	ErrDuplicatePost = errors.New("duplicate post")
 	ErrInternal      = errors.New("internal error")
+
+	ErrVersionConflict = errors.New("post was modified concurrently")
+
+	ErrRevisionNotFound = errors.New("revision not found")
+	ErrCommentNotFound  = errors.New("comment not found")
+	ErrAuthorNotFound   = errors.New("author not found")
+	ErrTagNotFound      = errors.New("tag not found")
+	ErrAPIKeyNotFound   = errors.New("api key not found")
+
+	ErrDuplicateComment = errors.New("duplicate comment")
+	ErrDuplicateAuthor  = errors.New("author name already taken")
+	ErrDuplicateTag     = errors.New("tag already in use")
+	ErrDuplicateSlug    = errors.New("slug already taken")
+	ErrDuplicateAPIKey  = errors.New("duplicate api key")
+
+	ErrInvalidTransition = errors.New("post status transition not allowed")
+	ErrNotInTrash        = errors.New("post is not in the trash")
+	ErrAuthorHasPosts    = errors.New("author still has posts")
+
+	ErrUnauthenticated  = errors.New("unauthenticated")
+	ErrPermissionDenied = errors.New("permission denied")
+
+	ErrResumeTokenExpired = errors.New("resume token expired")
+	ErrSlowConsumer       = errors.New("subscriber too slow")
+
+	ErrRateLimited   = errors.New("rate limit exceeded")
+	ErrQuotaExceeded = errors.New("daily write quota exceeded")
 )
+
+// RetryError is an error the caller can recover from by trying again after
+// RetryAfter, such as ErrRateLimited.
+type RetryError struct {
+	Err        error
+	RetryAfter time.Duration
+}
+
+func (e *RetryError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *RetryError) Unwrap() error {
+	return e.Err
+}
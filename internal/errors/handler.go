@@ -1,9 +1,15 @@
 package errors
 
 import (
+	"context"
+	"errors"
+	"fmt"
+
 	"github.com/BhaveetKumar/gRPC-server-go/internal/logger"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 func ToStatus(err error, log *logger.Logger) error {
@@ -11,18 +17,97 @@ func ToStatus(err error, log *logger.Logger) error {
 		return nil
 	}
 
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		log.Error("deadline exceeded")
+		return status.Error(codes.DeadlineExceeded, context.DeadlineExceeded.Error())
+	case errors.Is(err, context.Canceled):
+		log.Error("request canceled")
+		return status.Error(codes.Canceled, context.Canceled.Error())
+	}
+
+	var retry *RetryError
+	if errors.As(err, &retry) {
+		return retryStatus(retry, log)
+	}
+
 	switch err {
 	case ErrPostNotFound:
 		log.Error("post not found")
 		return status.Error(codes.NotFound, err.Error())
+	case ErrRevisionNotFound:
+		log.Error("revision not found")
+		return status.Error(codes.NotFound, err.Error())
+	case ErrCommentNotFound:
+		log.Error("comment not found")
+		return status.Error(codes.NotFound, err.Error())
+	case ErrAuthorNotFound:
+		log.Error("author not found")
+		return status.Error(codes.NotFound, err.Error())
+	case ErrTagNotFound:
+		log.Error("tag not found")
+		return status.Error(codes.NotFound, err.Error())
+	case ErrAPIKeyNotFound:
+		log.Error("api key not found")
+		return status.Error(codes.NotFound, err.Error())
 	case ErrInvalidInput:
 		log.Error("invalid input")
 		return status.Error(codes.InvalidArgument, err.Error())
 	case ErrDuplicatePost:
 		log.Error("duplicate post")
 		return status.Error(codes.AlreadyExists, err.Error())
+	case ErrDuplicateComment:
+		log.Error("duplicate comment")
+		return status.Error(codes.AlreadyExists, err.Error())
+	case ErrDuplicateAuthor:
+		log.Error("duplicate author")
+		return status.Error(codes.AlreadyExists, err.Error())
+	case ErrDuplicateTag:
+		log.Error("duplicate tag")
+		return status.Error(codes.AlreadyExists, err.Error())
+	case ErrDuplicateSlug:
+		log.Error("duplicate slug")
+		return status.Error(codes.AlreadyExists, err.Error())
+	case ErrDuplicateAPIKey:
+		log.Error("duplicate api key")
+		return status.Error(codes.AlreadyExists, err.Error())
+	case ErrVersionConflict:
+		log.Error("version conflict")
+		return status.Error(codes.Aborted, err.Error())
+	case ErrInvalidTransition:
+		log.Error("invalid status transition")
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case ErrNotInTrash:
+		log.Error("post not in trash")
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case ErrAuthorHasPosts:
+		log.Error("author has posts")
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case ErrUnauthenticated:
+		log.Error("unauthenticated")
+		return status.Error(codes.Unauthenticated, err.Error())
+	case ErrPermissionDenied:
+		log.Error("permission denied")
+		return status.Error(codes.PermissionDenied, err.Error())
+	case ErrResumeTokenExpired:
+		log.Error("resume token expired")
+		return status.Error(codes.OutOfRange, err.Error())
+	case ErrSlowConsumer:
+		log.Error("subscriber too slow")
+		return status.Error(codes.ResourceExhausted, err.Error())
 	default:
 		log.Error("internal error")
 		return status.Error(codes.Internal, ErrInternal.Error())
 	}
 }
+
+// retryStatus reports a RetryError as ResourceExhausted, telling the caller
+// when to try again in a RetryInfo detail.
+func retryStatus(err *RetryError, log *logger.Logger) error {
+	log.Error(fmt.Sprintf("%v, retry in %v", err.Err, err.RetryAfter))
+	st := status.New(codes.ResourceExhausted, err.Error())
+	if withInfo, detailErr := st.WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(err.RetryAfter)}); detailErr == nil {
+		st = withInfo
+	}
+	return st.Err()
+}
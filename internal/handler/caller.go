@@ -0,0 +1,37 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/BhaveetKumar/gRPC-server-go/internal/auth"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/service"
+	"google.golang.org/grpc/metadata"
+)
+
+// callerMetadataKey names the request header that identifies the calling
+// author when the server does not authenticate callers. Requests without it
+// are served as anonymous.
+const callerMetadataKey = "x-author"
+
+// withCaller makes the caller the request acts for known to the services:
+// the verified principal if there is one, the x-author header otherwise.
+// Editors and admins may moderate.
+func withCaller(ctx context.Context) context.Context {
+	if principal := auth.PrincipalFromContext(ctx); principal != nil {
+		if principal.Role >= auth.RoleEditor {
+			ctx = service.WithModeratorRights(ctx)
+		}
+		return service.WithCaller(ctx, principal.Subject)
+	}
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx
+	}
+
+	values := md.Get(callerMetadataKey)
+	if len(values) == 0 || values[0] == "" {
+		return ctx
+	}
+	return service.WithCaller(ctx, values[0])
+}
@@ -2,30 +2,46 @@ package handler
 
 import (
 	"context"
+	"fmt"
+	"time"
 
+	"github.com/BhaveetKumar/gRPC-server-go/internal/diff"
 	"github.com/BhaveetKumar/gRPC-server-go/internal/domain"
 	"github.com/BhaveetKumar/gRPC-server-go/internal/errors"
 	"github.com/BhaveetKumar/gRPC-server-go/internal/logger"
 	"github.com/BhaveetKumar/gRPC-server-go/internal/service"
 	blogv1 "github.com/BhaveetKumar/gRPC-server-go/proto/blog/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type BlogHandler struct {
 	blogv1.UnimplementedBlogServiceServer
 
-	service service.PostService
-	logger  *logger.Logger
+	service  service.PostService
+	comments service.CommentService
+	authors  service.AuthorService
+	apiKeys  service.APIKeyService
+	logger   *logger.Logger
 }
 
-func NewBlogHandler(s service.PostService, l *logger.Logger) *BlogHandler {
+func NewBlogHandler(s service.PostService, c service.CommentService, a service.AuthorService, k service.APIKeyService, l *logger.Logger) *BlogHandler {
 	return &BlogHandler{
-		service: s,
-		logger:  l,
+		service:  s,
+		comments: c,
+		authors:  a,
+		apiKeys:  k,
+		logger:   l,
 	}
 }
 
 func (h *BlogHandler) CreatePost(ctx context.Context, req *blogv1.CreatePostRequest) (*blogv1.CreatePostResponse, error) {
-	post, err := h.service.CreatePost(ctx, req.GetTitle(), req.GetContent(), req.GetAuthor(), req.GetPublicationDate(), req.GetTags())
+	ctx = withCaller(ctx)
+	author, err := h.byline(ctx, req.GetAuthorId(), req.GetAuthor())
+	if err != nil {
+		return nil, errors.ToStatus(err, h.logger)
+	}
+
+	post, err := h.service.CreatePost(ctx, req.GetTitle(), req.GetContent(), author, req.GetPublicationDate(), req.GetTags())
 	if err != nil {
 		return nil, errors.ToStatus(err, h.logger)
 	}
@@ -34,7 +50,7 @@ func (h *BlogHandler) CreatePost(ctx context.Context, req *blogv1.CreatePostRequ
 }
 
 func (h *BlogHandler) GetPost(ctx context.Context, req *blogv1.GetPostRequest) (*blogv1.GetPostResponse, error) {
-	post, err := h.service.GetPost(ctx, req.GetPostId())
+	post, err := h.service.GetPost(withCaller(ctx), req.GetPostId())
 	if err != nil {
 		return nil, errors.ToStatus(err, h.logger)
 	}
@@ -42,8 +58,36 @@ func (h *BlogHandler) GetPost(ctx context.Context, req *blogv1.GetPostRequest) (
 	return &blogv1.GetPostResponse{Post: toProtoPost(post)}, nil
 }
 
+func (h *BlogHandler) GetPostBySlug(ctx context.Context, req *blogv1.GetPostBySlugRequest) (*blogv1.GetPostBySlugResponse, error) {
+	post, redirect, err := h.service.GetPostBySlug(withCaller(ctx), req.GetSlug())
+	if err != nil {
+		return nil, errors.ToStatus(err, h.logger)
+	}
+
+	return &blogv1.GetPostBySlugResponse{Post: toProtoPost(post), Redirect: redirect}, nil
+}
+
 func (h *BlogHandler) UpdatePost(ctx context.Context, req *blogv1.UpdatePostRequest) (*blogv1.UpdatePostResponse, error) {
-	post, err := h.service.UpdatePost(ctx, req.GetPostId(), req.GetTitle(), req.GetContent(), req.GetAuthor(), req.GetTags())
+	ctx = withCaller(ctx)
+	// The byline is only looked up when the update applies it.
+	var author string
+	if appliesField(req.GetUpdateMask().GetPaths(), service.FieldAuthor) {
+		var err error
+		if author, err = h.byline(ctx, req.GetAuthorId(), req.GetAuthor()); err != nil {
+			return nil, errors.ToStatus(err, h.logger)
+		}
+	}
+
+	update := service.PostUpdate{
+		Title:           req.GetTitle(),
+		Content:         req.GetContent(),
+		Author:          author,
+		PublicationDate: req.GetPublicationDate(),
+		Tags:            req.GetTags(),
+		Status:          toPostStatus(req.GetStatus()),
+	}
+
+	post, err := h.service.UpdatePost(ctx, req.GetPostId(), update, req.GetUpdateMask().GetPaths(), req.GetEtag())
 	if err != nil {
 		return nil, errors.ToStatus(err, h.logger)
 	}
@@ -52,24 +96,696 @@ func (h *BlogHandler) UpdatePost(ctx context.Context, req *blogv1.UpdatePostRequ
 }
 
 func (h *BlogHandler) DeletePost(ctx context.Context, req *blogv1.DeletePostRequest) (*blogv1.DeletePostResponse, error) {
-	if err := h.service.DeletePost(ctx, req.GetPostId()); err != nil {
+	if err := h.service.DeletePost(withCaller(ctx), req.GetPostId(), req.GetEtag()); err != nil {
 		return nil, errors.ToStatus(err, h.logger)
 	}
 
 	return &blogv1.DeletePostResponse{Success: true}, nil
 }
 
+func (h *BlogHandler) RestorePost(ctx context.Context, req *blogv1.RestorePostRequest) (*blogv1.RestorePostResponse, error) {
+	post, err := h.service.RestorePost(withCaller(ctx), req.GetPostId(), req.GetEtag())
+	if err != nil {
+		return nil, errors.ToStatus(err, h.logger)
+	}
+
+	return &blogv1.RestorePostResponse{Post: toProtoPost(post)}, nil
+}
+
+func (h *BlogHandler) PurgePost(ctx context.Context, req *blogv1.PurgePostRequest) (*blogv1.PurgePostResponse, error) {
+	if err := h.service.PurgePost(withCaller(ctx), req.GetPostId(), req.GetEtag()); err != nil {
+		return nil, errors.ToStatus(err, h.logger)
+	}
+
+	return &blogv1.PurgePostResponse{}, nil
+}
+
+func (h *BlogHandler) PublishPost(ctx context.Context, req *blogv1.PublishPostRequest) (*blogv1.PublishPostResponse, error) {
+	post, err := h.service.PublishPost(withCaller(ctx), req.GetPostId(), req.GetEtag())
+	if err != nil {
+		return nil, errors.ToStatus(err, h.logger)
+	}
+
+	return &blogv1.PublishPostResponse{Post: toProtoPost(post)}, nil
+}
+
+func (h *BlogHandler) UnpublishPost(ctx context.Context, req *blogv1.UnpublishPostRequest) (*blogv1.UnpublishPostResponse, error) {
+	post, err := h.service.UnpublishPost(withCaller(ctx), req.GetPostId(), req.GetEtag())
+	if err != nil {
+		return nil, errors.ToStatus(err, h.logger)
+	}
+
+	return &blogv1.UnpublishPostResponse{Post: toProtoPost(post)}, nil
+}
+
+func (h *BlogHandler) ArchivePost(ctx context.Context, req *blogv1.ArchivePostRequest) (*blogv1.ArchivePostResponse, error) {
+	post, err := h.service.ArchivePost(withCaller(ctx), req.GetPostId(), req.GetEtag())
+	if err != nil {
+		return nil, errors.ToStatus(err, h.logger)
+	}
+
+	return &blogv1.ArchivePostResponse{Post: toProtoPost(post)}, nil
+}
+
+func (h *BlogHandler) SchedulePost(ctx context.Context, req *blogv1.SchedulePostRequest) (*blogv1.SchedulePostResponse, error) {
+	if !req.GetPublishAt().IsValid() {
+		return nil, errors.ToStatus(errors.ErrInvalidInput, h.logger)
+	}
+
+	post, err := h.service.SchedulePost(withCaller(ctx), req.GetPostId(), req.GetPublishAt().AsTime(), req.GetEtag())
+	if err != nil {
+		return nil, errors.ToStatus(err, h.logger)
+	}
+
+	return &blogv1.SchedulePostResponse{Post: toProtoPost(post)}, nil
+}
+
+func (h *BlogHandler) ListPendingPosts(ctx context.Context, req *blogv1.ListPendingPostsRequest) (*blogv1.ListPendingPostsResponse, error) {
+	posts, nextPageToken, err := h.service.ListPendingPosts(withCaller(ctx), service.ListPendingPostsParams{
+		PageSize:  int(req.GetPageSize()),
+		PageToken: req.GetPageToken(),
+	})
+	if err != nil {
+		return nil, errors.ToStatus(err, h.logger)
+	}
+
+	resp := &blogv1.ListPendingPostsResponse{
+		Posts:         make([]*blogv1.Post, 0, len(posts)),
+		NextPageToken: nextPageToken,
+	}
+	for _, post := range posts {
+		resp.Posts = append(resp.Posts, toProtoPost(post))
+	}
+
+	return resp, nil
+}
+
+func (h *BlogHandler) ApprovePost(ctx context.Context, req *blogv1.ApprovePostRequest) (*blogv1.ApprovePostResponse, error) {
+	post, err := h.service.ApprovePost(withCaller(ctx), req.GetPostId(), req.GetEtag())
+	if err != nil {
+		return nil, errors.ToStatus(err, h.logger)
+	}
+
+	return &blogv1.ApprovePostResponse{Post: toProtoPost(post)}, nil
+}
+
+func (h *BlogHandler) RejectPost(ctx context.Context, req *blogv1.RejectPostRequest) (*blogv1.RejectPostResponse, error) {
+	post, err := h.service.RejectPost(withCaller(ctx), req.GetPostId(), req.GetReason(), req.GetEtag())
+	if err != nil {
+		return nil, errors.ToStatus(err, h.logger)
+	}
+
+	return &blogv1.RejectPostResponse{Post: toProtoPost(post)}, nil
+}
+
+func (h *BlogHandler) ListPosts(ctx context.Context, req *blogv1.ListPostsRequest) (*blogv1.ListPostsResponse, error) {
+	order, err := toPostOrder(req.GetOrderBy())
+	if err != nil {
+		return nil, errors.ToStatus(err, h.logger)
+	}
+
+	posts, nextPageToken, err := h.service.ListPosts(withCaller(ctx), service.ListPostsParams{
+		PageSize:        int(req.GetPageSize()),
+		PageToken:       req.GetPageToken(),
+		Author:          req.GetAuthor(),
+		AuthorID:        req.GetAuthorId(),
+		Tag:             req.GetTag(),
+		PublishedAfter:  req.GetPublishedAfter(),
+		PublishedBefore: req.GetPublishedBefore(),
+		OrderBy:         order,
+		Status:          toPostStatus(req.GetStatus()),
+		ShowDeleted:     req.GetShowDeleted(),
+	})
+	if err != nil {
+		return nil, errors.ToStatus(err, h.logger)
+	}
+
+	resp := &blogv1.ListPostsResponse{
+		Posts:         make([]*blogv1.Post, 0, len(posts)),
+		NextPageToken: nextPageToken,
+	}
+	for _, post := range posts {
+		resp.Posts = append(resp.Posts, toProtoPost(post))
+	}
+
+	return resp, nil
+}
+
+func (h *BlogHandler) WatchPosts(req *blogv1.WatchPostsRequest, stream blogv1.BlogService_WatchPostsServer) error {
+	params := service.WatchPostsParams{
+		Author:      req.GetAuthor(),
+		Tag:         req.GetTag(),
+		ResumeToken: req.GetResumeToken(),
+	}
+
+	err := h.service.WatchPosts(withCaller(stream.Context()), params, func(event *domain.PostEvent) error {
+		return stream.Send(toProtoEvent(event))
+	})
+	if err != nil {
+		return errors.ToStatus(err, h.logger)
+	}
+
+	return nil
+}
+
+func (h *BlogHandler) SearchPosts(ctx context.Context, req *blogv1.SearchPostsRequest) (*blogv1.SearchPostsResponse, error) {
+	results, nextPageToken, err := h.service.SearchPosts(withCaller(ctx), service.SearchPostsParams{
+		Query:     req.GetQuery(),
+		PageSize:  int(req.GetPageSize()),
+		PageToken: req.GetPageToken(),
+	})
+	if err != nil {
+		return nil, errors.ToStatus(err, h.logger)
+	}
+
+	resp := &blogv1.SearchPostsResponse{
+		Results:       make([]*blogv1.SearchResult, 0, len(results)),
+		NextPageToken: nextPageToken,
+	}
+	for _, result := range results {
+		resp.Results = append(resp.Results, &blogv1.SearchResult{
+			Post:    toProtoPost(result.Post),
+			Score:   result.Score,
+			Snippet: result.Snippet,
+		})
+	}
+
+	return resp, nil
+}
+
+func (h *BlogHandler) ListPostRevisions(ctx context.Context, req *blogv1.ListPostRevisionsRequest) (*blogv1.ListPostRevisionsResponse, error) {
+	revisions, nextPageToken, err := h.service.ListPostRevisions(withCaller(ctx), service.ListRevisionsParams{
+		PostID:    req.GetPostId(),
+		PageSize:  int(req.GetPageSize()),
+		PageToken: req.GetPageToken(),
+	})
+	if err != nil {
+		return nil, errors.ToStatus(err, h.logger)
+	}
+
+	resp := &blogv1.ListPostRevisionsResponse{
+		Revisions:     make([]*blogv1.PostRevision, 0, len(revisions)),
+		NextPageToken: nextPageToken,
+	}
+	for _, rev := range revisions {
+		resp.Revisions = append(resp.Revisions, toProtoRevision(rev))
+	}
+
+	return resp, nil
+}
+
+func (h *BlogHandler) GetPostRevision(ctx context.Context, req *blogv1.GetPostRevisionRequest) (*blogv1.GetPostRevisionResponse, error) {
+	rev, err := h.service.GetPostRevision(withCaller(ctx), req.GetPostId(), req.GetRevision())
+	if err != nil {
+		return nil, errors.ToStatus(err, h.logger)
+	}
+
+	return &blogv1.GetPostRevisionResponse{Revision: toProtoRevision(rev)}, nil
+}
+
+func (h *BlogHandler) RestorePostRevision(ctx context.Context, req *blogv1.RestorePostRevisionRequest) (*blogv1.RestorePostRevisionResponse, error) {
+	post, err := h.service.RestorePostRevision(withCaller(ctx), req.GetPostId(), req.GetRevision(), req.GetEtag())
+	if err != nil {
+		return nil, errors.ToStatus(err, h.logger)
+	}
+
+	return &blogv1.RestorePostRevisionResponse{Post: toProtoPost(post)}, nil
+}
+
+func (h *BlogHandler) DiffPostRevisions(ctx context.Context, req *blogv1.DiffPostRevisionsRequest) (*blogv1.DiffPostRevisionsResponse, error) {
+	format := req.GetFormat()
+	if format != blogv1.DiffFormat_DIFF_FORMAT_UNSPECIFIED && format != blogv1.DiffFormat_DIFF_FORMAT_HUNKS && format != blogv1.DiffFormat_DIFF_FORMAT_UNIFIED {
+		return nil, errors.ToStatus(errors.ErrInvalidInput, h.logger)
+	}
+
+	d, err := h.service.DiffPostRevisions(withCaller(ctx), service.DiffRevisionsParams{
+		PostID:       req.GetPostId(),
+		From:         req.GetFromRevision(),
+		To:           req.GetToRevision(),
+		ContextLines: int(req.GetContextLines()),
+	})
+	if err != nil {
+		return nil, errors.ToStatus(err, h.logger)
+	}
+
+	resp := &blogv1.DiffPostRevisionsResponse{
+		PostId:       d.PostID,
+		FromRevision: d.From,
+		ToRevision:   d.To,
+		AddedTags:    d.AddedTags,
+		RemovedTags:  d.RemovedTags,
+	}
+	for _, change := range d.Fields {
+		resp.Fields = append(resp.Fields, &blogv1.FieldDiff{Field: change.Field, OldValue: change.Old, NewValue: change.New})
+	}
+
+	if format == blogv1.DiffFormat_DIFF_FORMAT_UNIFIED {
+		resp.UnifiedDiff = diff.Unified(fmt.Sprintf("%s@%d", d.PostID, d.From), fmt.Sprintf("%s@%d", d.PostID, d.To), d.Content)
+	} else {
+		resp.Hunks = toProtoHunks(d.Content)
+	}
+
+	return resp, nil
+}
+
+func (h *BlogHandler) AddComment(ctx context.Context, req *blogv1.AddCommentRequest) (*blogv1.AddCommentResponse, error) {
+	comment, err := h.comments.AddComment(withCaller(ctx), req.GetPostId(), req.GetParentId(), req.GetAuthor(), req.GetContent())
+	if err != nil {
+		return nil, errors.ToStatus(err, h.logger)
+	}
+
+	return &blogv1.AddCommentResponse{Comment: toProtoComment(comment)}, nil
+}
+
+func (h *BlogHandler) ListComments(ctx context.Context, req *blogv1.ListCommentsRequest) (*blogv1.ListCommentsResponse, error) {
+	comments, nextPageToken, err := h.comments.ListComments(withCaller(ctx), service.ListCommentsParams{
+		PostID:    req.GetPostId(),
+		ParentID:  req.GetParentId(),
+		PageSize:  int(req.GetPageSize()),
+		PageToken: req.GetPageToken(),
+	})
+	if err != nil {
+		return nil, errors.ToStatus(err, h.logger)
+	}
+
+	resp := &blogv1.ListCommentsResponse{
+		Comments:      make([]*blogv1.Comment, 0, len(comments)),
+		NextPageToken: nextPageToken,
+	}
+	for _, tc := range comments {
+		comment := toProtoComment(tc.Comment)
+		comment.Depth = int32(tc.Depth)
+		comment.ReplyCount = int32(tc.Replies)
+		resp.Comments = append(resp.Comments, comment)
+	}
+
+	return resp, nil
+}
+
+func (h *BlogHandler) EditComment(ctx context.Context, req *blogv1.EditCommentRequest) (*blogv1.EditCommentResponse, error) {
+	comment, err := h.comments.EditComment(withCaller(ctx), req.GetCommentId(), req.GetContent(), req.GetEtag())
+	if err != nil {
+		return nil, errors.ToStatus(err, h.logger)
+	}
+
+	return &blogv1.EditCommentResponse{Comment: toProtoComment(comment)}, nil
+}
+
+func (h *BlogHandler) DeleteComment(ctx context.Context, req *blogv1.DeleteCommentRequest) (*blogv1.DeleteCommentResponse, error) {
+	if err := h.comments.DeleteComment(withCaller(ctx), req.GetCommentId(), req.GetEtag()); err != nil {
+		return nil, errors.ToStatus(err, h.logger)
+	}
+
+	return &blogv1.DeleteCommentResponse{}, nil
+}
+
+// appliesField reports whether an update with mask sets field; an empty
+// mask sets every field.
+func appliesField(mask []string, field string) bool {
+	if len(mask) == 0 {
+		return true
+	}
+	for _, path := range mask {
+		if path == field {
+			return true
+		}
+	}
+	return false
+}
+
+// byline returns the author name to hand to the post service: the name of
+// the author with authorID when it is set, and name otherwise.
+func (h *BlogHandler) byline(ctx context.Context, authorID, name string) (string, error) {
+	if authorID == "" {
+		return name, nil
+	}
+
+	author, err := h.authors.GetAuthor(ctx, authorID)
+	if err != nil {
+		return "", err
+	}
+	return author.Name, nil
+}
+
+func (h *BlogHandler) CreateAuthor(ctx context.Context, req *blogv1.CreateAuthorRequest) (*blogv1.CreateAuthorResponse, error) {
+	author, err := h.authors.CreateAuthor(withCaller(ctx), req.GetName(), req.GetBio(), req.GetAvatarUrl())
+	if err != nil {
+		return nil, errors.ToStatus(err, h.logger)
+	}
+
+	return &blogv1.CreateAuthorResponse{Author: toProtoAuthor(author)}, nil
+}
+
+func (h *BlogHandler) GetAuthor(ctx context.Context, req *blogv1.GetAuthorRequest) (*blogv1.GetAuthorResponse, error) {
+	author, err := h.authors.GetAuthor(withCaller(ctx), req.GetAuthorId())
+	if err != nil {
+		return nil, errors.ToStatus(err, h.logger)
+	}
+
+	return &blogv1.GetAuthorResponse{Author: toProtoAuthor(author)}, nil
+}
+
+func (h *BlogHandler) UpdateAuthor(ctx context.Context, req *blogv1.UpdateAuthorRequest) (*blogv1.UpdateAuthorResponse, error) {
+	update := service.AuthorUpdate{
+		Name:      req.GetName(),
+		Bio:       req.GetBio(),
+		AvatarURL: req.GetAvatarUrl(),
+	}
+
+	author, err := h.authors.UpdateAuthor(withCaller(ctx), req.GetAuthorId(), update, req.GetUpdateMask().GetPaths(), req.GetEtag())
+	if err != nil {
+		return nil, errors.ToStatus(err, h.logger)
+	}
+
+	return &blogv1.UpdateAuthorResponse{Author: toProtoAuthor(author)}, nil
+}
+
+func (h *BlogHandler) DeleteAuthor(ctx context.Context, req *blogv1.DeleteAuthorRequest) (*blogv1.DeleteAuthorResponse, error) {
+	if err := h.authors.DeleteAuthor(withCaller(ctx), req.GetAuthorId(), req.GetEtag()); err != nil {
+		return nil, errors.ToStatus(err, h.logger)
+	}
+
+	return &blogv1.DeleteAuthorResponse{}, nil
+}
+
+func (h *BlogHandler) ListAuthors(ctx context.Context, req *blogv1.ListAuthorsRequest) (*blogv1.ListAuthorsResponse, error) {
+	authors, nextPageToken, err := h.authors.ListAuthors(withCaller(ctx), service.ListAuthorsParams{
+		PageSize:  int(req.GetPageSize()),
+		PageToken: req.GetPageToken(),
+	})
+	if err != nil {
+		return nil, errors.ToStatus(err, h.logger)
+	}
+
+	resp := &blogv1.ListAuthorsResponse{
+		Authors:       make([]*blogv1.Author, 0, len(authors)),
+		NextPageToken: nextPageToken,
+	}
+	for _, author := range authors {
+		resp.Authors = append(resp.Authors, toProtoAuthor(author))
+	}
+
+	return resp, nil
+}
+
+func (h *BlogHandler) ListTags(ctx context.Context, req *blogv1.ListTagsRequest) (*blogv1.ListTagsResponse, error) {
+	order, err := toTagOrder(req.GetOrderBy())
+	if err != nil {
+		return nil, errors.ToStatus(err, h.logger)
+	}
+
+	tags, nextPageToken, err := h.service.ListTags(withCaller(ctx), service.ListTagsParams{
+		PageSize:  int(req.GetPageSize()),
+		PageToken: req.GetPageToken(),
+		OrderBy:   order,
+	})
+	if err != nil {
+		return nil, errors.ToStatus(err, h.logger)
+	}
+
+	resp := &blogv1.ListTagsResponse{
+		Tags:          make([]*blogv1.TagCount, 0, len(tags)),
+		NextPageToken: nextPageToken,
+	}
+	for _, tag := range tags {
+		resp.Tags = append(resp.Tags, &blogv1.TagCount{Tag: tag.Tag, PostCount: int32(tag.Posts)})
+	}
+
+	return resp, nil
+}
+
+func (h *BlogHandler) RenameTag(ctx context.Context, req *blogv1.RenameTagRequest) (*blogv1.RenameTagResponse, error) {
+	changed, err := h.service.RenameTag(withCaller(ctx), req.GetFrom(), req.GetTo())
+	if err != nil {
+		return nil, errors.ToStatus(err, h.logger)
+	}
+
+	return &blogv1.RenameTagResponse{PostsChanged: int32(changed)}, nil
+}
+
+func (h *BlogHandler) MergeTags(ctx context.Context, req *blogv1.MergeTagsRequest) (*blogv1.MergeTagsResponse, error) {
+	changed, err := h.service.MergeTags(withCaller(ctx), req.GetSources(), req.GetTarget())
+	if err != nil {
+		return nil, errors.ToStatus(err, h.logger)
+	}
+
+	return &blogv1.MergeTagsResponse{PostsChanged: int32(changed)}, nil
+}
+
+func (h *BlogHandler) IssueApiKey(ctx context.Context, req *blogv1.IssueApiKeyRequest) (*blogv1.IssueApiKeyResponse, error) {
+	var ttl time.Duration
+	if req.GetTtl() != nil {
+		if err := req.GetTtl().CheckValid(); err != nil {
+			return nil, errors.ToStatus(errors.ErrInvalidInput, h.logger)
+		}
+		ttl = req.GetTtl().AsDuration()
+	}
+
+	key, raw, err := h.apiKeys.IssueAPIKey(ctx, req.GetName(), req.GetOwner(), req.GetScopes(), ttl)
+	if err != nil {
+		return nil, errors.ToStatus(err, h.logger)
+	}
+
+	return &blogv1.IssueApiKeyResponse{ApiKey: toProtoAPIKey(key), RawKey: raw}, nil
+}
+
+func (h *BlogHandler) ListApiKeys(ctx context.Context, req *blogv1.ListApiKeysRequest) (*blogv1.ListApiKeysResponse, error) {
+	keys, nextPageToken, err := h.apiKeys.ListAPIKeys(ctx, service.ListAPIKeysParams{
+		PageSize:  int(req.GetPageSize()),
+		PageToken: req.GetPageToken(),
+	})
+	if err != nil {
+		return nil, errors.ToStatus(err, h.logger)
+	}
+
+	resp := &blogv1.ListApiKeysResponse{
+		ApiKeys:       make([]*blogv1.ApiKey, 0, len(keys)),
+		NextPageToken: nextPageToken,
+	}
+	for _, key := range keys {
+		resp.ApiKeys = append(resp.ApiKeys, toProtoAPIKey(key))
+	}
+
+	return resp, nil
+}
+
+func (h *BlogHandler) RevokeApiKey(ctx context.Context, req *blogv1.RevokeApiKeyRequest) (*blogv1.RevokeApiKeyResponse, error) {
+	key, err := h.apiKeys.RevokeAPIKey(ctx, req.GetKeyId())
+	if err != nil {
+		return nil, errors.ToStatus(err, h.logger)
+	}
+
+	return &blogv1.RevokeApiKeyResponse{ApiKey: toProtoAPIKey(key)}, nil
+}
+
+func toTagOrder(order blogv1.TagOrder) (service.TagOrder, error) {
+	switch order {
+	case blogv1.TagOrder_TAG_ORDER_UNSPECIFIED, blogv1.TagOrder_TAG_ORDER_COUNT:
+		return service.TagOrderCount, nil
+	case blogv1.TagOrder_TAG_ORDER_NAME:
+		return service.TagOrderName, nil
+	default:
+		return 0, errors.ErrInvalidInput
+	}
+}
+
+func toPostOrder(order blogv1.PostOrder) (service.PostOrder, error) {
+	switch order {
+	case blogv1.PostOrder_POST_ORDER_UNSPECIFIED, blogv1.PostOrder_POST_ORDER_PUBLICATION_DATE_DESC:
+		return service.OrderPublicationDateDesc, nil
+	case blogv1.PostOrder_POST_ORDER_PUBLICATION_DATE_ASC:
+		return service.OrderPublicationDateAsc, nil
+	case blogv1.PostOrder_POST_ORDER_TITLE_ASC:
+		return service.OrderTitleAsc, nil
+	case blogv1.PostOrder_POST_ORDER_TITLE_DESC:
+		return service.OrderTitleDesc, nil
+	case blogv1.PostOrder_POST_ORDER_ID_ASC:
+		return service.OrderIDAsc, nil
+	case blogv1.PostOrder_POST_ORDER_ID_DESC:
+		return service.OrderIDDesc, nil
+	default:
+		return 0, errors.ErrInvalidInput
+	}
+}
+
 func toProtoPost(p *domain.Post) *blogv1.Post {
 	if p == nil {
 		return nil
 	}
 
+	var publishTime *timestamppb.Timestamp
+	if at, ok := p.PublishAt(); ok {
+		publishTime = timestamppb.New(at)
+	}
+
+	var deleteTime *timestamppb.Timestamp
+	if p.Deleted() {
+		deleteTime = timestamppb.New(p.DeletedAt)
+	}
+
 	return &blogv1.Post{
-		PostId:          p.ID,
-		Title:           p.Title,
-		Content:         p.Content,
-		Author:          p.Author,
-		PublicationDate: p.PublicationDate,
-		Tags:            p.Tags,
+		PostId:           p.ID,
+		Title:            p.Title,
+		Content:          p.Content,
+		Author:           p.Author,
+		AuthorId:         p.AuthorID,
+		Slug:             p.Slug,
+		PublicationDate:  p.PublicationDate,
+		Tags:             p.Tags,
+		Version:          p.Version,
+		Etag:             p.ETag(),
+		Status:           toProtoStatus(p.Status),
+		PublishTime:      publishTime,
+		DeleteTime:       deleteTime,
+		ModerationState:  toProtoModerationState(p.Moderation.Current()),
+		ModerationReason: p.Moderation.Reason,
+	}
+}
+
+func toProtoModerationState(state domain.ModerationState) blogv1.ModerationState {
+	switch state {
+	case domain.ModerationApproved:
+		return blogv1.ModerationState_MODERATION_STATE_APPROVED
+	case domain.ModerationPending:
+		return blogv1.ModerationState_MODERATION_STATE_PENDING
+	case domain.ModerationRejected:
+		return blogv1.ModerationState_MODERATION_STATE_REJECTED
+	default:
+		return blogv1.ModerationState_MODERATION_STATE_UNSPECIFIED
+	}
+}
+
+func toProtoRevision(r *domain.Revision) *blogv1.PostRevision {
+	return &blogv1.PostRevision{
+		PostId:        r.Post.ID,
+		Revision:      r.Post.Version,
+		Post:          toProtoPost(r.Post),
+		Editor:        r.Editor,
+		CreateTime:    timestamppb.New(r.CreatedAt),
+		ChangedFields: r.ChangedFields,
+	}
+}
+
+func toProtoComment(c *domain.Comment) *blogv1.Comment {
+	return &blogv1.Comment{
+		CommentId:  c.ID,
+		PostId:     c.PostID,
+		ParentId:   c.ParentID,
+		Author:     c.Author,
+		Content:    c.Content,
+		CreateTime: timestamppb.New(c.CreatedAt),
+		UpdateTime: timestamppb.New(c.UpdatedAt),
+		Version:    c.Version,
+		Etag:       c.ETag(),
+		Deleted:    c.Deleted,
+	}
+}
+
+func toProtoAuthor(a *domain.Author) *blogv1.Author {
+	return &blogv1.Author{
+		AuthorId:   a.ID,
+		Name:       a.Name,
+		Bio:        a.Bio,
+		AvatarUrl:  a.AvatarURL,
+		CreateTime: timestamppb.New(a.CreatedAt),
+		UpdateTime: timestamppb.New(a.UpdatedAt),
+		Version:    a.Version,
+		Etag:       a.ETag(),
+	}
+}
+
+func toProtoAPIKey(k *domain.APIKey) *blogv1.ApiKey {
+	return &blogv1.ApiKey{
+		KeyId:        k.ID,
+		Name:         k.Name,
+		Owner:        k.Owner,
+		Scopes:       k.Scopes,
+		CreateTime:   timestamppb.New(k.CreatedAt),
+		ExpireTime:   optionalTimestamp(k.ExpiresAt),
+		LastUsedTime: optionalTimestamp(k.LastUsedAt),
+		RevokeTime:   optionalTimestamp(k.RevokedAt),
+	}
+}
+
+// optionalTimestamp leaves zero times unset.
+func optionalTimestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+func toProtoHunks(hunks []diff.Hunk) []*blogv1.DiffHunk {
+	result := make([]*blogv1.DiffHunk, 0, len(hunks))
+	for _, h := range hunks {
+		hunk := &blogv1.DiffHunk{
+			OldStart: int32(h.OldStart),
+			OldLines: int32(h.OldLines),
+			NewStart: int32(h.NewStart),
+			NewLines: int32(h.NewLines),
+			Lines:    make([]*blogv1.DiffLine, 0, len(h.Lines)),
+		}
+		for _, line := range h.Lines {
+			protoLine := &blogv1.DiffLine{Op: toProtoDiffOp(line.Op), Text: line.Text}
+			for _, word := range line.Words {
+				protoLine.Words = append(protoLine.Words, &blogv1.DiffSegment{Op: toProtoDiffOp(word.Op), Text: word.Text})
+			}
+			hunk.Lines = append(hunk.Lines, protoLine)
+		}
+		result = append(result, hunk)
+	}
+	return result
+}
+
+func toProtoDiffOp(op diff.Op) blogv1.DiffOp {
+	switch op {
+	case diff.Insert:
+		return blogv1.DiffOp_DIFF_OP_INSERT
+	case diff.Delete:
+		return blogv1.DiffOp_DIFF_OP_DELETE
+	default:
+		return blogv1.DiffOp_DIFF_OP_EQUAL
+	}
+}
+
+var postStatuses = map[blogv1.PostStatus]domain.PostStatus{
+	blogv1.PostStatus_POST_STATUS_DRAFT:     domain.StatusDraft,
+	blogv1.PostStatus_POST_STATUS_IN_REVIEW: domain.StatusInReview,
+	blogv1.PostStatus_POST_STATUS_SCHEDULED: domain.StatusScheduled,
+	blogv1.PostStatus_POST_STATUS_PUBLISHED: domain.StatusPublished,
+	blogv1.PostStatus_POST_STATUS_ARCHIVED:  domain.StatusArchived,
+}
+
+// toPostStatus maps POST_STATUS_UNSPECIFIED and unknown values to the empty
+// status, which the service treats as "not set".
+func toPostStatus(status blogv1.PostStatus) domain.PostStatus {
+	return postStatuses[status]
+}
+
+func toProtoStatus(status domain.PostStatus) blogv1.PostStatus {
+	for protoStatus, domainStatus := range postStatuses {
+		if domainStatus == status {
+			return protoStatus
+		}
+	}
+	return blogv1.PostStatus_POST_STATUS_UNSPECIFIED
+}
+
+func toProtoEvent(e *domain.PostEvent) *blogv1.PostEvent {
+	var eventType blogv1.PostEventType
+	switch e.Type {
+	case domain.PostCreated:
+		eventType = blogv1.PostEventType_POST_EVENT_TYPE_CREATED
+	case domain.PostUpdated:
+		eventType = blogv1.PostEventType_POST_EVENT_TYPE_UPDATED
+	case domain.PostDeleted:
+		eventType = blogv1.PostEventType_POST_EVENT_TYPE_DELETED
+	}
+
+	return &blogv1.PostEvent{
+		Type:        eventType,
+		Post:        toProtoPost(e.Post),
+		ResumeToken: service.ResumeToken(e),
+		OccurredAt:  timestamppb.New(e.OccurredAt),
 	}
 }
@@ -3,20 +3,32 @@ package handler
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/BhaveetKumar/gRPC-server-go/internal/logger"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/moderation"
 	"github.com/BhaveetKumar/gRPC-server-go/internal/repository/memory"
 	"github.com/BhaveetKumar/gRPC-server-go/internal/service"
 	blogv1 "github.com/BhaveetKumar/gRPC-server-go/proto/blog/v1"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func setupHandler() *BlogHandler {
 	repo := memory.NewPostRepository()
-	svc := service.NewPostService(repo)
+	comments := memory.NewCommentRepository()
+	authors := memory.NewAuthorRepository()
+	svc := service.NewPostService(repo, service.WithComments(comments), service.WithAuthors(authors))
 	log := logger.New()
-	return NewBlogHandler(svc, log)
+	return NewBlogHandler(svc, service.NewCommentService(comments, svc), service.NewAuthorService(authors, svc), service.NewAPIKeyService(memory.NewAPIKeyRepository()), log)
+}
+
+// callerContext simulates a request whose metadata identifies author.
+func callerContext(author string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(callerMetadataKey, author))
 }
 
 func TestBlogHandler_CreatePost(t *testing.T) {
@@ -59,7 +71,7 @@ func TestBlogHandler_CreatePostInvalid(t *testing.T) {
 
 func TestBlogHandler_GetPost(t *testing.T) {
 	handler := setupHandler()
-	ctx := context.Background()
+	ctx := callerContext("Author")
 	createReq := &blogv1.CreatePostRequest{Title: "Test", Content: "Content", Author: "Author"}
 	createResp, _ := handler.CreatePost(ctx, createReq)
 	postID := createResp.GetPost().GetPostId()
@@ -74,6 +86,34 @@ func TestBlogHandler_GetPost(t *testing.T) {
 	}
 }
 
+func TestBlogHandler_GetPostBySlug(t *testing.T) {
+	handler := setupHandler()
+	ctx := callerContext("Author")
+	created, _ := handler.CreatePost(ctx, &blogv1.CreatePostRequest{Title: "Hello World", Content: "Content", Author: "Author"})
+	post := created.GetPost()
+	if post.GetSlug() != "hello-world" {
+		t.Fatalf("unexpected slug: %q", post.GetSlug())
+	}
+
+	_, err := handler.UpdatePost(ctx, &blogv1.UpdatePostRequest{PostId: post.GetPostId(), Title: "Goodbye", UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"title"}}})
+	if err != nil {
+		t.Fatalf("update failed: %v", err)
+	}
+
+	resp, err := handler.GetPostBySlug(ctx, &blogv1.GetPostBySlugRequest{Slug: "hello-world"})
+	if err != nil {
+		t.Fatalf("get by slug failed: %v", err)
+	}
+	if !resp.GetRedirect() || resp.GetPost().GetSlug() != "goodbye" || resp.GetPost().GetPostId() != post.GetPostId() {
+		t.Fatalf("expected a redirect to the new slug, got %v", resp)
+	}
+
+	_, err = handler.GetPostBySlug(ctx, &blogv1.GetPostBySlugRequest{Slug: "missing"})
+	if st, ok := status.FromError(err); !ok || st.Code() != codes.NotFound {
+		t.Fatalf("expected NotFound for an unknown slug, got %v", err)
+	}
+}
+
 func TestBlogHandler_GetPostNotFound(t *testing.T) {
 	handler := setupHandler()
 	ctx := context.Background()
@@ -145,6 +185,151 @@ func TestBlogHandler_DeletePost(t *testing.T) {
 	}
 }
 
+func TestBlogHandler_TrashRestoreAndPurge(t *testing.T) {
+	handler := setupHandler()
+	ctx := callerContext("Author")
+	created, _ := handler.CreatePost(ctx, &blogv1.CreatePostRequest{Title: "Test", Content: "Content", Author: "Author"})
+	postID := created.GetPost().GetPostId()
+
+	if _, err := handler.DeletePost(ctx, &blogv1.DeletePostRequest{PostId: postID}); err != nil {
+		t.Fatalf("delete failed: %v", err)
+	}
+
+	list, err := handler.ListPosts(ctx, &blogv1.ListPostsRequest{ShowDeleted: true})
+	if err != nil {
+		t.Fatalf("list failed: %v", err)
+	}
+	if len(list.GetPosts()) != 1 || list.GetPosts()[0].GetDeleteTime() == nil {
+		t.Fatalf("expected the trashed post with a delete time, got %v", list.GetPosts())
+	}
+
+	restored, err := handler.RestorePost(ctx, &blogv1.RestorePostRequest{PostId: postID, Etag: list.GetPosts()[0].GetEtag()})
+	if err != nil {
+		t.Fatalf("restore failed: %v", err)
+	}
+	if restored.GetPost().GetDeleteTime() != nil {
+		t.Fatalf("expected restored post to have no delete time: %v", restored.GetPost())
+	}
+
+	_, err = handler.PurgePost(ctx, &blogv1.PurgePostRequest{PostId: postID})
+	if st, ok := status.FromError(err); !ok || st.Code() != codes.FailedPrecondition {
+		t.Fatalf("expected FailedPrecondition when purging a live post, got %v", err)
+	}
+
+	if _, err := handler.DeletePost(ctx, &blogv1.DeletePostRequest{PostId: postID}); err != nil {
+		t.Fatalf("delete failed: %v", err)
+	}
+	if _, err := handler.PurgePost(ctx, &blogv1.PurgePostRequest{PostId: postID}); err != nil {
+		t.Fatalf("purge failed: %v", err)
+	}
+	_, err = handler.RestorePost(ctx, &blogv1.RestorePostRequest{PostId: postID})
+	if st, ok := status.FromError(err); !ok || st.Code() != codes.NotFound {
+		t.Fatalf("expected NotFound after purge, got %v", err)
+	}
+}
+
+func TestBlogHandler_Comments(t *testing.T) {
+	handler := setupHandler()
+	ctx := callerContext("Author")
+	created, _ := handler.CreatePost(ctx, &blogv1.CreatePostRequest{Title: "Test", Content: "Content", Author: "Author"})
+	postID := created.GetPost().GetPostId()
+
+	_, err := handler.AddComment(context.Background(), &blogv1.AddCommentRequest{PostId: postID, Author: "Reader", Content: "hi"})
+	if st, ok := status.FromError(err); !ok || st.Code() != codes.NotFound {
+		t.Fatalf("expected NotFound when commenting on a hidden draft, got %v", err)
+	}
+	if _, err := handler.PublishPost(ctx, &blogv1.PublishPostRequest{PostId: postID}); err != nil {
+		t.Fatalf("publish failed: %v", err)
+	}
+
+	top, err := handler.AddComment(context.Background(), &blogv1.AddCommentRequest{PostId: postID, Author: "Reader", Content: "hi"})
+	if err != nil {
+		t.Fatalf("add comment failed: %v", err)
+	}
+	reply, err := handler.AddComment(context.Background(), &blogv1.AddCommentRequest{PostId: postID, ParentId: top.GetComment().GetCommentId(), Author: "Author", Content: "hello"})
+	if err != nil {
+		t.Fatalf("add reply failed: %v", err)
+	}
+
+	edited, err := handler.EditComment(context.Background(), &blogv1.EditCommentRequest{CommentId: reply.GetComment().GetCommentId(), Content: "hello!", Etag: reply.GetComment().GetEtag()})
+	if err != nil {
+		t.Fatalf("edit failed: %v", err)
+	}
+	if edited.GetComment().GetContent() != "hello!" || edited.GetComment().GetVersion() != 2 {
+		t.Fatalf("unexpected edited comment: %v", edited.GetComment())
+	}
+	_, err = handler.EditComment(context.Background(), &blogv1.EditCommentRequest{CommentId: reply.GetComment().GetCommentId(), Content: "x", Etag: reply.GetComment().GetEtag()})
+	if st, ok := status.FromError(err); !ok || st.Code() != codes.Aborted {
+		t.Fatalf("expected Aborted for a stale etag, got %v", err)
+	}
+
+	if _, err := handler.DeleteComment(context.Background(), &blogv1.DeleteCommentRequest{CommentId: top.GetComment().GetCommentId()}); err != nil {
+		t.Fatalf("delete comment failed: %v", err)
+	}
+
+	list, err := handler.ListComments(context.Background(), &blogv1.ListCommentsRequest{PostId: postID})
+	if err != nil {
+		t.Fatalf("list comments failed: %v", err)
+	}
+	comments := list.GetComments()
+	if len(comments) != 2 || !comments[0].GetDeleted() || comments[0].GetReplyCount() != 1 || comments[1].GetDepth() != 1 || comments[1].GetContent() != "hello!" {
+		t.Fatalf("unexpected thread: %v", comments)
+	}
+
+	_, err = handler.ListComments(context.Background(), &blogv1.ListCommentsRequest{PostId: postID, PageToken: "garbage"})
+	if st, ok := status.FromError(err); !ok || st.Code() != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument for a bad page token, got %v", err)
+	}
+}
+
+func TestBlogHandler_ModerationQueue(t *testing.T) {
+	svc := service.NewPostService(memory.NewPostRepository(),
+		service.WithModeration(moderation.NewPipeline(moderation.FirstPostApproval())),
+		service.WithModerators("Mod"))
+	handler := NewBlogHandler(svc, service.NewCommentService(memory.NewCommentRepository(), svc), service.NewAuthorService(memory.NewAuthorRepository(), svc), service.NewAPIKeyService(memory.NewAPIKeyRepository()), logger.New())
+	ctx := callerContext("Author")
+
+	created, _ := handler.CreatePost(ctx, &blogv1.CreatePostRequest{Title: "Test", Content: "Content", Author: "Author"})
+	post := created.GetPost()
+	if post.GetModerationState() != blogv1.ModerationState_MODERATION_STATE_PENDING || post.GetModerationReason() == "" {
+		t.Fatalf("expected a pending first post with a reason, got %v", post)
+	}
+
+	_, err := handler.ListPendingPosts(ctx, &blogv1.ListPendingPostsRequest{})
+	if st, ok := status.FromError(err); !ok || st.Code() != codes.PermissionDenied {
+		t.Fatalf("expected PermissionDenied for a non-moderator, got %v", err)
+	}
+
+	mod := callerContext("Mod")
+	pending, err := handler.ListPendingPosts(mod, &blogv1.ListPendingPostsRequest{})
+	if err != nil {
+		t.Fatalf("list pending failed: %v", err)
+	}
+	if len(pending.GetPosts()) != 1 || pending.GetPosts()[0].GetPostId() != post.GetPostId() {
+		t.Fatalf("unexpected queue: %v", pending.GetPosts())
+	}
+
+	rejected, err := handler.RejectPost(mod, &blogv1.RejectPostRequest{PostId: post.GetPostId(), Reason: "needs work", Etag: post.GetEtag()})
+	if err != nil {
+		t.Fatalf("reject failed: %v", err)
+	}
+	if rejected.GetPost().GetModerationState() != blogv1.ModerationState_MODERATION_STATE_REJECTED || rejected.GetPost().GetModerationReason() != "needs work" {
+		t.Fatalf("unexpected rejected post: %v", rejected.GetPost())
+	}
+
+	approved, err := handler.ApprovePost(mod, &blogv1.ApprovePostRequest{PostId: post.GetPostId()})
+	if err != nil {
+		t.Fatalf("approve failed: %v", err)
+	}
+	if approved.GetPost().GetModerationState() != blogv1.ModerationState_MODERATION_STATE_APPROVED {
+		t.Fatalf("unexpected approved post: %v", approved.GetPost())
+	}
+	_, err = handler.ApprovePost(mod, &blogv1.ApprovePostRequest{PostId: post.GetPostId()})
+	if st, ok := status.FromError(err); !ok || st.Code() != codes.FailedPrecondition {
+		t.Fatalf("expected FailedPrecondition when approving twice, got %v", err)
+	}
+}
+
 func TestBlogHandler_DeletePostNotFound(t *testing.T) {
 	handler := setupHandler()
 	ctx := context.Background()
@@ -189,3 +374,457 @@ func TestBlogHandler_FullCRUDFlow(t *testing.T) {
 		t.Fatal("get after delete should fail")
 	}
 }
+
+func TestBlogHandler_ListPosts(t *testing.T) {
+	handler := setupHandler()
+	ctx := callerContext("Author")
+	for _, title := range []string{"b", "a", "c"} {
+		_, _ = handler.CreatePost(ctx, &blogv1.CreatePostRequest{Title: title, Content: "Content", Author: "Author"})
+	}
+
+	resp, err := handler.ListPosts(ctx, &blogv1.ListPostsRequest{PageSize: 2, OrderBy: blogv1.PostOrder_POST_ORDER_TITLE_ASC})
+	if err != nil {
+		t.Fatalf("list posts failed: %v", err)
+	}
+	if len(resp.GetPosts()) != 2 || resp.GetPosts()[0].GetTitle() != "a" {
+		t.Fatalf("unexpected first page: %v", resp.GetPosts())
+	}
+	if resp.GetNextPageToken() == "" {
+		t.Fatal("expected next page token")
+	}
+
+	resp, err = handler.ListPosts(ctx, &blogv1.ListPostsRequest{PageSize: 2, PageToken: resp.GetNextPageToken(), OrderBy: blogv1.PostOrder_POST_ORDER_TITLE_ASC})
+	if err != nil {
+		t.Fatalf("list second page failed: %v", err)
+	}
+	if len(resp.GetPosts()) != 1 || resp.GetPosts()[0].GetTitle() != "c" || resp.GetNextPageToken() != "" {
+		t.Fatalf("unexpected second page: %v", resp)
+	}
+}
+
+func TestBlogHandler_ListPostsInvalidToken(t *testing.T) {
+	handler := setupHandler()
+	ctx := context.Background()
+
+	_, err := handler.ListPosts(ctx, &blogv1.ListPostsRequest{PageToken: "bogus"})
+	st, ok := status.FromError(err)
+	if !ok || st.Code() != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument, got %v", err)
+	}
+}
+
+func TestBlogHandler_SearchPosts(t *testing.T) {
+	handler := setupHandler()
+	ctx := callerContext("Author")
+	created, _ := handler.CreatePost(ctx, &blogv1.CreatePostRequest{Title: "Go generics", Content: "Type parameters in Go.", Author: "Author"})
+	_, _ = handler.CreatePost(ctx, &blogv1.CreatePostRequest{Title: "Rust traits", Content: "Traits in Rust.", Author: "Author"})
+
+	resp, err := handler.SearchPosts(ctx, &blogv1.SearchPostsRequest{Query: `"type parameters"`})
+	if err != nil {
+		t.Fatalf("search posts failed: %v", err)
+	}
+	if len(resp.GetResults()) != 1 {
+		t.Fatalf("expected 1 result, got %v", resp.GetResults())
+	}
+	result := resp.GetResults()[0]
+	if result.GetPost().GetPostId() != created.GetPost().GetPostId() || result.GetScore() <= 0 {
+		t.Fatalf("unexpected result: %v", result)
+	}
+	if result.GetSnippet() != "<em>Type</em> <em>parameters</em> in Go." {
+		t.Fatalf("unexpected snippet: %q", result.GetSnippet())
+	}
+}
+
+func TestBlogHandler_SearchPostsInvalidQuery(t *testing.T) {
+	handler := setupHandler()
+	ctx := context.Background()
+
+	_, err := handler.SearchPosts(ctx, &blogv1.SearchPostsRequest{Query: "(go"})
+	st, ok := status.FromError(err)
+	if !ok || st.Code() != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument, got %v", err)
+	}
+}
+
+func TestBlogHandler_PublishWorkflow(t *testing.T) {
+	handler := setupHandler()
+	ctx := context.Background()
+	created, _ := handler.CreatePost(ctx, &blogv1.CreatePostRequest{Title: "Test", Content: "Content", Author: "Author"})
+	postID := created.GetPost().GetPostId()
+	if created.GetPost().GetStatus() != blogv1.PostStatus_POST_STATUS_DRAFT {
+		t.Fatalf("expected draft, got %v", created.GetPost().GetStatus())
+	}
+
+	_, err := handler.GetPost(ctx, &blogv1.GetPostRequest{PostId: postID})
+	if st, ok := status.FromError(err); !ok || st.Code() != codes.NotFound {
+		t.Fatalf("expected anonymous get of draft to be NotFound, got %v", err)
+	}
+
+	published, err := handler.PublishPost(ctx, &blogv1.PublishPostRequest{PostId: postID, Etag: created.GetPost().GetEtag()})
+	if err != nil {
+		t.Fatalf("publish failed: %v", err)
+	}
+	if published.GetPost().GetStatus() != blogv1.PostStatus_POST_STATUS_PUBLISHED {
+		t.Fatalf("expected published, got %v", published.GetPost().GetStatus())
+	}
+	if _, err := handler.GetPost(ctx, &blogv1.GetPostRequest{PostId: postID}); err != nil {
+		t.Fatalf("expected anonymous get of published post to succeed, got %v", err)
+	}
+
+	_, err = handler.PublishPost(ctx, &blogv1.PublishPostRequest{PostId: postID})
+	if st, ok := status.FromError(err); !ok || st.Code() != codes.FailedPrecondition {
+		t.Fatalf("expected FailedPrecondition, got %v", err)
+	}
+
+	if _, err := handler.UnpublishPost(ctx, &blogv1.UnpublishPostRequest{PostId: postID}); err != nil {
+		t.Fatalf("unpublish failed: %v", err)
+	}
+	archived, err := handler.ArchivePost(ctx, &blogv1.ArchivePostRequest{PostId: postID})
+	if err != nil {
+		t.Fatalf("archive failed: %v", err)
+	}
+	if archived.GetPost().GetStatus() != blogv1.PostStatus_POST_STATUS_ARCHIVED {
+		t.Fatalf("expected archived, got %v", archived.GetPost().GetStatus())
+	}
+}
+
+func TestBlogHandler_SchedulePost(t *testing.T) {
+	handler := setupHandler()
+	ctx := context.Background()
+	created, _ := handler.CreatePost(ctx, &blogv1.CreatePostRequest{Title: "Test", Content: "Content", Author: "Author"})
+	postID := created.GetPost().GetPostId()
+
+	_, err := handler.SchedulePost(ctx, &blogv1.SchedulePostRequest{PostId: postID})
+	if st, ok := status.FromError(err); !ok || st.Code() != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument without publish_at, got %v", err)
+	}
+
+	publishAt := time.Now().Add(time.Hour).Truncate(time.Second)
+	resp, err := handler.SchedulePost(ctx, &blogv1.SchedulePostRequest{PostId: postID, PublishAt: timestamppb.New(publishAt)})
+	if err != nil {
+		t.Fatalf("schedule failed: %v", err)
+	}
+	if resp.GetPost().GetStatus() != blogv1.PostStatus_POST_STATUS_SCHEDULED {
+		t.Fatalf("expected scheduled, got %v", resp.GetPost().GetStatus())
+	}
+	if !resp.GetPost().GetPublishTime().AsTime().Equal(publishAt) {
+		t.Fatalf("unexpected publish time: %v", resp.GetPost().GetPublishTime())
+	}
+}
+
+func TestBlogHandler_PostRevisions(t *testing.T) {
+	handler := setupHandler()
+	ctx := callerContext("Author")
+	created, _ := handler.CreatePost(ctx, &blogv1.CreatePostRequest{Title: "First", Content: "Content", Author: "Author"})
+	postID := created.GetPost().GetPostId()
+
+	updated, err := handler.UpdatePost(ctx, &blogv1.UpdatePostRequest{
+		PostId:     postID,
+		Title:      "Second",
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"title"}},
+	})
+	if err != nil {
+		t.Fatalf("update failed: %v", err)
+	}
+
+	list, err := handler.ListPostRevisions(ctx, &blogv1.ListPostRevisionsRequest{PostId: postID})
+	if err != nil {
+		t.Fatalf("list revisions failed: %v", err)
+	}
+	if len(list.GetRevisions()) != 2 || list.GetRevisions()[0].GetRevision() != 2 {
+		t.Fatalf("unexpected revisions: %v", list.GetRevisions())
+	}
+	latest := list.GetRevisions()[0]
+	if latest.GetEditor() != "Author" || latest.GetPost().GetTitle() != "Second" || latest.GetCreateTime() == nil {
+		t.Fatalf("unexpected latest revision: %v", latest)
+	}
+	if len(latest.GetChangedFields()) != 1 || latest.GetChangedFields()[0] != "title" {
+		t.Fatalf("unexpected changed fields: %v", latest.GetChangedFields())
+	}
+
+	got, err := handler.GetPostRevision(ctx, &blogv1.GetPostRevisionRequest{PostId: postID, Revision: 1})
+	if err != nil {
+		t.Fatalf("get revision failed: %v", err)
+	}
+	if got.GetRevision().GetPost().GetTitle() != "First" {
+		t.Fatalf("unexpected revision: %v", got.GetRevision())
+	}
+
+	_, err = handler.GetPostRevision(ctx, &blogv1.GetPostRevisionRequest{PostId: postID, Revision: 7})
+	if st, ok := status.FromError(err); !ok || st.Code() != codes.NotFound {
+		t.Fatalf("expected NotFound for a missing revision, got %v", err)
+	}
+	_, err = handler.ListPostRevisions(context.Background(), &blogv1.ListPostRevisionsRequest{PostId: postID})
+	if st, ok := status.FromError(err); !ok || st.Code() != codes.NotFound {
+		t.Fatalf("expected anonymous history of a draft to be NotFound, got %v", err)
+	}
+
+	restored, err := handler.RestorePostRevision(ctx, &blogv1.RestorePostRevisionRequest{PostId: postID, Revision: 1, Etag: updated.GetPost().GetEtag()})
+	if err != nil {
+		t.Fatalf("restore failed: %v", err)
+	}
+	if restored.GetPost().GetTitle() != "First" || restored.GetPost().GetVersion() != 3 {
+		t.Fatalf("unexpected restored post: %v", restored.GetPost())
+	}
+
+	_, err = handler.RestorePostRevision(ctx, &blogv1.RestorePostRevisionRequest{PostId: postID, Revision: 1, Etag: updated.GetPost().GetEtag()})
+	if st, ok := status.FromError(err); !ok || st.Code() != codes.Aborted {
+		t.Fatalf("expected Aborted for a stale etag, got %v", err)
+	}
+}
+
+func TestBlogHandler_DiffPostRevisions(t *testing.T) {
+	handler := setupHandler()
+	ctx := callerContext("Author")
+	created, _ := handler.CreatePost(ctx, &blogv1.CreatePostRequest{Title: "Title", Content: "one\ntwo\n", Author: "Author", Tags: []string{"go"}})
+	postID := created.GetPost().GetPostId()
+	_, err := handler.UpdatePost(ctx, &blogv1.UpdatePostRequest{
+		PostId:     postID,
+		Content:    "one\nthree\n",
+		Tags:       []string{"grpc"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content", "tags"}},
+	})
+	if err != nil {
+		t.Fatalf("update failed: %v", err)
+	}
+
+	hunks, err := handler.DiffPostRevisions(ctx, &blogv1.DiffPostRevisionsRequest{PostId: postID, FromRevision: 1, ToRevision: 2})
+	if err != nil {
+		t.Fatalf("diff failed: %v", err)
+	}
+	if len(hunks.GetHunks()) != 1 || hunks.GetUnifiedDiff() != "" {
+		t.Fatalf("expected structured hunks only: %v", hunks)
+	}
+	lines := hunks.GetHunks()[0].GetLines()
+	if len(lines) != 3 || lines[1].GetOp() != blogv1.DiffOp_DIFF_OP_DELETE || lines[2].GetOp() != blogv1.DiffOp_DIFF_OP_INSERT {
+		t.Fatalf("unexpected hunk lines: %v", lines)
+	}
+	if len(hunks.GetAddedTags()) != 1 || hunks.GetAddedTags()[0] != "grpc" || len(hunks.GetRemovedTags()) != 1 {
+		t.Fatalf("unexpected tag changes: %v", hunks)
+	}
+
+	unified, err := handler.DiffPostRevisions(ctx, &blogv1.DiffPostRevisionsRequest{PostId: postID, FromRevision: 1, Format: blogv1.DiffFormat_DIFF_FORMAT_UNIFIED})
+	if err != nil {
+		t.Fatalf("unified diff failed: %v", err)
+	}
+	want := "--- " + postID + "@1\n+++ " + postID + "@2\n@@ -1,2 +1,2 @@\n one\n-two\n+three\n"
+	if unified.GetUnifiedDiff() != want || len(unified.GetHunks()) != 0 {
+		t.Fatalf("unexpected unified diff:\n%s", unified.GetUnifiedDiff())
+	}
+
+	_, err = handler.DiffPostRevisions(ctx, &blogv1.DiffPostRevisionsRequest{PostId: postID, FromRevision: 1, Format: blogv1.DiffFormat(42)})
+	if st, ok := status.FromError(err); !ok || st.Code() != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument for an unknown format, got %v", err)
+	}
+}
+
+func TestBlogHandler_UpdatePostWithMask(t *testing.T) {
+	handler := setupHandler()
+	ctx := context.Background()
+	createResp, _ := handler.CreatePost(ctx, &blogv1.CreatePostRequest{Title: "Original", Content: "Content", Author: "Author", Tags: []string{"old"}})
+	postID := createResp.GetPost().GetPostId()
+
+	// Fields outside the mask are ignored, including an unknown author_id.
+	updateReq := &blogv1.UpdatePostRequest{PostId: postID, Tags: []string{"new"}, AuthorId: "missing", UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"tags"}}}
+	updateResp, err := handler.UpdatePost(ctx, updateReq)
+	if err != nil {
+		t.Fatalf("update post failed: %v", err)
+	}
+	if updateResp.GetPost().GetTitle() != "Original" || updateResp.GetPost().GetTags()[0] != "new" || updateResp.GetPost().GetAuthor() != "Author" {
+		t.Fatalf("unexpected post after masked update: %v", updateResp.GetPost())
+	}
+
+	updateReq = &blogv1.UpdatePostRequest{PostId: postID, AuthorId: "missing", UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"author"}}}
+	if _, err := handler.UpdatePost(ctx, updateReq); status.Code(err) != codes.NotFound {
+		t.Fatalf("expected an unknown author_id in the mask to be not found, got %v", err)
+	}
+}
+
+func TestBlogHandler_UpdatePostUnknownMaskPath(t *testing.T) {
+	handler := setupHandler()
+	ctx := context.Background()
+	createResp, _ := handler.CreatePost(ctx, &blogv1.CreatePostRequest{Title: "Original", Content: "Content", Author: "Author"})
+
+	updateReq := &blogv1.UpdatePostRequest{PostId: createResp.GetPost().GetPostId(), UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"unknown"}}}
+	_, err := handler.UpdatePost(ctx, updateReq)
+	st, ok := status.FromError(err)
+	if !ok || st.Code() != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument, got %v", err)
+	}
+}
+
+func TestBlogHandler_UpdatePostStaleEtag(t *testing.T) {
+	handler := setupHandler()
+	ctx := context.Background()
+	createResp, _ := handler.CreatePost(ctx, &blogv1.CreatePostRequest{Title: "Original", Content: "Content", Author: "Author"})
+	post := createResp.GetPost()
+
+	_, err := handler.UpdatePost(ctx, &blogv1.UpdatePostRequest{PostId: post.GetPostId(), Title: "First", Content: "Content", Author: "Author", Etag: post.GetEtag()})
+	if err != nil {
+		t.Fatalf("update post failed: %v", err)
+	}
+
+	_, err = handler.UpdatePost(ctx, &blogv1.UpdatePostRequest{PostId: post.GetPostId(), Title: "Second", Content: "Content", Author: "Author", Etag: post.GetEtag()})
+	st, ok := status.FromError(err)
+	if !ok || st.Code() != codes.Aborted {
+		t.Fatalf("expected Aborted, got %v", err)
+	}
+}
+
+func TestBlogHandler_GetPostDeadlineExceeded(t *testing.T) {
+	handler := setupHandler()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Nanosecond)
+	defer cancel()
+	<-ctx.Done()
+
+	_, err := handler.GetPost(ctx, &blogv1.GetPostRequest{PostId: "any"})
+	st, ok := status.FromError(err)
+	if !ok || st.Code() != codes.DeadlineExceeded {
+		t.Fatalf("expected DeadlineExceeded, got %v", err)
+	}
+}
+
+func TestBlogHandler_CreatePostCanceled(t *testing.T) {
+	handler := setupHandler()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := handler.CreatePost(ctx, &blogv1.CreatePostRequest{Title: "Title", Content: "Content", Author: "Author"})
+	st, ok := status.FromError(err)
+	if !ok || st.Code() != codes.Canceled {
+		t.Fatalf("expected Canceled, got %v", err)
+	}
+}
+
+func TestBlogHandler_Authors(t *testing.T) {
+	handler := setupHandler()
+	ctx := callerContext("grace hopper")
+
+	created, err := handler.CreateAuthor(ctx, &blogv1.CreateAuthorRequest{Name: "Grace Hopper", Bio: "COBOL", AvatarUrl: "https://example.com/grace.png"})
+	if err != nil {
+		t.Fatalf("create author failed: %v", err)
+	}
+	authorID := created.GetAuthor().GetAuthorId()
+
+	_, err = handler.CreateAuthor(ctx, &blogv1.CreateAuthorRequest{Name: "GRACE HOPPER"})
+	if st, ok := status.FromError(err); !ok || st.Code() != codes.AlreadyExists {
+		t.Fatalf("expected AlreadyExists for another spelling, got %v", err)
+	}
+
+	byID, err := handler.CreatePost(ctx, &blogv1.CreatePostRequest{Title: "By id", Content: "Content", AuthorId: authorID})
+	if err != nil {
+		t.Fatalf("create post by author id failed: %v", err)
+	}
+	byName, err := handler.CreatePost(ctx, &blogv1.CreatePostRequest{Title: "By name", Content: "Content", Author: "grace  hopper"})
+	if err != nil {
+		t.Fatalf("create post by name failed: %v", err)
+	}
+	for _, post := range []*blogv1.Post{byID.GetPost(), byName.GetPost()} {
+		if post.GetAuthorId() != authorID || post.GetAuthor() != "Grace Hopper" {
+			t.Fatalf("expected the post to belong to Grace Hopper, got %v", post)
+		}
+	}
+
+	_, err = handler.CreatePost(ctx, &blogv1.CreatePostRequest{Title: "Ghost", Content: "Content", AuthorId: "missing"})
+	if st, ok := status.FromError(err); !ok || st.Code() != codes.NotFound {
+		t.Fatalf("expected NotFound for an unknown author id, got %v", err)
+	}
+
+	_, _ = handler.CreatePost(callerContext("Other"), &blogv1.CreatePostRequest{Title: "Other", Content: "Content", Author: "Other"})
+	list, err := handler.ListPosts(ctx, &blogv1.ListPostsRequest{AuthorId: authorID})
+	if err != nil {
+		t.Fatalf("list posts failed: %v", err)
+	}
+	if len(list.GetPosts()) != 2 {
+		t.Fatalf("expected 2 posts by author id, got %d", len(list.GetPosts()))
+	}
+
+	updated, err := handler.UpdateAuthor(ctx, &blogv1.UpdateAuthorRequest{
+		AuthorId:   authorID,
+		Name:       "Rear Admiral Grace Hopper",
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"name"}},
+		Etag:       created.GetAuthor().GetEtag(),
+	})
+	if err != nil {
+		t.Fatalf("update author failed: %v", err)
+	}
+	if updated.GetAuthor().GetBio() != "COBOL" || updated.GetAuthor().GetVersion() != 2 {
+		t.Fatalf("unexpected updated author: %v", updated.GetAuthor())
+	}
+
+	got, err := handler.GetPost(callerContext("rear admiral grace hopper"), &blogv1.GetPostRequest{PostId: byID.GetPost().GetPostId()})
+	if err != nil {
+		t.Fatalf("get post failed: %v", err)
+	}
+	if got.GetPost().GetAuthor() != "Rear Admiral Grace Hopper" {
+		t.Fatalf("expected the byline to follow the rename, got %q", got.GetPost().GetAuthor())
+	}
+
+	_, err = handler.DeleteAuthor(ctx, &blogv1.DeleteAuthorRequest{AuthorId: authorID})
+	if st, ok := status.FromError(err); !ok || st.Code() != codes.FailedPrecondition {
+		t.Fatalf("expected FailedPrecondition for an author with posts, got %v", err)
+	}
+
+	authors, err := handler.ListAuthors(ctx, &blogv1.ListAuthorsRequest{PageSize: 1})
+	if err != nil {
+		t.Fatalf("list authors failed: %v", err)
+	}
+	if len(authors.GetAuthors()) != 1 || authors.GetAuthors()[0].GetName() != "Other" || authors.GetNextPageToken() == "" {
+		t.Fatalf("unexpected first page of authors: %v", authors)
+	}
+
+	_, err = handler.GetAuthor(ctx, &blogv1.GetAuthorRequest{AuthorId: "missing"})
+	if st, ok := status.FromError(err); !ok || st.Code() != codes.NotFound {
+		t.Fatalf("expected NotFound for an unknown author, got %v", err)
+	}
+}
+
+func TestBlogHandler_Tags(t *testing.T) {
+	svc := service.NewPostService(memory.NewPostRepository(), service.WithModerators("Mod"))
+	handler := NewBlogHandler(svc, service.NewCommentService(memory.NewCommentRepository(), svc), service.NewAuthorService(memory.NewAuthorRepository(), svc), service.NewAPIKeyService(memory.NewAPIKeyRepository()), logger.New())
+	ctx := callerContext("Author")
+
+	for _, tags := range [][]string{{" Go ", "gRPC"}, {"golang"}} {
+		created, err := handler.CreatePost(ctx, &blogv1.CreatePostRequest{Title: "Test", Content: "Content", Author: "Author", Tags: tags})
+		if err != nil {
+			t.Fatalf("create failed: %v", err)
+		}
+		if _, err := handler.PublishPost(ctx, &blogv1.PublishPostRequest{PostId: created.GetPost().GetPostId()}); err != nil {
+			t.Fatalf("publish failed: %v", err)
+		}
+	}
+
+	_, err := handler.MergeTags(ctx, &blogv1.MergeTagsRequest{Sources: []string{"golang"}, Target: "go"})
+	if st, ok := status.FromError(err); !ok || st.Code() != codes.PermissionDenied {
+		t.Fatalf("expected PermissionDenied for a non-moderator, got %v", err)
+	}
+
+	mod := callerContext("Mod")
+	_, err = handler.RenameTag(mod, &blogv1.RenameTagRequest{From: "golang", To: "GO"})
+	if st, ok := status.FromError(err); !ok || st.Code() != codes.AlreadyExists {
+		t.Fatalf("expected AlreadyExists when renaming onto a tag in use, got %v", err)
+	}
+	_, err = handler.RenameTag(mod, &blogv1.RenameTagRequest{From: "missing", To: "other"})
+	if st, ok := status.FromError(err); !ok || st.Code() != codes.NotFound {
+		t.Fatalf("expected NotFound for an unused tag, got %v", err)
+	}
+
+	merged, err := handler.MergeTags(mod, &blogv1.MergeTagsRequest{Sources: []string{"golang"}, Target: "go"})
+	if err != nil || merged.GetPostsChanged() != 1 {
+		t.Fatalf("expected 1 post to be merged, got %v, %v", merged, err)
+	}
+
+	resp, err := handler.ListTags(ctx, &blogv1.ListTagsRequest{})
+	if err != nil {
+		t.Fatalf("list tags failed: %v", err)
+	}
+	tags := resp.GetTags()
+	if len(tags) != 2 || tags[0].GetTag() != "go" || tags[0].GetPostCount() != 2 || tags[1].GetTag() != "grpc" {
+		t.Fatalf("unexpected tags: %v", tags)
+	}
+
+	_, err = handler.ListTags(ctx, &blogv1.ListTagsRequest{OrderBy: blogv1.TagOrder(99)})
+	if st, ok := status.FromError(err); !ok || st.Code() != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument for an unknown order, got %v", err)
+	}
+}
@@ -0,0 +1,162 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/BhaveetKumar/gRPC-server-go/internal/auth"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/authz"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/domain"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/service"
+	blogv1 "github.com/BhaveetKumar/gRPC-server-go/proto/blog/v1"
+)
+
+// Policies declares who may call each BlogService method. Reads are public
+// and left to the services' visibility rules; authors may only change their
+// own posts, comments and profile, and editors and admins anyone's.
+// Moderating only needs a signed-in caller here: the post service allows
+// editors and admins as well as the configured moderators. API keys
+// are further held to their scopes: reads need posts:read, changes
+// posts:write and managing keys admin. A method missing here is refused for
+// everyone.
+func (h *BlogHandler) Policies() authz.Policies {
+	read := func(p authz.Policy) authz.Policy { return authz.Scope(domain.ScopePostsRead, p) }
+	write := func(p authz.Policy) authz.Policy { return authz.Scope(domain.ScopePostsWrite, p) }
+
+	public := read(authz.Public())
+	ownPost := write(authz.OwnerOr(auth.RoleAuthor, auth.RoleEditor, h.postOwner))
+	moderator := write(authz.Require(auth.RoleReader))
+	moderatorRead := read(authz.Require(auth.RoleReader))
+	admin := authz.Scope(domain.ScopeAdmin, authz.Require(auth.RoleAdmin))
+
+	return authz.Policies{
+		blogv1.BlogService_CreatePost_FullMethodName:          write(authz.OwnerOr(auth.RoleAuthor, auth.RoleEditor, h.createPostOwner)),
+		blogv1.BlogService_GetPost_FullMethodName:             public,
+		blogv1.BlogService_GetPostBySlug_FullMethodName:       public,
+		blogv1.BlogService_UpdatePost_FullMethodName:          write(authz.OwnerOr(auth.RoleAuthor, auth.RoleEditor, h.updatePostOwners)),
+		blogv1.BlogService_DeletePost_FullMethodName:          ownPost,
+		blogv1.BlogService_RestorePost_FullMethodName:         ownPost,
+		blogv1.BlogService_PurgePost_FullMethodName:           ownPost,
+		blogv1.BlogService_ListPosts_FullMethodName:           public,
+		blogv1.BlogService_WatchPosts_FullMethodName:          public,
+		blogv1.BlogService_SearchPosts_FullMethodName:         public,
+		blogv1.BlogService_PublishPost_FullMethodName:         ownPost,
+		blogv1.BlogService_UnpublishPost_FullMethodName:       ownPost,
+		blogv1.BlogService_ArchivePost_FullMethodName:         ownPost,
+		blogv1.BlogService_SchedulePost_FullMethodName:        ownPost,
+		blogv1.BlogService_ListPendingPosts_FullMethodName:    moderatorRead,
+		blogv1.BlogService_ApprovePost_FullMethodName:         moderator,
+		blogv1.BlogService_RejectPost_FullMethodName:          moderator,
+		blogv1.BlogService_ListPostRevisions_FullMethodName:   public,
+		blogv1.BlogService_GetPostRevision_FullMethodName:     public,
+		blogv1.BlogService_RestorePostRevision_FullMethodName: ownPost,
+		blogv1.BlogService_DiffPostRevisions_FullMethodName:   public,
+		blogv1.BlogService_AddComment_FullMethodName:          write(authz.OwnerOr(auth.RoleReader, auth.RoleEditor, h.addCommentOwner)),
+		blogv1.BlogService_ListComments_FullMethodName:        public,
+		blogv1.BlogService_EditComment_FullMethodName:         write(authz.OwnerOr(auth.RoleReader, auth.RoleEditor, h.commentOwner)),
+		blogv1.BlogService_DeleteComment_FullMethodName:       write(authz.OwnerOr(auth.RoleReader, auth.RoleEditor, h.commentOwner)),
+		blogv1.BlogService_CreateAuthor_FullMethodName:        write(authz.OwnerOr(auth.RoleAuthor, auth.RoleEditor, h.createAuthorOwner)),
+		blogv1.BlogService_GetAuthor_FullMethodName:           public,
+		blogv1.BlogService_UpdateAuthor_FullMethodName:        write(authz.OwnerOr(auth.RoleAuthor, auth.RoleEditor, h.updateAuthorOwners)),
+		blogv1.BlogService_DeleteAuthor_FullMethodName:        write(authz.OwnerOr(auth.RoleAuthor, auth.RoleEditor, h.authorOwner)),
+		blogv1.BlogService_ListAuthors_FullMethodName:         public,
+		blogv1.BlogService_ListTags_FullMethodName:            public,
+		blogv1.BlogService_RenameTag_FullMethodName:           moderator,
+		blogv1.BlogService_MergeTags_FullMethodName:           moderator,
+		blogv1.BlogService_IssueApiKey_FullMethodName:         admin,
+		blogv1.BlogService_ListApiKeys_FullMethodName:         admin,
+		blogv1.BlogService_RevokeApiKey_FullMethodName:        admin,
+	}
+}
+
+// postOwner returns the author of the post a request names by post_id.
+func (h *BlogHandler) postOwner(ctx context.Context, req any) ([]string, error) {
+	author, err := h.service.PostAuthor(ctx, req.(interface{ GetPostId() string }).GetPostId())
+	if err != nil {
+		return nil, err
+	}
+	return owners(author), nil
+}
+
+func (h *BlogHandler) createPostOwner(ctx context.Context, req any) ([]string, error) {
+	r := req.(*blogv1.CreatePostRequest)
+	author, err := h.byline(ctx, r.GetAuthorId(), r.GetAuthor())
+	if err != nil {
+		return nil, err
+	}
+	return owners(author), nil
+}
+
+// updatePostOwners returns the post's author and, if the update sets the
+// byline, the new one: authors may not hand their posts to someone else.
+func (h *BlogHandler) updatePostOwners(ctx context.Context, req any) ([]string, error) {
+	current, err := h.postOwner(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	r := req.(*blogv1.UpdatePostRequest)
+	if !appliesField(r.GetUpdateMask().GetPaths(), service.FieldAuthor) {
+		return current, nil
+	}
+
+	author, err := h.byline(ctx, r.GetAuthorId(), r.GetAuthor())
+	if err != nil {
+		return nil, err
+	}
+	return append(current, owners(author)...), nil
+}
+
+func (h *BlogHandler) addCommentOwner(_ context.Context, req any) ([]string, error) {
+	return owners(req.(*blogv1.AddCommentRequest).GetAuthor()), nil
+}
+
+func (h *BlogHandler) commentOwner(ctx context.Context, req any) ([]string, error) {
+	// Comments are found through their post, which the caller must see.
+	author, err := h.comments.CommentAuthor(withCaller(ctx), req.(interface{ GetCommentId() string }).GetCommentId())
+	if err != nil {
+		return nil, err
+	}
+	return owners(author), nil
+}
+
+func (h *BlogHandler) createAuthorOwner(_ context.Context, req any) ([]string, error) {
+	return owners(req.(*blogv1.CreateAuthorRequest).GetName()), nil
+}
+
+// authorOwner returns the name of the author profile a request names by
+// author_id: profiles belong to the caller of the same name.
+func (h *BlogHandler) authorOwner(ctx context.Context, req any) ([]string, error) {
+	author, err := h.authors.GetAuthor(ctx, req.(interface{ GetAuthorId() string }).GetAuthorId())
+	if err != nil {
+		return nil, err
+	}
+	return owners(author.Name), nil
+}
+
+// updateAuthorOwners returns the profile's name and, if the update renames
+// it, the new name: renaming a profile moves its posts' bylines, so authors
+// may not rename themselves to someone else.
+func (h *BlogHandler) updateAuthorOwners(ctx context.Context, req any) ([]string, error) {
+	current, err := h.authorOwner(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	r := req.(*blogv1.UpdateAuthorRequest)
+	if !appliesField(r.GetUpdateMask().GetPaths(), service.FieldName) {
+		return current, nil
+	}
+	return append(current, owners(r.GetName())...), nil
+}
+
+// owners lists the non-empty names; a request without a name is left for
+// the services to reject as invalid.
+func owners(names ...string) []string {
+	var out []string
+	for _, name := range names {
+		if name != "" {
+			out = append(out, name)
+		}
+	}
+	return out
+}
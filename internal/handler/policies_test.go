@@ -0,0 +1,194 @@
+package handler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/BhaveetKumar/gRPC-server-go/internal/auth"
+	apperrors "github.com/BhaveetKumar/gRPC-server-go/internal/errors"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/logger"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/moderation"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/repository/memory"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/service"
+	blogv1 "github.com/BhaveetKumar/gRPC-server-go/proto/blog/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func principalContext(subject string, role auth.Role) context.Context {
+	return auth.WithPrincipal(context.Background(), &auth.Principal{Subject: subject, Role: role})
+}
+
+func TestBlogHandler_PoliciesCoverEveryMethod(t *testing.T) {
+	policies := setupHandler().Policies()
+
+	desc := blogv1.BlogService_ServiceDesc
+	var methods []string
+	for _, m := range desc.Methods {
+		methods = append(methods, "/"+desc.ServiceName+"/"+m.MethodName)
+	}
+	for _, s := range desc.Streams {
+		methods = append(methods, "/"+desc.ServiceName+"/"+s.StreamName)
+	}
+
+	for _, method := range methods {
+		if policies[method] == nil {
+			t.Errorf("no policy declared for %s", method)
+		}
+	}
+	if len(policies) != len(methods) {
+		t.Errorf("expected %d policies, got %d", len(methods), len(policies))
+	}
+}
+
+func TestBlogHandler_PostPolicies(t *testing.T) {
+	h := setupHandler()
+	policies := h.Policies()
+	alice := principalContext("alice", auth.RoleAuthor)
+	bob := principalContext("bob", auth.RoleAuthor)
+	editor := principalContext("erin", auth.RoleEditor)
+	reader := principalContext("alice", auth.RoleReader)
+
+	created, err := h.CreatePost(callerContext("alice"), &blogv1.CreatePostRequest{Title: "title", Content: "content", Author: "alice"})
+	if err != nil {
+		t.Fatalf("create failed: %v", err)
+	}
+	id := created.GetPost().GetPostId()
+
+	check := func(ctx context.Context, method string, req any, want error) {
+		t.Helper()
+		if err := policies[method](ctx, req); err != want {
+			t.Fatalf("%s: expected %v, got %v", method, want, err)
+		}
+	}
+
+	create := blogv1.BlogService_CreatePost_FullMethodName
+	check(alice, create, &blogv1.CreatePostRequest{Author: "alice"}, nil)
+	check(alice, create, &blogv1.CreatePostRequest{Author: "bob"}, apperrors.ErrPermissionDenied)
+	check(reader, create, &blogv1.CreatePostRequest{Author: "alice"}, apperrors.ErrPermissionDenied)
+	check(editor, create, &blogv1.CreatePostRequest{Author: "bob"}, nil)
+	check(context.Background(), create, &blogv1.CreatePostRequest{Author: "bob"}, apperrors.ErrUnauthenticated)
+
+	update := blogv1.BlogService_UpdatePost_FullMethodName
+	check(alice, update, &blogv1.UpdatePostRequest{PostId: id, Author: "alice"}, nil)
+	check(bob, update, &blogv1.UpdatePostRequest{PostId: id, Author: "bob"}, apperrors.ErrPermissionDenied)
+	check(alice, update, &blogv1.UpdatePostRequest{PostId: id, Author: "bob"}, apperrors.ErrPermissionDenied)
+	// The byline is only checked when the update applies it.
+	check(alice, update, &blogv1.UpdatePostRequest{PostId: id, Author: "bob", UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"title"}}}, nil)
+	check(editor, update, &blogv1.UpdatePostRequest{PostId: id, Author: "bob"}, nil)
+	check(alice, update, &blogv1.UpdatePostRequest{PostId: "missing"}, apperrors.ErrPostNotFound)
+
+	// Ownership holds for trashed posts too.
+	if _, err := h.DeletePost(callerContext("alice"), &blogv1.DeletePostRequest{PostId: id}); err != nil {
+		t.Fatalf("delete failed: %v", err)
+	}
+	check(alice, blogv1.BlogService_RestorePost_FullMethodName, &blogv1.RestorePostRequest{PostId: id}, nil)
+	check(bob, blogv1.BlogService_PurgePost_FullMethodName, &blogv1.PurgePostRequest{PostId: id}, apperrors.ErrPermissionDenied)
+	check(editor, blogv1.BlogService_PurgePost_FullMethodName, &blogv1.PurgePostRequest{PostId: id}, nil)
+
+	check(context.Background(), blogv1.BlogService_ApprovePost_FullMethodName, &blogv1.ApprovePostRequest{PostId: id}, apperrors.ErrUnauthenticated)
+	check(context.Background(), blogv1.BlogService_ListPosts_FullMethodName, &blogv1.ListPostsRequest{}, nil)
+}
+
+func TestBlogHandler_CommentPolicies(t *testing.T) {
+	h := setupHandler()
+	policies := h.Policies()
+
+	ctx := callerContext("alice")
+	created, _ := h.CreatePost(ctx, &blogv1.CreatePostRequest{Title: "title", Content: "content", Author: "alice"})
+	if _, err := h.PublishPost(ctx, &blogv1.PublishPostRequest{PostId: created.GetPost().GetPostId()}); err != nil {
+		t.Fatalf("publish failed: %v", err)
+	}
+	comment, err := h.AddComment(context.Background(), &blogv1.AddCommentRequest{PostId: created.GetPost().GetPostId(), Author: "carol", Content: "hi"})
+	if err != nil {
+		t.Fatalf("add comment failed: %v", err)
+	}
+	id := comment.GetComment().GetCommentId()
+
+	add := policies[blogv1.BlogService_AddComment_FullMethodName]
+	if err := add(principalContext("carol", auth.RoleReader), &blogv1.AddCommentRequest{Author: "carol"}); err != nil {
+		t.Fatalf("expected a reader to comment as themselves, got %v", err)
+	}
+	if err := add(principalContext("carol", auth.RoleReader), &blogv1.AddCommentRequest{Author: "dave"}); err != apperrors.ErrPermissionDenied {
+		t.Fatalf("expected a reader commenting as someone else to be denied, got %v", err)
+	}
+
+	edit := policies[blogv1.BlogService_EditComment_FullMethodName]
+	if err := edit(principalContext("carol", auth.RoleReader), &blogv1.EditCommentRequest{CommentId: id}); err != nil {
+		t.Fatalf("expected the comment author to edit, got %v", err)
+	}
+	if err := edit(principalContext("alice", auth.RoleAuthor), &blogv1.EditCommentRequest{CommentId: id}); err != apperrors.ErrPermissionDenied {
+		t.Fatalf("expected the post author not to edit another's comment, got %v", err)
+	}
+	if err := edit(principalContext("erin", auth.RoleEditor), &blogv1.EditCommentRequest{CommentId: id}); err != nil {
+		t.Fatalf("expected an editor to edit any comment, got %v", err)
+	}
+}
+
+func TestBlogHandler_AuthorPolicies(t *testing.T) {
+	h := setupHandler()
+	policies := h.Policies()
+	alice := principalContext("alice", auth.RoleAuthor)
+	editor := principalContext("erin", auth.RoleEditor)
+
+	created, err := h.CreateAuthor(context.Background(), &blogv1.CreateAuthorRequest{Name: "alice"})
+	if err != nil {
+		t.Fatalf("create author failed: %v", err)
+	}
+	id := created.GetAuthor().GetAuthorId()
+
+	update := policies[blogv1.BlogService_UpdateAuthor_FullMethodName]
+	check := func(ctx context.Context, req *blogv1.UpdateAuthorRequest, want error) {
+		t.Helper()
+		if err := update(ctx, req); err != want {
+			t.Fatalf("%+v: expected %v, got %v", req, want, err)
+		}
+	}
+
+	check(alice, &blogv1.UpdateAuthorRequest{AuthorId: id, Name: "Alice", Bio: "hi"}, nil)
+	check(principalContext("bob", auth.RoleAuthor), &blogv1.UpdateAuthorRequest{AuthorId: id, Name: "bob"}, apperrors.ErrPermissionDenied)
+	// Renaming would hand every post to the new name.
+	check(alice, &blogv1.UpdateAuthorRequest{AuthorId: id, Name: "bob"}, apperrors.ErrPermissionDenied)
+	check(alice, &blogv1.UpdateAuthorRequest{AuthorId: id, Name: "bob", UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"name"}}}, apperrors.ErrPermissionDenied)
+	// The name is only checked when the update applies it.
+	check(alice, &blogv1.UpdateAuthorRequest{AuthorId: id, Name: "bob", Bio: "hi", UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"bio"}}}, nil)
+	check(editor, &blogv1.UpdateAuthorRequest{AuthorId: id, Name: "bob"}, nil)
+}
+
+func TestBlogHandler_ModerationPolicies(t *testing.T) {
+	svc := service.NewPostService(memory.NewPostRepository(),
+		service.WithModeration(moderation.NewPipeline(moderation.FirstPostApproval())),
+		service.WithModerators("Mod"))
+	h := NewBlogHandler(svc, service.NewCommentService(memory.NewCommentRepository(), svc), service.NewAuthorService(memory.NewAuthorRepository(), svc), service.NewAPIKeyService(memory.NewAPIKeyRepository()), logger.New())
+	policies := h.Policies()
+	approve := blogv1.BlogService_ApprovePost_FullMethodName
+
+	created, err := h.CreatePost(callerContext("alice"), &blogv1.CreatePostRequest{Title: "title", Content: "content", Author: "alice"})
+	if err != nil {
+		t.Fatalf("create failed: %v", err)
+	}
+	req := &blogv1.ApprovePostRequest{PostId: created.GetPost().GetPostId()}
+
+	if err := policies[approve](context.Background(), req); err != apperrors.ErrUnauthenticated {
+		t.Fatalf("expected anonymous callers to be refused, got %v", err)
+	}
+
+	// The policy lets any signed-in caller through; the service decides
+	// who moderates.
+	alice := principalContext("alice", auth.RoleAuthor)
+	if err := policies[approve](alice, req); err != nil {
+		t.Fatalf("expected the policy to defer to the service, got %v", err)
+	}
+	if _, err := h.ApprovePost(alice, req); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("expected PermissionDenied for a non-moderator, got %v", err)
+	}
+
+	mod := principalContext("Mod", auth.RoleReader)
+	if err := policies[approve](mod, req); err != nil {
+		t.Fatalf("expected a configured moderator to pass the policy, got %v", err)
+	}
+	if _, err := h.ApprovePost(mod, req); err != nil {
+		t.Fatalf("expected a configured moderator to approve, got %v", err)
+	}
+}
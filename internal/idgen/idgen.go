@@ -0,0 +1,75 @@
+// Package idgen generates post IDs. Random UUIDs are the default; UUIDv7,
+// ULID and snowflake IDs grow with time, so they keep database indexes
+// append-mostly and let posts be listed in creation order by ID.
+package idgen
+
+import (
+	"fmt"
+
+	"github.com/BhaveetKumar/gRPC-server-go/internal/clock"
+	"github.com/google/uuid"
+)
+
+// Generator names accepted by New.
+const (
+	UUIDv4    = "uuidv4"
+	UUIDv7    = "uuidv7"
+	ULID      = "ulid"
+	Snowflake = "snowflake"
+)
+
+type Generator interface {
+	NewID() string
+	// TimeOrdered reports whether every ID sorts after the IDs generated
+	// before it, once IDs of different lengths are compared by length
+	// first.
+	TimeOrdered() bool
+}
+
+// New returns the generator called name; nodeID is only used by Snowflake.
+// An empty name selects UUIDv4.
+func New(name string, nodeID int64, c clock.Clock) (Generator, error) {
+	switch name {
+	case "", UUIDv4:
+		return NewUUIDv4(), nil
+	case UUIDv7:
+		return NewUUIDv7(), nil
+	case ULID:
+		return NewULID(c), nil
+	case Snowflake:
+		return NewSnowflake(nodeID, c)
+	default:
+		return nil, fmt.Errorf("unknown id generator %q", name)
+	}
+}
+
+type uuidV4 struct{}
+
+// NewUUIDv4 returns a generator of random UUIDs.
+func NewUUIDv4() Generator {
+	return uuidV4{}
+}
+
+func (uuidV4) NewID() string {
+	return uuid.NewString()
+}
+
+func (uuidV4) TimeOrdered() bool {
+	return false
+}
+
+type uuidV7 struct{}
+
+// NewUUIDv7 returns a generator of RFC 9562 version 7 UUIDs, which start
+// with a millisecond timestamp and are monotonic within the process.
+func NewUUIDv7() Generator {
+	return uuidV7{}
+}
+
+func (uuidV7) NewID() string {
+	return uuid.Must(uuid.NewV7()).String()
+}
+
+func (uuidV7) TimeOrdered() bool {
+	return true
+}
@@ -0,0 +1,135 @@
+package idgen
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/BhaveetKumar/gRPC-server-go/internal/clock"
+)
+
+// assertOrdered generates n IDs, advancing clk by step before each, and
+// checks that they are unique and sort in creation order.
+func assertOrdered(t *testing.T, gen Generator, clk *clock.Fake, step time.Duration, n int) []string {
+	t.Helper()
+
+	ids := make([]string, 0, n)
+	seen := make(map[string]bool, n)
+	for i := 0; i < n; i++ {
+		clk.Advance(step)
+		id := gen.NewID()
+		if seen[id] {
+			t.Fatalf("duplicate id %q", id)
+		}
+		seen[id] = true
+		if i > 0 {
+			prev := ids[i-1]
+			if len(id) < len(prev) || (len(id) == len(prev) && id <= prev) {
+				t.Fatalf("id %q does not sort after %q", id, prev)
+			}
+		}
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+func TestNew(t *testing.T) {
+	clk := clock.NewFake(time.Now())
+	for name, ordered := range map[string]bool{"": false, UUIDv4: false, UUIDv7: true, ULID: true, Snowflake: true} {
+		gen, err := New(name, 0, clk)
+		if err != nil {
+			t.Fatalf("new %q failed: %v", name, err)
+		}
+		if gen.TimeOrdered() != ordered {
+			t.Fatalf("%q: expected TimeOrdered %t", name, ordered)
+		}
+		if gen.NewID() == gen.NewID() {
+			t.Fatalf("%q: expected distinct ids", name)
+		}
+	}
+
+	if _, err := New("autoincrement", 0, clk); err == nil {
+		t.Fatal("expected an unknown generator to be rejected")
+	}
+	if _, err := New(Snowflake, MaxSnowflakeNode+1, clk); err == nil {
+		t.Fatal("expected an out of range node to be rejected")
+	}
+}
+
+func TestUUIDv7_Ordered(t *testing.T) {
+	gen := NewUUIDv7()
+	prev := gen.NewID()
+	for i := 0; i < 1000; i++ {
+		id := gen.NewID()
+		if id <= prev {
+			t.Fatalf("id %q does not sort after %q", id, prev)
+		}
+		prev = id
+	}
+}
+
+func TestULID(t *testing.T) {
+	clk := clock.NewFake(time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC))
+	gen := NewULID(clk)
+
+	// Many IDs within the same millisecond and then across milliseconds.
+	ids := assertOrdered(t, gen, clk, 0, 1000)
+	assertOrdered(t, gen, clk, time.Millisecond, 10)
+
+	id := ids[0]
+	if len(id) != 26 {
+		t.Fatalf("expected 26 characters, got %q", id)
+	}
+	// The first ten characters hold the timestamp.
+	ms := int64(0)
+	for _, c := range id[:10] {
+		ms = ms*32 + int64(indexOf(t, c))
+	}
+	if ms != clk.Now().Add(-10*time.Millisecond).UnixMilli() {
+		t.Fatalf("unexpected timestamp %d in %q", ms, id)
+	}
+}
+
+func indexOf(t *testing.T, c rune) int {
+	t.Helper()
+
+	for i, d := range crockford {
+		if d == c {
+			return i
+		}
+	}
+	t.Fatalf("%q is not a Crockford base32 digit", c)
+	return 0
+}
+
+func TestULID_ClockGoesBackwards(t *testing.T) {
+	clk := clock.NewFake(time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC))
+	gen := NewULID(clk)
+
+	first := gen.NewID()
+	clk.Advance(-time.Second)
+	if second := gen.NewID(); second <= first {
+		t.Fatalf("id %q does not sort after %q", second, first)
+	}
+}
+
+func TestSnowflake(t *testing.T) {
+	clk := clock.NewFake(SnowflakeEpoch)
+	gen, err := NewSnowflake(42, clk)
+	if err != nil {
+		t.Fatalf("new failed: %v", err)
+	}
+
+	// More IDs than the sequence holds within one millisecond, then IDs
+	// whose decimal form gains digits.
+	assertOrdered(t, gen, clk, 0, 5000)
+	ids := assertOrdered(t, gen, clk, time.Hour, 10)
+
+	raw, err := strconv.ParseInt(ids[0], 10, 64)
+	if err != nil {
+		t.Fatalf("expected a decimal id, got %q", ids[0])
+	}
+	if node := raw >> snowflakeSequenceBits & MaxSnowflakeNode; node != 42 {
+		t.Fatalf("expected node 42 in %q, got %d", ids[0], node)
+	}
+}
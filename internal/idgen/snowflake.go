@@ -0,0 +1,65 @@
+package idgen
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/BhaveetKumar/gRPC-server-go/internal/clock"
+)
+
+const (
+	snowflakeNodeBits     = 10
+	snowflakeSequenceBits = 12
+
+	MaxSnowflakeNode = 1<<snowflakeNodeBits - 1
+)
+
+// SnowflakeEpoch is the moment snowflake timestamps count from.
+var SnowflakeEpoch = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// snowflakeGenerator creates 63-bit IDs made of the milliseconds since
+// SnowflakeEpoch, a node ID that keeps servers sharing storage apart, and a
+// per-millisecond sequence number, written in decimal.
+type snowflakeGenerator struct {
+	clock clock.Clock
+	node  int64
+
+	mu       sync.Mutex
+	lastMS   int64
+	sequence int64
+}
+
+// NewSnowflake returns a snowflake generator for nodeID, which must be
+// between 0 and MaxSnowflakeNode and unique among the servers writing to the
+// same storage.
+func NewSnowflake(nodeID int64, c clock.Clock) (Generator, error) {
+	if nodeID < 0 || nodeID > MaxSnowflakeNode {
+		return nil, fmt.Errorf("snowflake node id %d out of range 0-%d", nodeID, MaxSnowflakeNode)
+	}
+	return &snowflakeGenerator{clock: c, node: nodeID, lastMS: -1}, nil
+}
+
+func (g *snowflakeGenerator) NewID() string {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	ms := g.clock.Now().Sub(SnowflakeEpoch).Milliseconds()
+	if ms > g.lastMS {
+		g.lastMS = ms
+		g.sequence = 0
+	} else if g.sequence++; g.sequence == 1<<snowflakeSequenceBits {
+		// The sequence ran out within one millisecond, or the clock went
+		// backwards; borrow the next millisecond rather than wait.
+		g.lastMS++
+		g.sequence = 0
+	}
+
+	id := g.lastMS<<(snowflakeNodeBits+snowflakeSequenceBits) | g.node<<snowflakeSequenceBits | g.sequence
+	return strconv.FormatInt(id, 10)
+}
+
+func (g *snowflakeGenerator) TimeOrdered() bool {
+	return true
+}
@@ -0,0 +1,86 @@
+package idgen
+
+import (
+	"crypto/rand"
+	"sync"
+
+	"github.com/BhaveetKumar/gRPC-server-go/internal/clock"
+)
+
+const crockford = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+// ulidGenerator creates ULIDs: a 48-bit millisecond timestamp followed by 80
+// random bits, written as 26 Crockford base32 characters. IDs created in the
+// same millisecond increment the random part of the previous one so they
+// still sort in creation order.
+type ulidGenerator struct {
+	clock clock.Clock
+
+	mu     sync.Mutex
+	lastMS uint64
+	// entropy is the 80 random bits of the last ID.
+	entropy [10]byte
+}
+
+func NewULID(c clock.Clock) Generator {
+	return &ulidGenerator{clock: c}
+}
+
+func (g *ulidGenerator) NewID() string {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	ms := uint64(g.clock.Now().UnixMilli())
+	if ms > g.lastMS {
+		g.lastMS = ms
+		if _, err := rand.Read(g.entropy[:]); err != nil {
+			panic(err)
+		}
+	} else if !increment(g.entropy[:]) {
+		// The random part ran out within one millisecond, or the clock
+		// went backwards; borrow the next millisecond.
+		g.lastMS++
+	}
+
+	var id [16]byte
+	for i := 0; i < 6; i++ {
+		id[i] = byte(g.lastMS >> (8 * (5 - i)))
+	}
+	copy(id[6:], g.entropy[:])
+	return encodeCrockford(id)
+}
+
+func (g *ulidGenerator) TimeOrdered() bool {
+	return true
+}
+
+// increment adds one to the big-endian number in b and reports false when it
+// wrapped around to zero.
+func increment(b []byte) bool {
+	for i := len(b) - 1; i >= 0; i-- {
+		b[i]++
+		if b[i] != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// encodeCrockford writes the 128 bits of id as 26 base32 characters, the
+// first of which carries only the top three bits.
+func encodeCrockford(id [16]byte) string {
+	var out [26]byte
+	var acc uint32
+	bits := 2 // 26*5 = 130 bits, so the encoding starts with two zero bits
+	n := 0
+	for _, b := range id {
+		acc = acc<<8 | uint32(b)
+		bits += 8
+		for bits >= 5 {
+			bits -= 5
+			out[n] = crockford[(acc>>uint(bits))&31]
+			n++
+		}
+	}
+	return string(out[:])
+}
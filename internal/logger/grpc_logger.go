@@ -26,9 +26,9 @@ func UnaryServerInterceptor(base *Logger) grpc.UnaryServerInterceptor {
 		log := base.WithContext(logID, sessionID)
 
 		if base.enableRequestIDs {
-			log.Info(fmt.Sprintf("incoming request %s | input: %+v", info.FullMethod, req))
+			log.Info(fmt.Sprintf("incoming request %s | input: %+v", info.FullMethod, redact(req)))
 		} else {
-			log.std.Println(fmt.Sprintf("INFO: incoming request %s | input: %+v", info.FullMethod, req))
+			log.std.Println(fmt.Sprintf("INFO: incoming request %s | input: %+v", info.FullMethod, redact(req)))
 		}
 
 		resp, err := handler(ctx, req)
@@ -44,14 +44,51 @@ func UnaryServerInterceptor(base *Logger) grpc.UnaryServerInterceptor {
 		}
 
 		if base.enableRequestIDs {
-			log.Info(fmt.Sprintf("request succeeded in %s | output: %+v", duration.String(), resp))
+			log.Info(fmt.Sprintf("request succeeded in %s | output: %+v", duration.String(), redact(resp)))
 		} else {
-			log.std.Println(fmt.Sprintf("INFO: request succeeded in %s | output: %+v", duration.String(), resp))
+			log.std.Println(fmt.Sprintf("INFO: request succeeded in %s | output: %+v", duration.String(), redact(resp)))
 		}
 		return resp, nil
 	}
 }
 
+func StreamServerInterceptor(base *Logger) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+
+		md, _ := metadata.FromIncomingContext(ss.Context())
+		logID := firstOrDefault(md[logIDKey], uuid.NewString())
+		sessionID := firstOrDefault(md[sessionIDKey], uuid.NewString())
+
+		log := base.WithContext(logID, sessionID)
+
+		if base.enableRequestIDs {
+			log.Info(fmt.Sprintf("incoming stream %s", info.FullMethod))
+		} else {
+			log.std.Println(fmt.Sprintf("INFO: incoming stream %s", info.FullMethod))
+		}
+
+		err := handler(srv, ss)
+		duration := time.Since(start)
+
+		if err != nil {
+			if base.enableRequestIDs {
+				log.Error(fmt.Sprintf("stream failed in %s | error: %v", duration.String(), err))
+			} else {
+				log.std.Println(fmt.Sprintf("ERROR: stream failed in %s | error: %v", duration.String(), err))
+			}
+			return err
+		}
+
+		if base.enableRequestIDs {
+			log.Info(fmt.Sprintf("stream closed in %s", duration.String()))
+		} else {
+			log.std.Println(fmt.Sprintf("INFO: stream closed in %s", duration.String()))
+		}
+		return nil
+	}
+}
+
 func firstOrDefault(values []string, def string) string {
 	if len(values) == 0 {
 		return def
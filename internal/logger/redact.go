@@ -0,0 +1,86 @@
+package logger
+
+import (
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// redacted is what a sensitive field is replaced with in the log.
+const redacted = "[REDACTED]"
+
+// redact returns v ready for logging: proto messages are copied with every
+// field marked debug_redact, such as raw API keys, replaced or cleared.
+// Anything else is returned unchanged.
+func redact(v interface{}) interface{} {
+	msg, ok := v.(proto.Message)
+	if !ok || msg == nil || !msg.ProtoReflect().IsValid() || !hasRedacted(msg.ProtoReflect().Descriptor(), nil) {
+		return v
+	}
+
+	clone := proto.Clone(msg)
+	redactMessage(clone.ProtoReflect())
+	return clone
+}
+
+func redactMessage(m protoreflect.Message) {
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case isRedacted(fd):
+			if fd.Kind() == protoreflect.StringKind && !fd.IsList() && !fd.IsMap() {
+				m.Set(fd, protoreflect.ValueOfString(redacted))
+			} else {
+				m.Clear(fd)
+			}
+		case fd.IsMap():
+			if fd.MapValue().Message() != nil {
+				v.Map().Range(func(_ protoreflect.MapKey, mv protoreflect.Value) bool {
+					redactMessage(mv.Message())
+					return true
+				})
+			}
+		case fd.IsList():
+			if fd.Message() != nil {
+				list := v.List()
+				for i := 0; i < list.Len(); i++ {
+					redactMessage(list.Get(i).Message())
+				}
+			}
+		case fd.Message() != nil:
+			redactMessage(v.Message())
+		}
+		return true
+	})
+}
+
+// hasRedacted reports whether md or any message it contains has a redacted
+// field, so that most messages are logged without being copied.
+func hasRedacted(md protoreflect.MessageDescriptor, seen map[protoreflect.FullName]bool) bool {
+	if seen[md.FullName()] {
+		return false
+	}
+	if seen == nil {
+		seen = make(map[protoreflect.FullName]bool)
+	}
+	seen[md.FullName()] = true
+
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if isRedacted(fd) {
+			return true
+		}
+		if fd.IsMap() {
+			fd = fd.MapValue()
+		}
+		if fd.Message() != nil && hasRedacted(fd.Message(), seen) {
+			return true
+		}
+	}
+	return false
+}
+
+func isRedacted(fd protoreflect.FieldDescriptor) bool {
+	opts, ok := fd.Options().(*descriptorpb.FieldOptions)
+	return ok && opts.GetDebugRedact()
+}
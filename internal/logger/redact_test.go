@@ -0,0 +1,58 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"log"
+	"strings"
+	"testing"
+
+	blogv1 "github.com/BhaveetKumar/gRPC-server-go/proto/blog/v1"
+	"google.golang.org/grpc"
+)
+
+func TestUnaryServerInterceptor_RedactsSecrets(t *testing.T) {
+	for _, enableRequestIDs := range []bool{false, true} {
+		var buf bytes.Buffer
+		base := &Logger{std: log.New(&buf, "", 0), enableRequestIDs: enableRequestIDs}
+
+		resp := &blogv1.IssueApiKeyResponse{
+			ApiKey: &blogv1.ApiKey{KeyId: "0123456789abcdef", Name: "nightly export"},
+			RawKey: "blog_0123456789abcdef.very-secret",
+		}
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+			return resp, nil
+		}
+		info := &grpc.UnaryServerInfo{FullMethod: blogv1.BlogService_IssueApiKey_FullMethodName}
+
+		got, err := UnaryServerInterceptor(base)(context.Background(), &blogv1.IssueApiKeyRequest{Name: "nightly export"}, info, handler)
+		if err != nil {
+			t.Fatalf("interceptor failed: %v", err)
+		}
+
+		out := buf.String()
+		if strings.Contains(out, "very-secret") {
+			t.Fatalf("expected the raw key to be redacted, got %q", out)
+		}
+		if !strings.Contains(out, redacted) || !strings.Contains(out, "nightly export") {
+			t.Fatalf("expected the rest of the response to be logged, got %q", out)
+		}
+		if got.(*blogv1.IssueApiKeyResponse).RawKey != "blog_0123456789abcdef.very-secret" {
+			t.Fatal("expected the caller to get the raw key")
+		}
+	}
+}
+
+func TestRedact_LeavesOtherValues(t *testing.T) {
+	req := &blogv1.GetPostRequest{PostId: "p1"}
+	if got := redact(req); got != req {
+		t.Fatal("expected messages without secrets to be logged as they are")
+	}
+	if got := redact("plain"); got != "plain" {
+		t.Fatalf("expected non-messages to be unchanged, got %v", got)
+	}
+	var nilResp *blogv1.IssueApiKeyResponse
+	if got := redact(nilResp); got != nilResp {
+		t.Fatalf("expected a nil message to be unchanged, got %v", got)
+	}
+}
@@ -0,0 +1,98 @@
+// Package moderation decides whether new and edited posts can be shown right
+// away, need a moderator's approval first, or are rejected.
+package moderation
+
+import (
+	"context"
+	"strings"
+
+	"github.com/BhaveetKumar/gRPC-server-go/internal/domain"
+)
+
+// Decision is a rule's verdict on a post. Decisions are ordered by severity:
+// a pipeline returns the most severe decision of its rules.
+type Decision int
+
+const (
+	Approve Decision = iota
+	Hold
+	Reject
+)
+
+func (d Decision) String() string {
+	switch d {
+	case Approve:
+		return "approve"
+	case Hold:
+		return "hold"
+	case Reject:
+		return "reject"
+	default:
+		return "unknown"
+	}
+}
+
+type Verdict struct {
+	Decision Decision
+	// Reason tells the author what to fix; it is empty for Approve.
+	Reason string
+}
+
+// Submission is a post write being moderated.
+type Submission struct {
+	Post *domain.Post
+	// Previous is the post before an update; nil when it is being created.
+	Previous *domain.Post
+	History  History
+}
+
+// History answers questions about an author's other posts.
+type History interface {
+	// HasApprovedPost reports whether author has any approved post.
+	HasApprovedPost(ctx context.Context, author string) (bool, error)
+}
+
+// Rule is one moderation check. Name identifies the rule in reasons and
+// logs. Check returns an error only when it could not decide, in which case
+// the write fails.
+type Rule interface {
+	Name() string
+	Check(ctx context.Context, sub *Submission) (Verdict, error)
+}
+
+// Pipeline runs every registered rule against a submission.
+type Pipeline struct {
+	rules []Rule
+}
+
+func NewPipeline(rules ...Rule) *Pipeline {
+	return &Pipeline{rules: rules}
+}
+
+// Register adds a rule. It must not be called while the pipeline is in use.
+func (p *Pipeline) Register(rule Rule) {
+	p.rules = append(p.rules, rule)
+}
+
+// Evaluate returns the most severe decision of all rules, with the reasons
+// of every rule that did not approve, each prefixed with the rule's name.
+func (p *Pipeline) Evaluate(ctx context.Context, sub *Submission) (Verdict, error) {
+	var (
+		decision Decision
+		reasons  []string
+	)
+	for _, rule := range p.rules {
+		v, err := rule.Check(ctx, sub)
+		if err != nil {
+			return Verdict{}, err
+		}
+		if v.Decision == Approve {
+			continue
+		}
+		if v.Decision > decision {
+			decision = v.Decision
+		}
+		reasons = append(reasons, rule.Name()+": "+v.Reason)
+	}
+	return Verdict{Decision: decision, Reason: strings.Join(reasons, "; ")}, nil
+}
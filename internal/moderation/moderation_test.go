@@ -0,0 +1,132 @@
+package moderation
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/BhaveetKumar/gRPC-server-go/internal/domain"
+)
+
+type fakeHistory map[string]bool
+
+func (h fakeHistory) HasApprovedPost(_ context.Context, author string) (bool, error) {
+	return h[author], nil
+}
+
+func check(t *testing.T, rule Rule, post *domain.Post) Verdict {
+	t.Helper()
+
+	v, err := rule.Check(context.Background(), &Submission{Post: post, History: fakeHistory{"known": true}})
+	if err != nil {
+		t.Fatalf("%s failed: %v", rule.Name(), err)
+	}
+	return v
+}
+
+func TestBannedWords(t *testing.T) {
+	rule := BannedWords("spam", "Buy Now", "  ")
+
+	tests := []struct {
+		post *domain.Post
+		want Decision
+	}{
+		{&domain.Post{Title: "hello", Content: "nothing to see"}, Approve},
+		{&domain.Post{Title: "hello", Content: "SPAM, anyone?"}, Reject},
+		{&domain.Post{Title: "hello", Content: "spammer and spamming are fine"}, Approve},
+		{&domain.Post{Title: "buy... now!", Content: "x"}, Reject},
+		{&domain.Post{Title: "buy it now", Content: "x"}, Approve},
+		{&domain.Post{Title: "t", Content: "c", Tags: []string{"spam"}}, Reject},
+	}
+	for _, tt := range tests {
+		if got := check(t, rule, tt.post); got.Decision != tt.want {
+			t.Fatalf("%+v: expected %s, got %+v", tt.post, tt.want, got)
+		}
+	}
+
+	if got := check(t, rule, &domain.Post{Content: "buy now"}); got.Reason != `contains banned word "buy now"` {
+		t.Fatalf("unexpected reason: %q", got.Reason)
+	}
+}
+
+func TestMaxLinks(t *testing.T) {
+	rule := MaxLinks(2)
+
+	two := &domain.Post{Content: "see https://a.example and www.b.example"}
+	if got := check(t, rule, two); got.Decision != Approve {
+		t.Fatalf("expected two links to pass, got %+v", got)
+	}
+	three := &domain.Post{Title: "http://c.example", Content: two.Content}
+	if got := check(t, rule, three); got.Decision != Hold || got.Reason != "contains 3 links, more than 2" {
+		t.Fatalf("expected three links to be held, got %+v", got)
+	}
+}
+
+func TestRepeatedText(t *testing.T) {
+	rule := RepeatedText(3)
+
+	tests := []struct {
+		content string
+		want    Decision
+	}{
+		{"one\ntwo\none\nthree\none", Approve},
+		{"Buy now\nbuy  now\n\nBUY NOW\nbuy now", Hold},
+		{"very very very good", Approve},
+		{"very very, very very good", Hold},
+	}
+	for _, tt := range tests {
+		if got := check(t, rule, &domain.Post{Content: tt.content}); got.Decision != tt.want {
+			t.Fatalf("%q: expected %s, got %+v", tt.content, tt.want, got)
+		}
+	}
+}
+
+func TestFirstPostApproval(t *testing.T) {
+	rule := FirstPostApproval()
+
+	if got := check(t, rule, &domain.Post{Author: "known"}); got.Decision != Approve {
+		t.Fatalf("expected a known author to pass, got %+v", got)
+	}
+	if got := check(t, rule, &domain.Post{Author: "new"}); got.Decision != Hold {
+		t.Fatalf("expected a new author to be held, got %+v", got)
+	}
+}
+
+type ruleFunc func(*Submission) (Verdict, error)
+
+func (f ruleFunc) Name() string { return "custom" }
+
+func (f ruleFunc) Check(_ context.Context, sub *Submission) (Verdict, error) {
+	return f(sub)
+}
+
+func TestPipeline_MostSevereDecisionWins(t *testing.T) {
+	p := NewPipeline(MaxLinks(0), BannedWords("spam"))
+	p.Register(ruleFunc(func(*Submission) (Verdict, error) {
+		return Verdict{Decision: Approve, Reason: "ignored"}, nil
+	}))
+
+	sub := &Submission{Post: &domain.Post{Content: "spam at https://a.example"}}
+	v, err := p.Evaluate(context.Background(), sub)
+	if err != nil {
+		t.Fatalf("evaluate failed: %v", err)
+	}
+	if v.Decision != Reject {
+		t.Fatalf("expected reject, got %+v", v)
+	}
+	if !strings.HasPrefix(v.Reason, "max_links: ") || !strings.Contains(v.Reason, "; banned_words: ") {
+		t.Fatalf("expected the reasons of every rule, got %q", v.Reason)
+	}
+
+	v, _ = NewPipeline().Evaluate(context.Background(), sub)
+	if v.Decision != Approve || v.Reason != "" {
+		t.Fatalf("expected an empty pipeline to approve, got %+v", v)
+	}
+
+	failing := errors.New("lookup failed")
+	p.Register(ruleFunc(func(*Submission) (Verdict, error) { return Verdict{}, failing }))
+	if _, err := p.Evaluate(context.Background(), sub); !errors.Is(err, failing) {
+		t.Fatalf("expected the rule error, got %v", err)
+	}
+}
@@ -0,0 +1,134 @@
+package moderation
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// BannedWords rejects posts whose title, content or tags contain any of
+// words. Matching ignores case and punctuation and only matches whole words;
+// an entry of several words matches them as a phrase.
+func BannedWords(words ...string) Rule {
+	r := &bannedWords{}
+	for _, w := range words {
+		if phrase := normalizeWords(w); phrase != "" {
+			r.phrases = append(r.phrases, phrase)
+		}
+	}
+	return r
+}
+
+type bannedWords struct {
+	// phrases are normalized with normalizeWords, so matching a phrase is a
+	// substring search on normalized text.
+	phrases []string
+}
+
+func (r *bannedWords) Name() string { return "banned_words" }
+
+func (r *bannedWords) Check(_ context.Context, sub *Submission) (Verdict, error) {
+	text := normalizeWords(sub.Post.Title + "\n" + sub.Post.Content + "\n" + strings.Join(sub.Post.Tags, "\n"))
+	for _, phrase := range r.phrases {
+		if strings.Contains(text, phrase) {
+			return Verdict{Decision: Reject, Reason: fmt.Sprintf("contains banned word %q", strings.TrimSpace(phrase))}, nil
+		}
+	}
+	return Verdict{}, nil
+}
+
+// normalizeWords lower-cases s and reduces it to its words, each surrounded
+// by single spaces.
+func normalizeWords(s string) string {
+	words := strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	if len(words) == 0 {
+		return ""
+	}
+	return " " + strings.Join(words, " ") + " "
+}
+
+var linkPattern = regexp.MustCompile(`(?i)\b(?:https?://|www\.)[^\s]+`)
+
+// MaxLinks holds posts with more than max links in their title and content
+// for review.
+func MaxLinks(max int) Rule {
+	return maxLinks(max)
+}
+
+type maxLinks int
+
+func (r maxLinks) Name() string { return "max_links" }
+
+func (r maxLinks) Check(_ context.Context, sub *Submission) (Verdict, error) {
+	n := len(linkPattern.FindAllStringIndex(sub.Post.Title+"\n"+sub.Post.Content, -1))
+	if n > int(r) {
+		return Verdict{Decision: Hold, Reason: fmt.Sprintf("contains %d links, more than %d", n, int(r))}, nil
+	}
+	return Verdict{}, nil
+}
+
+// RepeatedText holds posts for review when a line of their content appears
+// more than maxRepeats times, or a word is repeated more than maxRepeats
+// times in a row: both are typical of spam.
+func RepeatedText(maxRepeats int) Rule {
+	return repeatedText(maxRepeats)
+}
+
+type repeatedText int
+
+func (r repeatedText) Name() string { return "repeated_text" }
+
+func (r repeatedText) Check(_ context.Context, sub *Submission) (Verdict, error) {
+	max := int(r)
+
+	lines := make(map[string]int)
+	for _, line := range strings.Split(sub.Post.Content, "\n") {
+		line = strings.ToLower(strings.Join(strings.Fields(line), " "))
+		if line == "" {
+			continue
+		}
+		lines[line]++
+		if lines[line] > max {
+			return Verdict{Decision: Hold, Reason: fmt.Sprintf("a line is repeated more than %d times", max)}, nil
+		}
+	}
+
+	run, prev := 0, ""
+	for _, word := range strings.Fields(normalizeWords(sub.Post.Title + "\n" + sub.Post.Content)) {
+		if word == prev {
+			run++
+		} else {
+			run, prev = 1, word
+		}
+		if run > max {
+			return Verdict{Decision: Hold, Reason: fmt.Sprintf("%q is repeated more than %d times in a row", word, max)}, nil
+		}
+	}
+
+	return Verdict{}, nil
+}
+
+// FirstPostApproval holds posts for review until their author has a post
+// that was approved.
+func FirstPostApproval() Rule {
+	return firstPostApproval{}
+}
+
+type firstPostApproval struct{}
+
+func (firstPostApproval) Name() string { return "first_post" }
+
+func (firstPostApproval) Check(ctx context.Context, sub *Submission) (Verdict, error) {
+	ok, err := sub.History.HasApprovedPost(ctx, sub.Post.Author)
+	if err != nil {
+		return Verdict{}, err
+	}
+	if !ok {
+		return Verdict{Decision: Hold, Reason: fmt.Sprintf("first post by %s needs approval", sub.Post.Author)}, nil
+	}
+	return Verdict{}, nil
+}
@@ -0,0 +1,59 @@
+package ratelimit
+
+import (
+	"context"
+	"net"
+
+	"github.com/BhaveetKumar/gRPC-server-go/internal/auth"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/domain"
+	apperrors "github.com/BhaveetKumar/gRPC-server-go/internal/errors"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/logger"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+)
+
+// UnaryServerInterceptor refuses calls beyond the caller's limit with
+// ResourceExhausted, saying when to retry in a RetryInfo detail. It should
+// run after the authentication interceptor so that callers are told apart
+// by who they are rather than where they call from.
+func UnaryServerInterceptor(l *Limiter, log *logger.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if ok, wait := l.Allow(info.FullMethod, callerKey(ctx)); !ok {
+			return nil, apperrors.ToStatus(&apperrors.RetryError{Err: apperrors.ErrRateLimited, RetryAfter: wait}, log)
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor limits how often streams are opened; the messages
+// on an open stream are not limited.
+func StreamServerInterceptor(l *Limiter, log *logger.Logger) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if ok, wait := l.Allow(info.FullMethod, callerKey(ss.Context())); !ok {
+			return apperrors.ToStatus(&apperrors.RetryError{Err: apperrors.ErrRateLimited, RetryAfter: wait}, log)
+		}
+		return handler(srv, ss)
+	}
+}
+
+// callerKey names whose bucket a call is taken from: the API key it was
+// made with, the verified principal, or failing those the address it came
+// from.
+func callerKey(ctx context.Context) string {
+	if p := auth.PrincipalFromContext(ctx); p != nil {
+		if p.KeyID != "" {
+			return "key:" + p.KeyID
+		}
+		return "principal:" + domain.NormalizeAuthorName(p.Subject)
+	}
+
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return "peer:unknown"
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		host = p.Addr.String()
+	}
+	return "peer:" + host
+}
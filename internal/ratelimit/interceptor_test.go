@@ -0,0 +1,70 @@
+package ratelimit
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/BhaveetKumar/gRPC-server-go/internal/auth"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/clock"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/logger"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+func TestUnaryServerInterceptor_RetryInfo(t *testing.T) {
+	l := NewLimiter(Limits{Default: Limit{Count: 1, Per: time.Minute}}, clock.NewFake(limiterEpoch))
+	interceptor := UnaryServerInterceptor(l, logger.New())
+	info := &grpc.UnaryServerInfo{FullMethod: createPost}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	}
+	ctx := auth.WithPrincipal(context.Background(), &auth.Principal{Subject: "alice"})
+
+	if _, err := interceptor(ctx, nil, info, handler); err != nil {
+		t.Fatalf("expected the first call through, got %v", err)
+	}
+	_, err := interceptor(ctx, nil, info, handler)
+	st := status.Convert(err)
+	if st.Code() != codes.ResourceExhausted {
+		t.Fatalf("expected resource exhausted, got %v", err)
+	}
+	details := st.Details()
+	if len(details) != 1 {
+		t.Fatalf("expected a retry info detail, got %v", details)
+	}
+	if info, ok := details[0].(*errdetails.RetryInfo); !ok || info.GetRetryDelay().AsDuration() != time.Minute {
+		t.Fatalf("expected to be told to retry in a minute, got %v", details[0])
+	}
+}
+
+func TestCallerKey(t *testing.T) {
+	addr := &net.TCPAddr{IP: net.ParseIP("192.0.2.7"), Port: 5000}
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: addr})
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want string
+	}{
+		{"peer", ctx, "peer:192.0.2.7"},
+		{"principal", auth.WithPrincipal(ctx, &auth.Principal{Subject: " Alice "}), "principal:alice"},
+		{"api key", auth.WithPrincipal(ctx, &auth.Principal{Subject: "alice", KeyID: "0123456789abcdef"}), "key:0123456789abcdef"},
+		{"unknown", context.Background(), "peer:unknown"},
+	}
+	for _, tt := range tests {
+		if got := callerKey(tt.ctx); got != tt.want {
+			t.Fatalf("%s: expected %q, got %q", tt.name, tt.want, got)
+		}
+	}
+
+	// Another connection from the same host shares the bucket.
+	other := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: addr.IP, Port: 6000}})
+	if callerKey(other) != callerKey(ctx) {
+		t.Fatal("expected the port to be ignored")
+	}
+}
@@ -0,0 +1,144 @@
+// Package ratelimit limits how fast each caller may call each gRPC method,
+// with a token bucket per caller and method.
+package ratelimit
+
+import (
+	"math"
+	"path"
+	"sync"
+	"time"
+
+	"github.com/BhaveetKumar/gRPC-server-go/internal/clock"
+)
+
+// sweepEvery is how often buckets that have filled up again are dropped, so
+// callers that have gone away do not hold memory.
+const sweepEvery = time.Minute
+
+// Limit lets a caller make Count calls every Per, in bursts of up to Burst
+// calls; a Burst of zero is Count. The zero Limit is no limit.
+type Limit struct {
+	Count int
+	Per   time.Duration
+	Burst int
+}
+
+func (l Limit) unlimited() bool {
+	return l.Count <= 0 || l.Per <= 0
+}
+
+func (l Limit) burst() float64 {
+	if l.Burst > 0 {
+		return float64(l.Burst)
+	}
+	return float64(l.Count)
+}
+
+// interval is how long the bucket takes to gain one token.
+func (l Limit) interval() time.Duration {
+	return l.Per / time.Duration(l.Count)
+}
+
+// Limits holds the limit of every method. Methods is keyed by the method's
+// name, such as CreatePost, or its full name; methods not in it get
+// Default.
+type Limits struct {
+	Default Limit
+	Methods map[string]Limit
+}
+
+func (l Limits) forMethod(fullMethod string) Limit {
+	if limit, ok := l.Methods[fullMethod]; ok {
+		return limit
+	}
+	if limit, ok := l.Methods[path.Base(fullMethod)]; ok {
+		return limit
+	}
+	return l.Default
+}
+
+type bucketKey struct {
+	method string
+	caller string
+}
+
+type bucket struct {
+	limit   Limit
+	tokens  float64
+	updated time.Time
+}
+
+// refill adds the tokens earned since the bucket was last updated.
+func (b *bucket) refill(now time.Time) {
+	if elapsed := now.Sub(b.updated); elapsed > 0 {
+		b.tokens = math.Min(b.limit.burst(), b.tokens+float64(elapsed)/float64(b.limit.interval()))
+		b.updated = now
+	}
+}
+
+// Limiter keeps a token bucket for every caller of every limited method.
+// It is safe for concurrent use.
+type Limiter struct {
+	limits Limits
+	clock  clock.Clock
+
+	mu        sync.Mutex
+	buckets   map[bucketKey]*bucket
+	lastSweep time.Time
+}
+
+func NewLimiter(limits Limits, c clock.Clock) *Limiter {
+	return &Limiter{
+		limits:    limits,
+		clock:     c,
+		buckets:   make(map[bucketKey]*bucket),
+		lastSweep: c.Now(),
+	}
+}
+
+// Allow takes a token from caller's bucket for fullMethod. When the bucket
+// is empty the call is refused, and Allow returns how long the caller must
+// wait for the next token.
+func (l *Limiter) Allow(fullMethod, caller string) (bool, time.Duration) {
+	limit := l.limits.forMethod(fullMethod)
+	if limit.unlimited() {
+		return true, 0
+	}
+
+	now := l.clock.Now()
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.sweep(now)
+
+	key := bucketKey{method: fullMethod, caller: caller}
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{limit: limit, tokens: limit.burst(), updated: now}
+		l.buckets[key] = b
+	}
+	b.refill(now)
+
+	if b.tokens >= 1 {
+		b.tokens--
+		return true, 0
+	}
+	wait := time.Duration(math.Ceil((1 - b.tokens) * float64(limit.interval())))
+	return false, wait
+}
+
+// sweep drops the buckets that are full again, which are no different from
+// the new bucket the caller's next call would get.
+func (l *Limiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < sweepEvery {
+		return
+	}
+	l.lastSweep = now
+
+	for key, b := range l.buckets {
+		b.refill(now)
+		if b.tokens >= b.limit.burst() {
+			delete(l.buckets, key)
+		}
+	}
+}
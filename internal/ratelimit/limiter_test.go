@@ -0,0 +1,98 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/BhaveetKumar/gRPC-server-go/internal/clock"
+)
+
+var limiterEpoch = time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+const createPost = "/blog.v1.BlogService/CreatePost"
+
+func TestLimiter_TokenBucket(t *testing.T) {
+	clk := clock.NewFake(limiterEpoch)
+	l := NewLimiter(Limits{Methods: map[string]Limit{"CreatePost": {Count: 6, Per: time.Minute, Burst: 2}}}, clk)
+
+	for i := 0; i < 2; i++ {
+		if ok, _ := l.Allow(createPost, "alice"); !ok {
+			t.Fatalf("expected call %d of the burst to be allowed", i+1)
+		}
+	}
+	ok, wait := l.Allow(createPost, "alice")
+	if ok || wait != 10*time.Second {
+		t.Fatalf("expected to wait 10s for the next token, got %v, %v", ok, wait)
+	}
+	if ok, _ := l.Allow(createPost, "bob"); !ok {
+		t.Fatal("expected other callers to have their own bucket")
+	}
+
+	clk.Advance(4 * time.Second)
+	if ok, wait := l.Allow(createPost, "alice"); ok || wait != 6*time.Second {
+		t.Fatalf("expected to wait the rest of the interval, got %v, %v", ok, wait)
+	}
+	clk.Advance(6 * time.Second)
+	if ok, _ := l.Allow(createPost, "alice"); !ok {
+		t.Fatal("expected a token after the interval")
+	}
+
+	// Tokens never pile up beyond the burst.
+	clk.Advance(time.Hour)
+	for i := 0; i < 2; i++ {
+		if ok, _ := l.Allow(createPost, "alice"); !ok {
+			t.Fatalf("expected call %d after a pause to be allowed", i+1)
+		}
+	}
+	if ok, _ := l.Allow(createPost, "alice"); ok {
+		t.Fatal("expected the burst to cap the saved up tokens")
+	}
+}
+
+func TestLimiter_MethodLimits(t *testing.T) {
+	clk := clock.NewFake(limiterEpoch)
+	l := NewLimiter(Limits{
+		Default: Limit{Count: 1, Per: time.Second},
+		Methods: map[string]Limit{
+			createPost:                       {Count: 1, Per: time.Hour},
+			"/blog.v1.BlogService/ListPosts": {},
+			"/blog.v1.BlogService/GetPost":   {Count: 3, Per: time.Second},
+			"CreatePost":                     {Count: 100, Per: time.Second},
+		},
+	}, clk)
+
+	l.Allow(createPost, "alice")
+	if ok, wait := l.Allow(createPost, "alice"); ok || wait != time.Hour {
+		t.Fatalf("expected the full method name to take precedence, got %v, %v", ok, wait)
+	}
+	for i := 0; i < 10; i++ {
+		if ok, _ := l.Allow("/blog.v1.BlogService/ListPosts", "alice"); !ok {
+			t.Fatal("expected a zero limit to be no limit")
+		}
+	}
+	l.Allow("/blog.v1.BlogService/DeletePost", "alice")
+	if ok, wait := l.Allow("/blog.v1.BlogService/DeletePost", "alice"); ok || wait != time.Second {
+		t.Fatalf("expected the default limit for other methods, got %v, %v", ok, wait)
+	}
+	if ok, _ := l.Allow("/blog.v1.BlogService/UpdatePost", "alice"); !ok {
+		t.Fatal("expected every method to have its own bucket")
+	}
+}
+
+func TestLimiter_SweepsFullBuckets(t *testing.T) {
+	clk := clock.NewFake(limiterEpoch)
+	l := NewLimiter(Limits{Default: Limit{Count: 1, Per: time.Hour}}, clk)
+
+	l.Allow(createPost, "alice")
+	clk.Advance(sweepEvery)
+	l.Allow(createPost, "bob")
+	if len(l.buckets) != 2 {
+		t.Fatalf("expected buckets still refilling to be kept, got %d", len(l.buckets))
+	}
+
+	clk.Advance(time.Hour)
+	l.Allow(createPost, "carol")
+	if _, ok := l.buckets[bucketKey{method: createPost, caller: "alice"}]; ok || len(l.buckets) != 1 {
+		t.Fatalf("expected full buckets to be dropped, got %d", len(l.buckets))
+	}
+}
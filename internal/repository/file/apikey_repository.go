@@ -0,0 +1,115 @@
+package file
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/BhaveetKumar/gRPC-server-go/internal/domain"
+	apperrors "github.com/BhaveetKumar/gRPC-server-go/internal/errors"
+)
+
+func (r *PostRepository) CreateAPIKey(ctx context.Context, key *domain.APIKey) error {
+	if err := key.Validate(); err != nil {
+		return err
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.apiKeys[key.ID]; exists {
+		return apperrors.ErrDuplicateAPIKey
+	}
+
+	return r.commit(record{Op: opPutAPIKey, ID: key.ID, APIKey: key.Clone()})
+}
+
+func (r *PostRepository) GetAPIKey(ctx context.Context, id string) (*domain.APIKey, error) {
+	if id == "" {
+		return nil, apperrors.ErrInvalidInput
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	key, ok := r.apiKeys[id]
+	if !ok {
+		return nil, apperrors.ErrAPIKeyNotFound
+	}
+
+	return key.Clone(), nil
+}
+
+func (r *PostRepository) ListAPIKeys(ctx context.Context) ([]*domain.APIKey, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make([]*domain.APIKey, 0, len(r.apiKeys))
+	for _, key := range r.apiKeys {
+		result = append(result, key.Clone())
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if !result[i].CreatedAt.Equal(result[j].CreatedAt) {
+			return result[i].CreatedAt.Before(result[j].CreatedAt)
+		}
+		return result[i].ID < result[j].ID
+	})
+
+	return result, nil
+}
+
+func (r *PostRepository) RevokeAPIKey(ctx context.Context, id string, at time.Time) error {
+	return r.updateAPIKey(ctx, id, func(key *domain.APIKey) bool {
+		if key.Revoked() {
+			return false
+		}
+		key.RevokedAt = at
+		return true
+	})
+}
+
+func (r *PostRepository) TouchAPIKey(ctx context.Context, id string, at time.Time) error {
+	return r.updateAPIKey(ctx, id, func(key *domain.APIKey) bool {
+		key.LastUsedAt = at
+		return true
+	})
+}
+
+// updateAPIKey logs the key as changed by apply, unless apply reports that
+// nothing changed.
+func (r *PostRepository) updateAPIKey(ctx context.Context, id string, apply func(*domain.APIKey) bool) error {
+	if id == "" {
+		return apperrors.ErrInvalidInput
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	current, ok := r.apiKeys[id]
+	if !ok {
+		return apperrors.ErrAPIKeyNotFound
+	}
+
+	stored := current.Clone()
+	if !apply(stored) {
+		return nil
+	}
+
+	return r.commit(record{Op: opPutAPIKey, ID: id, APIKey: stored})
+}
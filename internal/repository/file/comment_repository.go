@@ -0,0 +1,176 @@
+package file
+
+import (
+	"context"
+	"sort"
+
+	"github.com/BhaveetKumar/gRPC-server-go/internal/domain"
+	apperrors "github.com/BhaveetKumar/gRPC-server-go/internal/errors"
+)
+
+func (r *PostRepository) CreateComment(ctx context.Context, comment *domain.Comment) error {
+	if comment == nil || comment.ID == "" || comment.PostID == "" {
+		return apperrors.ErrInvalidInput
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.comments[comment.ID]; exists {
+		return apperrors.ErrDuplicateComment
+	}
+
+	stored := comment.Clone()
+	stored.Version = 1
+	if err := r.commit(record{Op: opPutComment, ID: stored.ID, Comment: stored}); err != nil {
+		return err
+	}
+
+	comment.Version = stored.Version
+	return nil
+}
+
+func (r *PostRepository) GetComment(ctx context.Context, id string) (*domain.Comment, error) {
+	if id == "" {
+		return nil, apperrors.ErrInvalidInput
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	comment, ok := r.comments[id]
+	if !ok {
+		return nil, apperrors.ErrCommentNotFound
+	}
+
+	return comment.Clone(), nil
+}
+
+func (r *PostRepository) UpdateComment(ctx context.Context, comment *domain.Comment, expectedVersion int64) error {
+	if comment == nil || comment.ID == "" {
+		return apperrors.ErrInvalidInput
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	current, ok := r.comments[comment.ID]
+	if !ok {
+		return apperrors.ErrCommentNotFound
+	}
+	if current.Version != expectedVersion {
+		return apperrors.ErrVersionConflict
+	}
+
+	// A comment cannot move to another post or thread.
+	stored := comment.Clone()
+	stored.PostID, stored.ParentID = current.PostID, current.ParentID
+	stored.Version = expectedVersion + 1
+	if err := r.commit(record{Op: opPutComment, ID: stored.ID, Comment: stored}); err != nil {
+		return err
+	}
+
+	comment.PostID, comment.ParentID = stored.PostID, stored.ParentID
+	comment.Version = stored.Version
+	return nil
+}
+
+func (r *PostRepository) DeleteComment(ctx context.Context, id string) error {
+	if id == "" {
+		return apperrors.ErrInvalidInput
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.comments[id]; !ok {
+		return apperrors.ErrCommentNotFound
+	}
+
+	return r.commit(record{Op: opDeleteComment, ID: id})
+}
+
+func (r *PostRepository) ListComments(ctx context.Context, postID string) ([]*domain.Comment, error) {
+	if postID == "" {
+		return nil, apperrors.ErrInvalidInput
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make([]*domain.Comment, 0, len(r.commentsByPost[postID]))
+	for id := range r.commentsByPost[postID] {
+		result = append(result, r.comments[id].Clone())
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if !result[i].CreatedAt.Equal(result[j].CreatedAt) {
+			return result[i].CreatedAt.Before(result[j].CreatedAt)
+		}
+		return result[i].ID < result[j].ID
+	})
+
+	return result, nil
+}
+
+func (r *PostRepository) DeleteComments(ctx context.Context, postID string) error {
+	if postID == "" {
+		return apperrors.ErrInvalidInput
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if len(r.commentsByPost[postID]) == 0 {
+		return nil
+	}
+
+	return r.commit(record{Op: opDeleteComments, ID: postID})
+}
+
+func (r *PostRepository) putComment(comment *domain.Comment) {
+	ids, ok := r.commentsByPost[comment.PostID]
+	if !ok {
+		ids = make(map[string]struct{})
+		r.commentsByPost[comment.PostID] = ids
+	}
+	r.comments[comment.ID] = comment
+	ids[comment.ID] = struct{}{}
+}
+
+func (r *PostRepository) removeComment(id string) {
+	comment, ok := r.comments[id]
+	if !ok {
+		return
+	}
+
+	delete(r.comments, id)
+	ids := r.commentsByPost[comment.PostID]
+	delete(ids, id)
+	if len(ids) == 0 {
+		delete(r.commentsByPost, comment.PostID)
+	}
+}
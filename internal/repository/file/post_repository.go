@@ -0,0 +1,834 @@
+package file
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"github.com/BhaveetKumar/gRPC-server-go/internal/domain"
+	apperrors "github.com/BhaveetKumar/gRPC-server-go/internal/errors"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/repository"
+)
+
+const (
+	walFileName      = "posts.wal"
+	snapshotFileName = "posts.snapshot"
+
+	DefaultSnapshotEvery = 1000
+)
+
+type snapshot struct {
+	Posts     []*domain.Post     `json:"posts"`
+	Revisions []*domain.Revision `json:"revisions,omitempty"`
+	Authors   []*domain.Author   `json:"authors,omitempty"`
+	// Slugs maps every claimed slug to its post.
+	Slugs    map[string]string `json:"slugs,omitempty"`
+	APIKeys  []*domain.APIKey  `json:"api_keys,omitempty"`
+	Comments []*domain.Comment `json:"comments,omitempty"`
+}
+
+// PostRepository keeps every post in memory and makes writes durable by
+// appending them to a write-ahead log before they become visible. Every
+// snapshotEvery records the full state is written to a snapshot and the log
+// starts over. It also implements repository.RevisionRepository,
+// repository.AuthorRepository, repository.SlugRepository,
+// repository.APIKeyRepository and repository.CommentRepository, keeping
+// revisions, authors, slugs, API keys and comments in the same log so posts
+// recover together with everything around them.
+type PostRepository struct {
+	mu            sync.RWMutex
+	posts         map[string]*domain.Post
+	revisions     map[string]map[int64]*domain.Revision
+	authors       map[string]*domain.Author
+	authorsByName map[string]string
+	slugs         map[string]string
+	apiKeys       map[string]*domain.APIKey
+	comments      map[string]*domain.Comment
+	// commentsByPost indexes comment IDs by the post they belong to.
+	commentsByPost map[string]map[string]struct{}
+	dir            string
+	wal            *os.File
+	walSize        int64
+	walRecords     int
+	snapshotEvery  int
+}
+
+var (
+	_ repository.PostRepository     = (*PostRepository)(nil)
+	_ repository.RevisionRepository = (*PostRepository)(nil)
+	_ repository.AuthorRepository   = (*PostRepository)(nil)
+	_ repository.SlugRepository     = (*PostRepository)(nil)
+	_ repository.APIKeyRepository   = (*PostRepository)(nil)
+	_ repository.CommentRepository  = (*PostRepository)(nil)
+)
+
+func NewPostRepository(dir string, snapshotEvery int) (*PostRepository, error) {
+	if dir == "" {
+		return nil, apperrors.ErrInvalidInput
+	}
+	if snapshotEvery <= 0 {
+		snapshotEvery = DefaultSnapshotEvery
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("create data dir: %w", err)
+	}
+
+	r := &PostRepository{
+		posts:          make(map[string]*domain.Post),
+		revisions:      make(map[string]map[int64]*domain.Revision),
+		authors:        make(map[string]*domain.Author),
+		authorsByName:  make(map[string]string),
+		slugs:          make(map[string]string),
+		apiKeys:        make(map[string]*domain.APIKey),
+		comments:       make(map[string]*domain.Comment),
+		commentsByPost: make(map[string]map[string]struct{}),
+		dir:            dir,
+		snapshotEvery:  snapshotEvery,
+	}
+
+	if err := r.recover(); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func (r *PostRepository) recover() error {
+	data, err := os.ReadFile(filepath.Join(r.dir, snapshotFileName))
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+	case err != nil:
+		return fmt.Errorf("read snapshot: %w", err)
+	default:
+		var snap snapshot
+		if err := json.Unmarshal(data, &snap); err != nil {
+			return fmt.Errorf("decode snapshot: %w", err)
+		}
+		for _, post := range snap.Posts {
+			r.posts[post.ID] = post
+		}
+		for _, rev := range snap.Revisions {
+			r.putRevision(rev)
+		}
+		for _, author := range snap.Authors {
+			r.putAuthor(author)
+		}
+		for slug, postID := range snap.Slugs {
+			r.slugs[slug] = postID
+		}
+		for _, key := range snap.APIKeys {
+			r.apiKeys[key.ID] = key
+		}
+		for _, comment := range snap.Comments {
+			r.putComment(comment)
+		}
+	}
+
+	walPath := filepath.Join(r.dir, walFileName)
+	validSize, count, err := replayWAL(walPath, r.apply)
+	if err != nil {
+		return err
+	}
+
+	wal, err := os.OpenFile(walPath, os.O_CREATE|os.O_RDWR, 0o644)
+	if err != nil {
+		return fmt.Errorf("open wal: %w", err)
+	}
+	if err := syncDir(r.dir); err != nil {
+		wal.Close()
+		return err
+	}
+
+	// Drop whatever partial record a crash left after the last good one so
+	// new appends start on a record boundary. replayWAL has already failed
+	// if anything intact came after it.
+	if err := wal.Truncate(validSize); err != nil {
+		wal.Close()
+		return fmt.Errorf("truncate wal: %w", err)
+	}
+	if _, err := wal.Seek(validSize, 0); err != nil {
+		wal.Close()
+		return fmt.Errorf("seek wal: %w", err)
+	}
+
+	// Posts written before statuses existed were visible to everyone.
+	for _, post := range r.posts {
+		if post.Status == "" {
+			post.Status = domain.StatusPublished
+		}
+	}
+
+	r.wal = wal
+	r.walSize = validSize
+	r.walRecords = count
+	return nil
+}
+
+func (r *PostRepository) apply(rec record) {
+	switch rec.Op {
+	case opPut:
+		if rec.Post != nil {
+			r.posts[rec.Post.ID] = rec.Post
+		}
+	case opPutAll:
+		for _, post := range rec.Posts {
+			r.posts[post.ID] = post
+		}
+	case opDelete:
+		delete(r.posts, rec.ID)
+	case opAddRevision:
+		if rec.Revision != nil && rec.Revision.Post != nil {
+			r.putRevision(rec.Revision)
+		}
+	case opDeleteRevisions:
+		byVersion := r.revisions[rec.ID]
+		for _, version := range rec.Versions {
+			delete(byVersion, version)
+		}
+		if len(byVersion) == 0 {
+			delete(r.revisions, rec.ID)
+		}
+	case opPutAuthor:
+		if rec.Author != nil {
+			r.putAuthor(rec.Author)
+		}
+	case opDeleteAuthor:
+		if author, ok := r.authors[rec.ID]; ok {
+			delete(r.authorsByName, domain.NormalizeAuthorName(author.Name))
+			delete(r.authors, rec.ID)
+		}
+	case opClaimSlug:
+		r.slugs[rec.Slug] = rec.ID
+	case opDeleteSlugs:
+		for slug, postID := range r.slugs {
+			if postID == rec.ID {
+				delete(r.slugs, slug)
+			}
+		}
+	case opPutAPIKey:
+		if rec.APIKey != nil {
+			r.apiKeys[rec.APIKey.ID] = rec.APIKey
+		}
+	case opPutComment:
+		if rec.Comment != nil {
+			r.removeComment(rec.Comment.ID)
+			r.putComment(rec.Comment)
+		}
+	case opDeleteComment:
+		r.removeComment(rec.ID)
+	case opDeleteComments:
+		for id := range r.commentsByPost[rec.ID] {
+			r.removeComment(id)
+		}
+	}
+}
+
+func (r *PostRepository) putAuthor(author *domain.Author) {
+	if current, ok := r.authors[author.ID]; ok {
+		delete(r.authorsByName, domain.NormalizeAuthorName(current.Name))
+	}
+	r.authors[author.ID] = author
+	r.authorsByName[domain.NormalizeAuthorName(author.Name)] = author.ID
+}
+
+func (r *PostRepository) putRevision(rev *domain.Revision) {
+	byVersion, ok := r.revisions[rev.Post.ID]
+	if !ok {
+		byVersion = make(map[int64]*domain.Revision)
+		r.revisions[rev.Post.ID] = byVersion
+	}
+	byVersion[rev.Post.Version] = rev
+}
+
+// commit durably appends rec and then applies it. Callers must hold r.mu.
+func (r *PostRepository) commit(rec record) error {
+	if r.wal == nil {
+		return apperrors.ErrInternal
+	}
+
+	buf, err := encodeRecord(rec)
+	if err != nil {
+		return err
+	}
+	if _, err := r.wal.Write(buf); err != nil {
+		r.rewindWAL()
+		return fmt.Errorf("append wal: %w", err)
+	}
+	if err := r.wal.Sync(); err != nil {
+		r.rewindWAL()
+		return fmt.Errorf("sync wal: %w", err)
+	}
+
+	r.walSize += int64(len(buf))
+	r.apply(rec)
+	r.walRecords++
+
+	// The record is already durable, so a failed snapshot is not reported to
+	// the caller; the log keeps growing and the next write tries again.
+	if r.walRecords >= r.snapshotEvery {
+		_ = r.snapshotLocked()
+	}
+	return nil
+}
+
+// rewindWAL cuts a failed append back off the log so that later records are
+// not stranded behind a torn one.
+func (r *PostRepository) rewindWAL() {
+	_ = r.wal.Truncate(r.walSize)
+	_, _ = r.wal.Seek(r.walSize, 0)
+}
+
+// Snapshot writes the current state to disk and resets the log.
+func (r *PostRepository) Snapshot() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.snapshotLocked()
+}
+
+func (r *PostRepository) snapshotLocked() error {
+	snap := snapshot{Posts: make([]*domain.Post, 0, len(r.posts))}
+	for _, post := range r.posts {
+		snap.Posts = append(snap.Posts, post)
+	}
+	for _, byVersion := range r.revisions {
+		for _, rev := range byVersion {
+			snap.Revisions = append(snap.Revisions, rev)
+		}
+	}
+	for _, author := range r.authors {
+		snap.Authors = append(snap.Authors, author)
+	}
+	if len(r.slugs) > 0 {
+		snap.Slugs = r.slugs
+	}
+	for _, key := range r.apiKeys {
+		snap.APIKeys = append(snap.APIKeys, key)
+	}
+	for _, comment := range r.comments {
+		snap.Comments = append(snap.Comments, comment)
+	}
+
+	data, err := json.Marshal(snap)
+	if err != nil {
+		return fmt.Errorf("encode snapshot: %w", err)
+	}
+
+	// The snapshot is renamed into place, and the rename made durable,
+	// before the log is cleared. A crash in between only means the old log
+	// is replayed on top of the new snapshot, which is safe because records
+	// are idempotent.
+	tmpPath := filepath.Join(r.dir, snapshotFileName+".tmp")
+	if err := writeFileSync(tmpPath, data); err != nil {
+		return err
+	}
+	if err := os.Rename(tmpPath, filepath.Join(r.dir, snapshotFileName)); err != nil {
+		return fmt.Errorf("install snapshot: %w", err)
+	}
+	if err := syncDir(r.dir); err != nil {
+		return err
+	}
+
+	if err := r.wal.Truncate(0); err != nil {
+		return fmt.Errorf("reset wal: %w", err)
+	}
+	if _, err := r.wal.Seek(0, 0); err != nil {
+		return fmt.Errorf("reset wal: %w", err)
+	}
+	if err := r.wal.Sync(); err != nil {
+		return fmt.Errorf("sync wal: %w", err)
+	}
+
+	r.walSize = 0
+	r.walRecords = 0
+	return nil
+}
+
+// syncDir flushes dir's entries, so that files created or renamed in it
+// survive a crash.
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return fmt.Errorf("sync data dir: %w", err)
+	}
+	if err := d.Sync(); err != nil {
+		d.Close()
+		return fmt.Errorf("sync data dir: %w", err)
+	}
+	return d.Close()
+}
+
+func writeFileSync(path string, data []byte) error {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o644)
+	if err != nil {
+		return fmt.Errorf("write snapshot: %w", err)
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return fmt.Errorf("write snapshot: %w", err)
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return fmt.Errorf("sync snapshot: %w", err)
+	}
+	return f.Close()
+}
+
+func (r *PostRepository) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.wal == nil {
+		return nil
+	}
+
+	err := r.wal.Close()
+	r.wal = nil
+	return err
+}
+
+func (r *PostRepository) Create(ctx context.Context, post *domain.Post) error {
+	if post == nil {
+		return apperrors.ErrInvalidInput
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.posts[post.ID]; exists {
+		return apperrors.ErrDuplicatePost
+	}
+
+	stored := post.Clone()
+	stored.Version = 1
+	if err := r.commit(record{Op: opPut, ID: stored.ID, Post: stored}); err != nil {
+		return err
+	}
+
+	post.Version = 1
+	return nil
+}
+
+func (r *PostRepository) GetByID(ctx context.Context, id string) (*domain.Post, error) {
+	if id == "" {
+		return nil, apperrors.ErrInvalidInput
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	post, ok := r.posts[id]
+	if !ok {
+		return nil, apperrors.ErrPostNotFound
+	}
+
+	return post.Clone(), nil
+}
+
+func (r *PostRepository) Update(ctx context.Context, post *domain.Post, expectedVersion int64) error {
+	if post == nil || post.ID == "" {
+		return apperrors.ErrInvalidInput
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	current, ok := r.posts[post.ID]
+	if !ok {
+		return apperrors.ErrPostNotFound
+	}
+	if current.Version != expectedVersion {
+		return apperrors.ErrVersionConflict
+	}
+
+	stored := post.Clone()
+	stored.Version = expectedVersion + 1
+	if err := r.commit(record{Op: opPut, ID: stored.ID, Post: stored}); err != nil {
+		return err
+	}
+
+	post.Version = stored.Version
+	return nil
+}
+
+func (r *PostRepository) UpdateAll(ctx context.Context, posts []*domain.Post) error {
+	if !repository.ValidBatch(posts) {
+		return apperrors.ErrInvalidInput
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored := make([]*domain.Post, len(posts))
+	for i, post := range posts {
+		current, ok := r.posts[post.ID]
+		if !ok {
+			return apperrors.ErrPostNotFound
+		}
+		if current.Version != post.Version {
+			return apperrors.ErrVersionConflict
+		}
+		stored[i] = post.Clone()
+		stored[i].Version++
+	}
+	if len(stored) == 0 {
+		return nil
+	}
+
+	if err := r.commit(record{Op: opPutAll, Posts: stored}); err != nil {
+		return err
+	}
+
+	for _, post := range posts {
+		post.Version++
+	}
+	return nil
+}
+
+func (r *PostRepository) Delete(ctx context.Context, id string, expectedVersion int64) error {
+	if id == "" {
+		return apperrors.ErrInvalidInput
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	current, ok := r.posts[id]
+	if !ok {
+		return apperrors.ErrPostNotFound
+	}
+	if expectedVersion != 0 && current.Version != expectedVersion {
+		return apperrors.ErrVersionConflict
+	}
+
+	return r.commit(record{Op: opDelete, ID: id})
+}
+
+func (r *PostRepository) List(ctx context.Context) ([]*domain.Post, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make([]*domain.Post, 0, len(r.posts))
+	for _, post := range r.posts {
+		result = append(result, post.Clone())
+	}
+
+	return result, nil
+}
+
+func (r *PostRepository) AddRevision(ctx context.Context, rev *domain.Revision) error {
+	if rev == nil || rev.Post == nil || rev.Post.ID == "" {
+		return apperrors.ErrInvalidInput
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.revisions[rev.Post.ID][rev.Post.Version]; exists {
+		return apperrors.ErrVersionConflict
+	}
+
+	return r.commit(record{Op: opAddRevision, ID: rev.Post.ID, Revision: rev.Clone()})
+}
+
+func (r *PostRepository) GetRevision(ctx context.Context, postID string, version int64) (*domain.Revision, error) {
+	if postID == "" {
+		return nil, apperrors.ErrInvalidInput
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	rev, ok := r.revisions[postID][version]
+	if !ok {
+		return nil, apperrors.ErrRevisionNotFound
+	}
+
+	return rev.Clone(), nil
+}
+
+func (r *PostRepository) ListRevisions(ctx context.Context, postID string) ([]*domain.Revision, error) {
+	if postID == "" {
+		return nil, apperrors.ErrInvalidInput
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make([]*domain.Revision, 0, len(r.revisions[postID]))
+	for _, rev := range r.revisions[postID] {
+		result = append(result, rev.Clone())
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Post.Version > result[j].Post.Version
+	})
+
+	return result, nil
+}
+
+func (r *PostRepository) DeleteRevisions(ctx context.Context, postID string, versions []int64) error {
+	if postID == "" {
+		return apperrors.ErrInvalidInput
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	present := make([]int64, 0, len(versions))
+	for _, version := range versions {
+		if _, ok := r.revisions[postID][version]; ok {
+			present = append(present, version)
+		}
+	}
+	if len(present) == 0 {
+		return nil
+	}
+
+	return r.commit(record{Op: opDeleteRevisions, ID: postID, Versions: present})
+}
+
+func (r *PostRepository) CreateAuthor(ctx context.Context, author *domain.Author) error {
+	if author == nil || author.ID == "" {
+		return apperrors.ErrInvalidInput
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.authors[author.ID]; exists {
+		return apperrors.ErrDuplicateAuthor
+	}
+	if _, taken := r.authorsByName[domain.NormalizeAuthorName(author.Name)]; taken {
+		return apperrors.ErrDuplicateAuthor
+	}
+
+	stored := author.Clone()
+	stored.Version = 1
+	if err := r.commit(record{Op: opPutAuthor, ID: stored.ID, Author: stored}); err != nil {
+		return err
+	}
+
+	author.Version = 1
+	return nil
+}
+
+func (r *PostRepository) GetAuthor(ctx context.Context, id string) (*domain.Author, error) {
+	if id == "" {
+		return nil, apperrors.ErrInvalidInput
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	author, ok := r.authors[id]
+	if !ok {
+		return nil, apperrors.ErrAuthorNotFound
+	}
+
+	return author.Clone(), nil
+}
+
+func (r *PostRepository) GetAuthorByName(ctx context.Context, name string) (*domain.Author, error) {
+	key := domain.NormalizeAuthorName(name)
+	if key == "" {
+		return nil, apperrors.ErrInvalidInput
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	id, ok := r.authorsByName[key]
+	if !ok {
+		return nil, apperrors.ErrAuthorNotFound
+	}
+
+	return r.authors[id].Clone(), nil
+}
+
+func (r *PostRepository) UpdateAuthor(ctx context.Context, author *domain.Author, expectedVersion int64) error {
+	if author == nil || author.ID == "" {
+		return apperrors.ErrInvalidInput
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	current, ok := r.authors[author.ID]
+	if !ok {
+		return apperrors.ErrAuthorNotFound
+	}
+	if current.Version != expectedVersion {
+		return apperrors.ErrVersionConflict
+	}
+	if id, taken := r.authorsByName[domain.NormalizeAuthorName(author.Name)]; taken && id != author.ID {
+		return apperrors.ErrDuplicateAuthor
+	}
+
+	stored := author.Clone()
+	stored.Version = expectedVersion + 1
+	if err := r.commit(record{Op: opPutAuthor, ID: stored.ID, Author: stored}); err != nil {
+		return err
+	}
+
+	author.Version = stored.Version
+	return nil
+}
+
+func (r *PostRepository) DeleteAuthor(ctx context.Context, id string, expectedVersion int64) error {
+	if id == "" {
+		return apperrors.ErrInvalidInput
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	current, ok := r.authors[id]
+	if !ok {
+		return apperrors.ErrAuthorNotFound
+	}
+	if expectedVersion != 0 && current.Version != expectedVersion {
+		return apperrors.ErrVersionConflict
+	}
+
+	return r.commit(record{Op: opDeleteAuthor, ID: id})
+}
+
+func (r *PostRepository) ListAuthors(ctx context.Context) ([]*domain.Author, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make([]*domain.Author, 0, len(r.authors))
+	for _, author := range r.authors {
+		result = append(result, author.Clone())
+	}
+
+	return result, nil
+}
+
+func (r *PostRepository) ClaimSlug(ctx context.Context, slug, postID string) error {
+	if slug == "" || postID == "" {
+		return apperrors.ErrInvalidInput
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	holder, taken := r.slugs[slug]
+	if taken && holder != postID {
+		return apperrors.ErrDuplicateSlug
+	}
+	if taken {
+		return nil
+	}
+
+	return r.commit(record{Op: opClaimSlug, ID: postID, Slug: slug})
+}
+
+func (r *PostRepository) ResolveSlug(ctx context.Context, slug string) (string, error) {
+	if slug == "" {
+		return "", apperrors.ErrInvalidInput
+	}
+
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	postID, ok := r.slugs[slug]
+	if !ok {
+		return "", apperrors.ErrPostNotFound
+	}
+
+	return postID, nil
+}
+
+func (r *PostRepository) DeleteSlugs(ctx context.Context, postID string) error {
+	if postID == "" {
+		return apperrors.ErrInvalidInput
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.commit(record{Op: opDeleteSlugs, ID: postID})
+}
@@ -0,0 +1,416 @@
+package file
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/BhaveetKumar/gRPC-server-go/internal/domain"
+	apperrors "github.com/BhaveetKumar/gRPC-server-go/internal/errors"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/repository"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/repository/repositorytest"
+)
+
+func openRepo(t *testing.T, dir string, snapshotEvery int) *PostRepository {
+	t.Helper()
+
+	repo, err := NewPostRepository(dir, snapshotEvery)
+	if err != nil {
+		t.Fatalf("open repository: %v", err)
+	}
+	return repo
+}
+
+func TestPostRepository_Conformance(t *testing.T) {
+	repositorytest.Run(t, func(t *testing.T) repository.PostRepository {
+		repo := openRepo(t, t.TempDir(), 10)
+		t.Cleanup(func() { repo.Close() })
+		return repo
+	})
+}
+
+func TestRevisionRepository_Conformance(t *testing.T) {
+	repositorytest.RunRevisions(t, func(t *testing.T) repository.RevisionRepository {
+		repo := openRepo(t, t.TempDir(), 10)
+		t.Cleanup(func() { repo.Close() })
+		return repo
+	})
+}
+
+func TestCommentRepository_Conformance(t *testing.T) {
+	repositorytest.RunComments(t, func(t *testing.T) repository.CommentRepository {
+		repo := openRepo(t, t.TempDir(), 10)
+		t.Cleanup(func() { repo.Close() })
+		return repo
+	})
+}
+
+func TestAuthorRepository_Conformance(t *testing.T) {
+	repositorytest.RunAuthors(t, func(t *testing.T) repository.AuthorRepository {
+		repo := openRepo(t, t.TempDir(), 10)
+		t.Cleanup(func() { repo.Close() })
+		return repo
+	})
+}
+
+func TestSlugRepository_Conformance(t *testing.T) {
+	repositorytest.RunSlugs(t, func(t *testing.T) repository.SlugRepository {
+		repo := openRepo(t, t.TempDir(), 10)
+		t.Cleanup(func() { repo.Close() })
+		return repo
+	})
+}
+
+func TestAPIKeyRepository_Conformance(t *testing.T) {
+	repositorytest.RunAPIKeys(t, func(t *testing.T) repository.APIKeyRepository {
+		repo := openRepo(t, t.TempDir(), 10)
+		t.Cleanup(func() { repo.Close() })
+		return repo
+	})
+}
+
+func TestPostRepository_EmptyDir(t *testing.T) {
+	if _, err := NewPostRepository("", 0); err != apperrors.ErrInvalidInput {
+		t.Fatalf("expected invalid input for empty dir, got %v", err)
+	}
+}
+
+func TestPostRepository_RecoversFromWAL(t *testing.T) {
+	dir := t.TempDir()
+	ctx := context.Background()
+	repo := openRepo(t, dir, 100)
+
+	_ = repo.Create(ctx, &domain.Post{ID: "id1", Title: "title1", Content: "content", Author: "author", Tags: []string{"go"}})
+	_ = repo.Create(ctx, &domain.Post{ID: "id2", Title: "title2", Content: "content", Author: "author"})
+	_ = repo.Update(ctx, &domain.Post{ID: "id1", Title: "updated", Content: "content", Author: "author"}, 1)
+	_ = repo.Delete(ctx, "id2", 0)
+	_ = repo.Close()
+
+	reopened := openRepo(t, dir, 100)
+	defer reopened.Close()
+
+	loaded, err := reopened.GetByID(ctx, "id1")
+	if err != nil {
+		t.Fatalf("get after reopen failed: %v", err)
+	}
+	if loaded.Title != "updated" || loaded.Version != 2 {
+		t.Fatalf("unexpected recovered post: %+v", loaded)
+	}
+	// Records written without a status predate statuses and were public.
+	if loaded.Status != domain.StatusPublished {
+		t.Fatalf("expected legacy post to recover as published, got %q", loaded.Status)
+	}
+	if _, err := reopened.GetByID(ctx, "id2"); err != apperrors.ErrPostNotFound {
+		t.Fatalf("expected deleted post to stay deleted, got %v", err)
+	}
+}
+
+func TestPostRepository_RecoversFromSnapshot(t *testing.T) {
+	dir := t.TempDir()
+	ctx := context.Background()
+	repo := openRepo(t, dir, 2)
+
+	_ = repo.Create(ctx, &domain.Post{ID: "id1", Title: "title1", Content: "content", Author: "author"})
+	_ = repo.Create(ctx, &domain.Post{ID: "id2", Title: "title2", Content: "content", Author: "author"})
+	_ = repo.Create(ctx, &domain.Post{ID: "id3", Title: "title3", Content: "content", Author: "author"})
+	_ = repo.Close()
+
+	if _, err := os.Stat(filepath.Join(dir, snapshotFileName)); err != nil {
+		t.Fatalf("expected snapshot to be written: %v", err)
+	}
+
+	reopened := openRepo(t, dir, 2)
+	defer reopened.Close()
+
+	posts, _ := reopened.List(ctx)
+	if len(posts) != 3 {
+		t.Fatalf("expected 3 posts after reopen, got %d", len(posts))
+	}
+}
+
+func TestPostRepository_RecoversRevisions(t *testing.T) {
+	for _, snapshotEvery := range []int{2, 100} {
+		dir := t.TempDir()
+		ctx := context.Background()
+		repo := openRepo(t, dir, snapshotEvery)
+
+		for v := int64(1); v <= 3; v++ {
+			rev := &domain.Revision{
+				Post:          &domain.Post{ID: "id1", Title: "title", Content: "content", Author: "author", Version: v},
+				Editor:        "editor",
+				ChangedFields: []string{"title"},
+			}
+			if err := repo.AddRevision(ctx, rev); err != nil {
+				t.Fatalf("add revision %d failed: %v", v, err)
+			}
+		}
+		if err := repo.DeleteRevisions(ctx, "id1", []int64{1}); err != nil {
+			t.Fatalf("delete revisions failed: %v", err)
+		}
+		_ = repo.Close()
+
+		reopened := openRepo(t, dir, snapshotEvery)
+		revs, err := reopened.ListRevisions(ctx, "id1")
+		if err != nil {
+			t.Fatalf("list after reopen failed: %v", err)
+		}
+		if len(revs) != 2 || revs[0].Post.Version != 3 || revs[1].Post.Version != 2 {
+			t.Fatalf("snapshotEvery=%d: unexpected recovered revisions: %+v", snapshotEvery, revs)
+		}
+		if revs[0].Editor != "editor" || len(revs[0].ChangedFields) != 1 {
+			t.Fatalf("snapshotEvery=%d: revision metadata lost: %+v", snapshotEvery, revs[0])
+		}
+		_ = reopened.Close()
+	}
+}
+
+func TestPostRepository_RecoversAuthors(t *testing.T) {
+	for _, snapshotEvery := range []int{2, 100} {
+		dir := t.TempDir()
+		ctx := context.Background()
+		repo := openRepo(t, dir, snapshotEvery)
+
+		_ = repo.CreateAuthor(ctx, &domain.Author{ID: "a1", Name: "John Doe"})
+		_ = repo.CreateAuthor(ctx, &domain.Author{ID: "a2", Name: "Jane Roe"})
+		_ = repo.UpdateAuthor(ctx, &domain.Author{ID: "a1", Name: "Johnny Doe", Bio: "bio"}, 1)
+		_ = repo.DeleteAuthor(ctx, "a2", 0)
+		_ = repo.Close()
+
+		reopened := openRepo(t, dir, snapshotEvery)
+		author, err := reopened.GetAuthorByName(ctx, "johnny doe")
+		if err != nil {
+			t.Fatalf("snapshotEvery=%d: get after reopen failed: %v", snapshotEvery, err)
+		}
+		if author.ID != "a1" || author.Bio != "bio" || author.Version != 2 {
+			t.Fatalf("snapshotEvery=%d: unexpected recovered author: %+v", snapshotEvery, author)
+		}
+		if _, err := reopened.GetAuthorByName(ctx, "John Doe"); err != apperrors.ErrAuthorNotFound {
+			t.Fatalf("snapshotEvery=%d: expected the old name to stay released, got %v", snapshotEvery, err)
+		}
+		if _, err := reopened.GetAuthor(ctx, "a2"); err != apperrors.ErrAuthorNotFound {
+			t.Fatalf("snapshotEvery=%d: expected deleted author to stay deleted, got %v", snapshotEvery, err)
+		}
+		_ = reopened.Close()
+	}
+}
+
+func TestPostRepository_RecoversSlugs(t *testing.T) {
+	for _, snapshotEvery := range []int{2, 100} {
+		dir := t.TempDir()
+		ctx := context.Background()
+		repo := openRepo(t, dir, snapshotEvery)
+
+		_ = repo.ClaimSlug(ctx, "first", "p1")
+		_ = repo.ClaimSlug(ctx, "second", "p1")
+		_ = repo.ClaimSlug(ctx, "other", "p2")
+		_ = repo.DeleteSlugs(ctx, "p2")
+		_ = repo.Close()
+
+		reopened := openRepo(t, dir, snapshotEvery)
+		for _, slug := range []string{"first", "second"} {
+			if postID, err := reopened.ResolveSlug(ctx, slug); err != nil || postID != "p1" {
+				t.Fatalf("snapshotEvery=%d: expected %s to resolve to p1, got %q, %v", snapshotEvery, slug, postID, err)
+			}
+		}
+		if _, err := reopened.ResolveSlug(ctx, "other"); err != apperrors.ErrPostNotFound {
+			t.Fatalf("snapshotEvery=%d: expected deleted slug to stay deleted, got %v", snapshotEvery, err)
+		}
+		_ = reopened.Close()
+	}
+}
+
+func TestPostRepository_RecoversAPIKeys(t *testing.T) {
+	for _, snapshotEvery := range []int{2, 100} {
+		dir := t.TempDir()
+		ctx := context.Background()
+		repo := openRepo(t, dir, snapshotEvery)
+
+		created := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+		for _, id := range []string{"k1", "k2"} {
+			_ = repo.CreateAPIKey(ctx, &domain.APIKey{
+				ID: id, Name: "export", Owner: "alice", Scopes: []string{domain.ScopePostsRead},
+				SecretHash: "hash-" + id, CreatedAt: created,
+			})
+		}
+		_ = repo.TouchAPIKey(ctx, "k1", created.Add(time.Minute))
+		_ = repo.RevokeAPIKey(ctx, "k2", created.Add(time.Hour))
+		_ = repo.Close()
+
+		reopened := openRepo(t, dir, snapshotEvery)
+		k1, err := reopened.GetAPIKey(ctx, "k1")
+		if err != nil || k1.SecretHash != "hash-k1" || !k1.LastUsedAt.Equal(created.Add(time.Minute)) {
+			t.Fatalf("snapshotEvery=%d: expected k1 with its last use, got %+v, %v", snapshotEvery, k1, err)
+		}
+		k2, err := reopened.GetAPIKey(ctx, "k2")
+		if err != nil || !k2.RevokedAt.Equal(created.Add(time.Hour)) {
+			t.Fatalf("snapshotEvery=%d: expected k2 to stay revoked, got %+v, %v", snapshotEvery, k2, err)
+		}
+		_ = reopened.Close()
+	}
+}
+
+func TestPostRepository_RecoversComments(t *testing.T) {
+	for _, snapshotEvery := range []int{2, 100} {
+		dir := t.TempDir()
+		ctx := context.Background()
+		repo := openRepo(t, dir, snapshotEvery)
+
+		_ = repo.CreateComment(ctx, &domain.Comment{ID: "c1", PostID: "p1", Author: "reader", Content: "first"})
+		_ = repo.CreateComment(ctx, &domain.Comment{ID: "c2", PostID: "p1", ParentID: "c1", Author: "reader", Content: "reply"})
+		_ = repo.CreateComment(ctx, &domain.Comment{ID: "c3", PostID: "p2", Author: "reader", Content: "other"})
+		_ = repo.UpdateComment(ctx, &domain.Comment{ID: "c1", Author: "reader", Content: "edited"}, 1)
+		_ = repo.DeleteComment(ctx, "c2")
+		_ = repo.DeleteComments(ctx, "p2")
+		_ = repo.Close()
+
+		reopened := openRepo(t, dir, snapshotEvery)
+		comments, err := reopened.ListComments(ctx, "p1")
+		if err != nil || len(comments) != 1 {
+			t.Fatalf("snapshotEvery=%d: expected one comment on p1, got %v, %v", snapshotEvery, comments, err)
+		}
+		if c := comments[0]; c.ID != "c1" || c.Content != "edited" || c.Version != 2 {
+			t.Fatalf("snapshotEvery=%d: unexpected recovered comment: %+v", snapshotEvery, c)
+		}
+		if _, err := reopened.GetComment(ctx, "c3"); err != apperrors.ErrCommentNotFound {
+			t.Fatalf("snapshotEvery=%d: expected p2's comments to stay deleted, got %v", snapshotEvery, err)
+		}
+		_ = reopened.Close()
+	}
+}
+
+func TestPostRepository_RecoversUpdateAll(t *testing.T) {
+	dir := t.TempDir()
+	ctx := context.Background()
+	repo := openRepo(t, dir, 100)
+
+	first := &domain.Post{ID: "id1", Title: "title1", Content: "content", Author: "author", Tags: []string{"go"}}
+	second := &domain.Post{ID: "id2", Title: "title2", Content: "content", Author: "author", Tags: []string{"go"}}
+	_ = repo.Create(ctx, first)
+	_ = repo.Create(ctx, second)
+	first.Tags, second.Tags = []string{"golang"}, []string{"golang"}
+	if err := repo.UpdateAll(ctx, []*domain.Post{first, second}); err != nil {
+		t.Fatalf("update all failed: %v", err)
+	}
+	_ = repo.Close()
+
+	reopened := openRepo(t, dir, 100)
+	defer reopened.Close()
+	for _, id := range []string{"id1", "id2"} {
+		post, err := reopened.GetByID(ctx, id)
+		if err != nil {
+			t.Fatalf("get %s after reopen failed: %v", id, err)
+		}
+		if post.Version != 2 || len(post.Tags) != 1 || post.Tags[0] != "golang" {
+			t.Fatalf("unexpected recovered post: %+v", post)
+		}
+	}
+}
+
+func TestPostRepository_TruncatedTail(t *testing.T) {
+	dir := t.TempDir()
+	ctx := context.Background()
+	repo := openRepo(t, dir, 100)
+
+	_ = repo.Create(ctx, &domain.Post{ID: "id1", Title: "title1", Content: "content", Author: "author"})
+	_ = repo.Create(ctx, &domain.Post{ID: "id2", Title: "title2", Content: "content", Author: "author"})
+	_ = repo.Close()
+
+	walPath := filepath.Join(dir, walFileName)
+	info, err := os.Stat(walPath)
+	if err != nil {
+		t.Fatalf("stat wal: %v", err)
+	}
+	if err := os.Truncate(walPath, info.Size()-3); err != nil {
+		t.Fatalf("truncate wal: %v", err)
+	}
+
+	reopened := openRepo(t, dir, 100)
+
+	if _, err := reopened.GetByID(ctx, "id1"); err != nil {
+		t.Fatalf("expected intact record to survive, got %v", err)
+	}
+	if _, err := reopened.GetByID(ctx, "id2"); err != apperrors.ErrPostNotFound {
+		t.Fatalf("expected torn record to be dropped, got %v", err)
+	}
+
+	if err := reopened.Create(ctx, &domain.Post{ID: "id3", Title: "title3", Content: "content", Author: "author"}); err != nil {
+		t.Fatalf("create after recovery failed: %v", err)
+	}
+	_ = reopened.Close()
+
+	again := openRepo(t, dir, 100)
+	defer again.Close()
+
+	if _, err := again.GetByID(ctx, "id3"); err != nil {
+		t.Fatalf("expected write after recovery to persist, got %v", err)
+	}
+}
+
+// flipWALByte corrupts the byte at offset in the WAL and returns its size.
+func flipWALByte(t *testing.T, dir string, offset int64) int64 {
+	t.Helper()
+
+	walPath := filepath.Join(dir, walFileName)
+	data, err := os.ReadFile(walPath)
+	if err != nil {
+		t.Fatalf("read wal: %v", err)
+	}
+	data[offset] ^= 0xff
+	if err := os.WriteFile(walPath, data, 0o644); err != nil {
+		t.Fatalf("write wal: %v", err)
+	}
+	return int64(len(data))
+}
+
+func TestPostRepository_CorruptTail(t *testing.T) {
+	dir := t.TempDir()
+	ctx := context.Background()
+	repo := openRepo(t, dir, 100)
+
+	_ = repo.Create(ctx, &domain.Post{ID: "id1", Title: "title1", Content: "content", Author: "author"})
+	_ = repo.Create(ctx, &domain.Post{ID: "id2", Title: "title2", Content: "content", Author: "author"})
+	_ = repo.Close()
+
+	info, err := os.Stat(filepath.Join(dir, walFileName))
+	if err != nil {
+		t.Fatalf("stat wal: %v", err)
+	}
+	flipWALByte(t, dir, info.Size()-2)
+
+	reopened := openRepo(t, dir, 100)
+	defer reopened.Close()
+
+	if _, err := reopened.GetByID(ctx, "id1"); err != nil {
+		t.Fatalf("expected intact record to survive, got %v", err)
+	}
+	if _, err := reopened.GetByID(ctx, "id2"); err != apperrors.ErrPostNotFound {
+		t.Fatalf("expected the damaged last record to be dropped, got %v", err)
+	}
+}
+
+func TestPostRepository_CorruptMiddleKeepsLog(t *testing.T) {
+	dir := t.TempDir()
+	ctx := context.Background()
+	repo := openRepo(t, dir, 100)
+
+	for _, id := range []string{"id1", "id2", "id3"} {
+		_ = repo.Create(ctx, &domain.Post{ID: id, Title: id, Content: "content", Author: "author"})
+	}
+	_ = repo.Close()
+
+	size := flipWALByte(t, dir, 20)
+
+	if _, err := NewPostRepository(dir, 100); !errors.Is(err, ErrCorruptWAL) {
+		t.Fatalf("expected a corrupt record before intact ones to fail recovery, got %v", err)
+	}
+	info, err := os.Stat(filepath.Join(dir, walFileName))
+	if err != nil {
+		t.Fatalf("stat wal: %v", err)
+	}
+	if info.Size() != size {
+		t.Fatalf("expected the wal to be left alone at %d bytes, got %d", size, info.Size())
+	}
+}
@@ -0,0 +1,154 @@
+package file
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+
+	"github.com/BhaveetKumar/gRPC-server-go/internal/domain"
+)
+
+const (
+	opPut             = "put"
+	opPutAll          = "put_all"
+	opDelete          = "delete"
+	opAddRevision     = "add_revision"
+	opDeleteRevisions = "delete_revisions"
+	opPutAuthor       = "put_author"
+	opDeleteAuthor    = "delete_author"
+	opClaimSlug       = "claim_slug"
+	opDeleteSlugs     = "delete_slugs"
+	opPutAPIKey       = "put_api_key"
+	opPutComment      = "put_comment"
+	opDeleteComment   = "delete_comment"
+	opDeleteComments  = "delete_comments"
+
+	recordHeaderSize = 8
+	maxRecordSize    = 16 << 20
+)
+
+type record struct {
+	Op   string       `json:"op"`
+	ID   string       `json:"id"`
+	Post *domain.Post `json:"post,omitempty"`
+	// Posts holds the posts of an opPutAll record, which replays as one
+	// write since the whole record is covered by a single checksum.
+	Posts []*domain.Post `json:"posts,omitempty"`
+
+	Revision *domain.Revision `json:"revision,omitempty"`
+	Versions []int64          `json:"versions,omitempty"`
+
+	Author *domain.Author `json:"author,omitempty"`
+
+	Slug string `json:"slug,omitempty"`
+
+	APIKey *domain.APIKey `json:"api_key,omitempty"`
+
+	Comment *domain.Comment `json:"comment,omitempty"`
+}
+
+var (
+	errBadRecord = errors.New("bad wal record")
+	// errTornRecord is a record cut short by the end of the log.
+	errTornRecord = errors.New("torn wal record")
+	// ErrCorruptWAL is returned when a damaged record is followed by more
+	// of the log, which a crash mid-append cannot leave behind. Replay stops
+	// rather than drop the records after it.
+	ErrCorruptWAL = errors.New("corrupt wal")
+)
+
+// Each WAL record is framed as a 4 byte big-endian payload length, a 4 byte
+// CRC32 of the payload and the JSON payload itself. Records always carry the
+// full post, revision, author, slug, API key or comment state, so replaying a
+// record twice is harmless.
+func encodeRecord(rec record) ([]byte, error) {
+	payload, err := json.Marshal(rec)
+	if err != nil {
+		return nil, fmt.Errorf("encode wal record: %w", err)
+	}
+
+	buf := make([]byte, recordHeaderSize+len(payload))
+	binary.BigEndian.PutUint32(buf[0:4], uint32(len(payload)))
+	binary.BigEndian.PutUint32(buf[4:8], crc32.ChecksumIEEE(payload))
+	copy(buf[recordHeaderSize:], payload)
+
+	return buf, nil
+}
+
+// readRecord reads the next record and returns it with its size in the log.
+// A damaged record is reported as errBadRecord together with the size its
+// header claims, so the caller can tell whether anything follows it.
+func readRecord(r *bufio.Reader) (record, int64, error) {
+	var header [recordHeaderSize]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		if err == io.EOF {
+			return record{}, 0, io.EOF
+		}
+		return record{}, 0, errTornRecord
+	}
+
+	size := binary.BigEndian.Uint32(header[0:4])
+	n := int64(recordHeaderSize) + int64(size)
+	if size > maxRecordSize {
+		return record{}, n, errBadRecord
+	}
+
+	payload := make([]byte, size)
+	if _, err := io.ReadFull(r, payload); err != nil {
+		return record{}, n, errTornRecord
+	}
+	if crc32.ChecksumIEEE(payload) != binary.BigEndian.Uint32(header[4:8]) {
+		return record{}, n, errBadRecord
+	}
+
+	var rec record
+	if err := json.Unmarshal(payload, &rec); err != nil {
+		return record{}, n, errBadRecord
+	}
+
+	return rec, n, nil
+}
+
+// replayWAL applies every intact record in path and returns the offset just
+// past the last good one. A torn or corrupt last record, as left behind by a
+// crash mid-append, ends replay instead of failing it; a damaged record with
+// more of the log after it fails with ErrCorruptWAL.
+func replayWAL(path string, apply func(record)) (int64, int, error) {
+	f, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, 0, nil
+	}
+	if err != nil {
+		return 0, 0, fmt.Errorf("open wal: %w", err)
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return 0, 0, fmt.Errorf("stat wal: %w", err)
+	}
+
+	reader := bufio.NewReader(f)
+	var offset int64
+	count := 0
+	for {
+		rec, n, err := readRecord(reader)
+		switch {
+		case err == io.EOF, errors.Is(err, errTornRecord):
+			return offset, count, nil
+		case err != nil && offset+n >= info.Size():
+			return offset, count, nil
+		case err != nil:
+			return 0, 0, fmt.Errorf("%w: bad record at offset %d of %d bytes; %s needs repair", ErrCorruptWAL, offset, info.Size(), path)
+		}
+
+		apply(rec)
+		offset += n
+		count++
+	}
+}
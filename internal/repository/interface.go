@@ -1,11 +1,103 @@
 package repository
 
-import "github.com/BhaveetKumar/gRPC-server-go/internal/domain"
+import (
+	"context"
+	"time"
 
+	"github.com/BhaveetKumar/gRPC-server-go/internal/domain"
+)
+
+// PostRepository implementations own the post version counter: Create stores
+// a post at version 1 and Update only succeeds when the stored version equals
+// expectedVersion, bumping it by one. Both write the new version back to post.
+// Delete with an expectedVersion of 0 removes the post unconditionally.
+// UpdateAll updates several posts in one atomic write, each expected at the
+// Version it carries: if any of them is missing or out of date nothing is
+// written and the error is that of the first such post.
+// Every method returns ctx.Err() once ctx is done instead of doing the work.
 type PostRepository interface {
-	Create(post *domain.Post) error
-	GetByID(id string) (*domain.Post, error)
-	Update(post *domain.Post) error
-	Delete(id string) error
-	List() ([]*domain.Post, error)
+	Create(ctx context.Context, post *domain.Post) error
+	GetByID(ctx context.Context, id string) (*domain.Post, error)
+	Update(ctx context.Context, post *domain.Post, expectedVersion int64) error
+	UpdateAll(ctx context.Context, posts []*domain.Post) error
+	Delete(ctx context.Context, id string, expectedVersion int64) error
+	List(ctx context.Context) ([]*domain.Post, error)
+}
+
+// RevisionRepository stores immutable post revisions keyed by post ID and the
+// post version each one captured. AddRevision fails with ErrVersionConflict
+// if that version is already recorded, ListRevisions returns the newest
+// first, and DeleteRevisions ignores versions that do not exist.
+type RevisionRepository interface {
+	AddRevision(ctx context.Context, rev *domain.Revision) error
+	GetRevision(ctx context.Context, postID string, version int64) (*domain.Revision, error)
+	ListRevisions(ctx context.Context, postID string) ([]*domain.Revision, error)
+	DeleteRevisions(ctx context.Context, postID string, versions []int64) error
+}
+
+// CommentRepository stores post comments. Like PostRepository it owns the
+// version counter: CreateComment stores a comment at version 1 and
+// UpdateComment only succeeds when the stored version equals expectedVersion.
+// ListComments returns every comment on a post, oldest first, and
+// DeleteComments removes them all.
+type CommentRepository interface {
+	CreateComment(ctx context.Context, comment *domain.Comment) error
+	GetComment(ctx context.Context, id string) (*domain.Comment, error)
+	UpdateComment(ctx context.Context, comment *domain.Comment, expectedVersion int64) error
+	DeleteComment(ctx context.Context, id string) error
+	ListComments(ctx context.Context, postID string) ([]*domain.Comment, error)
+	DeleteComments(ctx context.Context, postID string) error
+}
+
+// AuthorRepository stores author profiles. Names are unique after
+// domain.NormalizeAuthorName: CreateAuthor and UpdateAuthor fail with
+// ErrDuplicateAuthor when another author has the same normalized name, and
+// GetAuthorByName finds an author by any spelling of its name. Versions work
+// as in PostRepository, including DeleteAuthor with an expectedVersion of 0.
+type AuthorRepository interface {
+	CreateAuthor(ctx context.Context, author *domain.Author) error
+	GetAuthor(ctx context.Context, id string) (*domain.Author, error)
+	GetAuthorByName(ctx context.Context, name string) (*domain.Author, error)
+	UpdateAuthor(ctx context.Context, author *domain.Author, expectedVersion int64) error
+	DeleteAuthor(ctx context.Context, id string, expectedVersion int64) error
+	ListAuthors(ctx context.Context) ([]*domain.Author, error)
+}
+
+// SlugRepository maps post slugs to post IDs. A claimed slug keeps pointing
+// at its post after the post moves on to a new slug, so old links go on
+// resolving; only DeleteSlugs, for a purged post, releases them. ClaimSlug
+// fails with ErrDuplicateSlug when another post holds slug and succeeds if
+// postID already does. ResolveSlug returns ErrPostNotFound for an unknown
+// slug.
+type SlugRepository interface {
+	ClaimSlug(ctx context.Context, slug, postID string) error
+	ResolveSlug(ctx context.Context, slug string) (string, error)
+	DeleteSlugs(ctx context.Context, postID string) error
+}
+
+// APIKeyRepository stores API keys, which hold only a hash of their secret.
+// CreateAPIKey fails with ErrDuplicateAPIKey when the ID is taken. Keys are
+// never deleted: RevokeAPIKey marks a key revoked at the given time, keeping
+// the first time if it already is, and TouchAPIKey records when a key was
+// last used. ListAPIKeys returns the keys oldest first. Unknown IDs give
+// ErrAPIKeyNotFound.
+type APIKeyRepository interface {
+	CreateAPIKey(ctx context.Context, key *domain.APIKey) error
+	GetAPIKey(ctx context.Context, id string) (*domain.APIKey, error)
+	ListAPIKeys(ctx context.Context) ([]*domain.APIKey, error)
+	RevokeAPIKey(ctx context.Context, id string, at time.Time) error
+	TouchAPIKey(ctx context.Context, id string, at time.Time) error
+}
+
+// ValidBatch reports whether posts can be passed to UpdateAll: every post has
+// an ID and no ID appears twice.
+func ValidBatch(posts []*domain.Post) bool {
+	seen := make(map[string]bool, len(posts))
+	for _, post := range posts {
+		if post == nil || post.ID == "" || seen[post.ID] {
+			return false
+		}
+		seen[post.ID] = true
+	}
+	return true
 }
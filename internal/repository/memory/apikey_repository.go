@@ -0,0 +1,122 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/BhaveetKumar/gRPC-server-go/internal/domain"
+	apperrors "github.com/BhaveetKumar/gRPC-server-go/internal/errors"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/repository"
+)
+
+type APIKeyRepository struct {
+	mu   sync.RWMutex
+	keys map[string]*domain.APIKey
+}
+
+var _ repository.APIKeyRepository = (*APIKeyRepository)(nil)
+
+func NewAPIKeyRepository() *APIKeyRepository {
+	return &APIKeyRepository{
+		keys: make(map[string]*domain.APIKey),
+	}
+}
+
+func (r *APIKeyRepository) CreateAPIKey(ctx context.Context, key *domain.APIKey) error {
+	if err := key.Validate(); err != nil {
+		return err
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.keys[key.ID]; exists {
+		return apperrors.ErrDuplicateAPIKey
+	}
+	r.keys[key.ID] = key.Clone()
+
+	return nil
+}
+
+func (r *APIKeyRepository) GetAPIKey(ctx context.Context, id string) (*domain.APIKey, error) {
+	if id == "" {
+		return nil, apperrors.ErrInvalidInput
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	key, ok := r.keys[id]
+	if !ok {
+		return nil, apperrors.ErrAPIKeyNotFound
+	}
+
+	return key.Clone(), nil
+}
+
+func (r *APIKeyRepository) ListAPIKeys(ctx context.Context) ([]*domain.APIKey, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make([]*domain.APIKey, 0, len(r.keys))
+	for _, key := range r.keys {
+		result = append(result, key.Clone())
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if !result[i].CreatedAt.Equal(result[j].CreatedAt) {
+			return result[i].CreatedAt.Before(result[j].CreatedAt)
+		}
+		return result[i].ID < result[j].ID
+	})
+
+	return result, nil
+}
+
+func (r *APIKeyRepository) RevokeAPIKey(ctx context.Context, id string, at time.Time) error {
+	return r.update(ctx, id, func(key *domain.APIKey) {
+		if key.RevokedAt.IsZero() {
+			key.RevokedAt = at
+		}
+	})
+}
+
+func (r *APIKeyRepository) TouchAPIKey(ctx context.Context, id string, at time.Time) error {
+	return r.update(ctx, id, func(key *domain.APIKey) {
+		key.LastUsedAt = at
+	})
+}
+
+func (r *APIKeyRepository) update(ctx context.Context, id string, apply func(*domain.APIKey)) error {
+	if id == "" {
+		return apperrors.ErrInvalidInput
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key, ok := r.keys[id]
+	if !ok {
+		return apperrors.ErrAPIKeyNotFound
+	}
+	apply(key)
+
+	return nil
+}
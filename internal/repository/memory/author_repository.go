@@ -0,0 +1,169 @@
+package memory
+
+import (
+	"context"
+	"sync"
+
+	"github.com/BhaveetKumar/gRPC-server-go/internal/domain"
+	apperrors "github.com/BhaveetKumar/gRPC-server-go/internal/errors"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/repository"
+)
+
+type AuthorRepository struct {
+	mu      sync.RWMutex
+	authors map[string]*domain.Author
+	// byName maps normalized author names to author IDs.
+	byName map[string]string
+}
+
+var _ repository.AuthorRepository = (*AuthorRepository)(nil)
+
+func NewAuthorRepository() *AuthorRepository {
+	return &AuthorRepository{
+		authors: make(map[string]*domain.Author),
+		byName:  make(map[string]string),
+	}
+}
+
+func (r *AuthorRepository) CreateAuthor(ctx context.Context, author *domain.Author) error {
+	if author == nil || author.ID == "" {
+		return apperrors.ErrInvalidInput
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := domain.NormalizeAuthorName(author.Name)
+	if _, exists := r.authors[author.ID]; exists {
+		return apperrors.ErrDuplicateAuthor
+	}
+	if _, taken := r.byName[key]; taken {
+		return apperrors.ErrDuplicateAuthor
+	}
+
+	author.Version = 1
+	r.authors[author.ID] = author.Clone()
+	r.byName[key] = author.ID
+
+	return nil
+}
+
+func (r *AuthorRepository) GetAuthor(ctx context.Context, id string) (*domain.Author, error) {
+	if id == "" {
+		return nil, apperrors.ErrInvalidInput
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	author, ok := r.authors[id]
+	if !ok {
+		return nil, apperrors.ErrAuthorNotFound
+	}
+
+	return author.Clone(), nil
+}
+
+func (r *AuthorRepository) GetAuthorByName(ctx context.Context, name string) (*domain.Author, error) {
+	key := domain.NormalizeAuthorName(name)
+	if key == "" {
+		return nil, apperrors.ErrInvalidInput
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	id, ok := r.byName[key]
+	if !ok {
+		return nil, apperrors.ErrAuthorNotFound
+	}
+
+	return r.authors[id].Clone(), nil
+}
+
+func (r *AuthorRepository) UpdateAuthor(ctx context.Context, author *domain.Author, expectedVersion int64) error {
+	if author == nil || author.ID == "" {
+		return apperrors.ErrInvalidInput
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	current, ok := r.authors[author.ID]
+	if !ok {
+		return apperrors.ErrAuthorNotFound
+	}
+	if current.Version != expectedVersion {
+		return apperrors.ErrVersionConflict
+	}
+
+	key := domain.NormalizeAuthorName(author.Name)
+	if id, taken := r.byName[key]; taken && id != author.ID {
+		return apperrors.ErrDuplicateAuthor
+	}
+
+	delete(r.byName, domain.NormalizeAuthorName(current.Name))
+	author.Version = expectedVersion + 1
+	r.authors[author.ID] = author.Clone()
+	r.byName[key] = author.ID
+
+	return nil
+}
+
+func (r *AuthorRepository) DeleteAuthor(ctx context.Context, id string, expectedVersion int64) error {
+	if id == "" {
+		return apperrors.ErrInvalidInput
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	current, ok := r.authors[id]
+	if !ok {
+		return apperrors.ErrAuthorNotFound
+	}
+	if expectedVersion != 0 && current.Version != expectedVersion {
+		return apperrors.ErrVersionConflict
+	}
+
+	delete(r.authors, id)
+	delete(r.byName, domain.NormalizeAuthorName(current.Name))
+
+	return nil
+}
+
+func (r *AuthorRepository) ListAuthors(ctx context.Context) ([]*domain.Author, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make([]*domain.Author, 0, len(r.authors))
+	for _, author := range r.authors {
+		result = append(result, author.Clone())
+	}
+
+	return result, nil
+}
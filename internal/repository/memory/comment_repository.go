@@ -0,0 +1,177 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/BhaveetKumar/gRPC-server-go/internal/domain"
+	apperrors "github.com/BhaveetKumar/gRPC-server-go/internal/errors"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/repository"
+)
+
+type CommentRepository struct {
+	mu       sync.RWMutex
+	comments map[string]*domain.Comment
+	// byPost indexes comment IDs by the post they belong to.
+	byPost map[string]map[string]struct{}
+}
+
+var _ repository.CommentRepository = (*CommentRepository)(nil)
+
+func NewCommentRepository() *CommentRepository {
+	return &CommentRepository{
+		comments: make(map[string]*domain.Comment),
+		byPost:   make(map[string]map[string]struct{}),
+	}
+}
+
+func (r *CommentRepository) CreateComment(ctx context.Context, comment *domain.Comment) error {
+	if comment == nil || comment.ID == "" || comment.PostID == "" {
+		return apperrors.ErrInvalidInput
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.comments[comment.ID]; exists {
+		return apperrors.ErrDuplicateComment
+	}
+
+	ids, ok := r.byPost[comment.PostID]
+	if !ok {
+		ids = make(map[string]struct{})
+		r.byPost[comment.PostID] = ids
+	}
+
+	comment.Version = 1
+	r.comments[comment.ID] = comment.Clone()
+	ids[comment.ID] = struct{}{}
+
+	return nil
+}
+
+func (r *CommentRepository) GetComment(ctx context.Context, id string) (*domain.Comment, error) {
+	if id == "" {
+		return nil, apperrors.ErrInvalidInput
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	comment, ok := r.comments[id]
+	if !ok {
+		return nil, apperrors.ErrCommentNotFound
+	}
+
+	return comment.Clone(), nil
+}
+
+func (r *CommentRepository) UpdateComment(ctx context.Context, comment *domain.Comment, expectedVersion int64) error {
+	if comment == nil || comment.ID == "" {
+		return apperrors.ErrInvalidInput
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	current, ok := r.comments[comment.ID]
+	if !ok {
+		return apperrors.ErrCommentNotFound
+	}
+	if current.Version != expectedVersion {
+		return apperrors.ErrVersionConflict
+	}
+
+	// A comment cannot move to another post or thread.
+	comment.PostID, comment.ParentID = current.PostID, current.ParentID
+	comment.Version = expectedVersion + 1
+	r.comments[comment.ID] = comment.Clone()
+
+	return nil
+}
+
+func (r *CommentRepository) DeleteComment(ctx context.Context, id string) error {
+	if id == "" {
+		return apperrors.ErrInvalidInput
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	comment, ok := r.comments[id]
+	if !ok {
+		return apperrors.ErrCommentNotFound
+	}
+
+	delete(r.comments, id)
+	ids := r.byPost[comment.PostID]
+	delete(ids, id)
+	if len(ids) == 0 {
+		delete(r.byPost, comment.PostID)
+	}
+
+	return nil
+}
+
+func (r *CommentRepository) ListComments(ctx context.Context, postID string) ([]*domain.Comment, error) {
+	if postID == "" {
+		return nil, apperrors.ErrInvalidInput
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make([]*domain.Comment, 0, len(r.byPost[postID]))
+	for id := range r.byPost[postID] {
+		result = append(result, r.comments[id].Clone())
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if !result[i].CreatedAt.Equal(result[j].CreatedAt) {
+			return result[i].CreatedAt.Before(result[j].CreatedAt)
+		}
+		return result[i].ID < result[j].ID
+	})
+
+	return result, nil
+}
+
+func (r *CommentRepository) DeleteComments(ctx context.Context, postID string) error {
+	if postID == "" {
+		return apperrors.ErrInvalidInput
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for id := range r.byPost[postID] {
+		delete(r.comments, id)
+	}
+	delete(r.byPost, postID)
+
+	return nil
+}
@@ -1,6 +1,7 @@
 package memory
 
 import (
+	"context"
 	"sync"
 
 	"github.com/BhaveetKumar/gRPC-server-go/internal/domain"
@@ -21,11 +22,15 @@ func NewPostRepository() *PostRepository {
 	}
 }
 
-func (r *PostRepository) Create(post *domain.Post) error {
+func (r *PostRepository) Create(ctx context.Context, post *domain.Post) error {
 	if post == nil {
 		return apperrors.ErrInvalidInput
 	}
 
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
@@ -33,17 +38,21 @@ func (r *PostRepository) Create(post *domain.Post) error {
 		return apperrors.ErrDuplicatePost
 	}
 
-	copy := *post
-	r.posts[post.ID] = &copy
+	post.Version = 1
+	r.posts[post.ID] = post.Clone()
 
 	return nil
 }
 
-func (r *PostRepository) GetByID(id string) (*domain.Post, error) {
+func (r *PostRepository) GetByID(ctx context.Context, id string) (*domain.Post, error) {
 	if id == "" {
 		return nil, apperrors.ErrInvalidInput
 	}
 
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
@@ -52,52 +61,100 @@ func (r *PostRepository) GetByID(id string) (*domain.Post, error) {
 		return nil, apperrors.ErrPostNotFound
 	}
 
-	copy := *post
-	return &copy, nil
+	return post.Clone(), nil
 }
 
-func (r *PostRepository) Update(post *domain.Post) error {
+func (r *PostRepository) Update(ctx context.Context, post *domain.Post, expectedVersion int64) error {
 	if post == nil || post.ID == "" {
 		return apperrors.ErrInvalidInput
 	}
 
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
-	if _, ok := r.posts[post.ID]; !ok {
+	current, ok := r.posts[post.ID]
+	if !ok {
 		return apperrors.ErrPostNotFound
 	}
+	if current.Version != expectedVersion {
+		return apperrors.ErrVersionConflict
+	}
 
-	copy := *post
-	r.posts[post.ID] = &copy
+	post.Version = expectedVersion + 1
+	r.posts[post.ID] = post.Clone()
 
 	return nil
 }
 
-func (r *PostRepository) Delete(id string) error {
+func (r *PostRepository) UpdateAll(ctx context.Context, posts []*domain.Post) error {
+	if !repository.ValidBatch(posts) {
+		return apperrors.ErrInvalidInput
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, post := range posts {
+		current, ok := r.posts[post.ID]
+		if !ok {
+			return apperrors.ErrPostNotFound
+		}
+		if current.Version != post.Version {
+			return apperrors.ErrVersionConflict
+		}
+	}
+
+	for _, post := range posts {
+		post.Version++
+		r.posts[post.ID] = post.Clone()
+	}
+
+	return nil
+}
+
+func (r *PostRepository) Delete(ctx context.Context, id string, expectedVersion int64) error {
 	if id == "" {
 		return apperrors.ErrInvalidInput
 	}
 
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
-	if _, ok := r.posts[id]; !ok {
+	current, ok := r.posts[id]
+	if !ok {
 		return apperrors.ErrPostNotFound
 	}
+	if expectedVersion != 0 && current.Version != expectedVersion {
+		return apperrors.ErrVersionConflict
+	}
 
 	delete(r.posts, id)
 	return nil
 }
 
-func (r *PostRepository) List() ([]*domain.Post, error) {
+func (r *PostRepository) List(ctx context.Context) ([]*domain.Post, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
 	result := make([]*domain.Post, 0, len(r.posts))
 	for _, post := range r.posts {
-		copy := *post
-		result = append(result, &copy)
+		result = append(result, post.Clone())
 	}
 
 	return result, nil
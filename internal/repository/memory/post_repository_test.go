@@ -1,24 +1,64 @@
 package memory
 
 import (
+	"context"
 	"fmt"
 	"sync"
 	"testing"
 
 	"github.com/BhaveetKumar/gRPC-server-go/internal/domain"
 	apperrors "github.com/BhaveetKumar/gRPC-server-go/internal/errors"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/repository"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/repository/repositorytest"
 )
 
+func TestPostRepository_Conformance(t *testing.T) {
+	repositorytest.Run(t, func(t *testing.T) repository.PostRepository {
+		return NewPostRepository()
+	})
+}
+
+func TestRevisionRepository_Conformance(t *testing.T) {
+	repositorytest.RunRevisions(t, func(t *testing.T) repository.RevisionRepository {
+		return NewRevisionRepository()
+	})
+}
+
+func TestCommentRepository_Conformance(t *testing.T) {
+	repositorytest.RunComments(t, func(t *testing.T) repository.CommentRepository {
+		return NewCommentRepository()
+	})
+}
+
+func TestAuthorRepository_Conformance(t *testing.T) {
+	repositorytest.RunAuthors(t, func(t *testing.T) repository.AuthorRepository {
+		return NewAuthorRepository()
+	})
+}
+
+func TestSlugRepository_Conformance(t *testing.T) {
+	repositorytest.RunSlugs(t, func(t *testing.T) repository.SlugRepository {
+		return NewSlugRepository()
+	})
+}
+
+func TestAPIKeyRepository_Conformance(t *testing.T) {
+	repositorytest.RunAPIKeys(t, func(t *testing.T) repository.APIKeyRepository {
+		return NewAPIKeyRepository()
+	})
+}
+
 func TestPostRepository_CreateAndGet(t *testing.T) {
 	repo := NewPostRepository()
+	ctx := context.Background()
 
 	post := &domain.Post{ID: "id1", Title: "title", Content: "content", Author: "author"}
 
-	if err := repo.Create(post); err != nil {
+	if err := repo.Create(ctx, post); err != nil {
 		t.Fatalf("create failed: %v", err)
 	}
 
-	loaded, err := repo.GetByID("id1")
+	loaded, err := repo.GetByID(ctx, "id1")
 	if err != nil {
 		t.Fatalf("get failed: %v", err)
 	}
@@ -36,26 +76,29 @@ func TestPostRepository_CreateAndGet(t *testing.T) {
 
 func TestPostRepository_CreateNilPost(t *testing.T) {
 	repo := NewPostRepository()
+	ctx := context.Background()
 
-	if err := repo.Create(nil); err != apperrors.ErrInvalidInput {
+	if err := repo.Create(ctx, nil); err != apperrors.ErrInvalidInput {
 		t.Fatalf("expected invalid input for nil post, got %v", err)
 	}
 }
 
 func TestPostRepository_Duplicate(t *testing.T) {
 	repo := NewPostRepository()
+	ctx := context.Background()
 	post := &domain.Post{ID: "id1", Title: "title", Content: "content", Author: "author"}
 
-	_ = repo.Create(post)
-	if err := repo.Create(post); err != apperrors.ErrDuplicatePost {
+	_ = repo.Create(ctx, post)
+	if err := repo.Create(ctx, post); err != apperrors.ErrDuplicatePost {
 		t.Fatalf("expected duplicate error, got %v", err)
 	}
 }
 
 func TestPostRepository_GetByIDEmptyString(t *testing.T) {
 	repo := NewPostRepository()
+	ctx := context.Background()
 
-	_, err := repo.GetByID("")
+	_, err := repo.GetByID(ctx, "")
 	if err != apperrors.ErrInvalidInput {
 		t.Fatalf("expected invalid input for empty id, got %v", err)
 	}
@@ -63,8 +106,9 @@ func TestPostRepository_GetByIDEmptyString(t *testing.T) {
 
 func TestPostRepository_GetByIDNotFound(t *testing.T) {
 	repo := NewPostRepository()
+	ctx := context.Background()
 
-	_, err := repo.GetByID("nonexistent")
+	_, err := repo.GetByID(ctx, "nonexistent")
 	if err != apperrors.ErrPostNotFound {
 		t.Fatalf("expected not found, got %v", err)
 	}
@@ -72,16 +116,17 @@ func TestPostRepository_GetByIDNotFound(t *testing.T) {
 
 func TestPostRepository_Update(t *testing.T) {
 	repo := NewPostRepository()
+	ctx := context.Background()
 
 	original := &domain.Post{ID: "id1", Title: "original", Content: "original content", Author: "author1"}
-	_ = repo.Create(original)
+	_ = repo.Create(ctx, original)
 
 	updated := &domain.Post{ID: "id1", Title: "updated", Content: "updated content", Author: "author2", Tags: []string{"tag1", "tag2"}}
-	if err := repo.Update(updated); err != nil {
+	if err := repo.Update(ctx, updated, 1); err != nil {
 		t.Fatalf("update failed: %v", err)
 	}
 
-	loaded, _ := repo.GetByID("id1")
+	loaded, _ := repo.GetByID(ctx, "id1")
 	if loaded.Title != "updated" {
 		t.Fatalf("title not updated: %s", loaded.Title)
 	}
@@ -98,41 +143,45 @@ func TestPostRepository_Update(t *testing.T) {
 
 func TestPostRepository_UpdateNilPost(t *testing.T) {
 	repo := NewPostRepository()
+	ctx := context.Background()
 
-	if err := repo.Update(nil); err != apperrors.ErrInvalidInput {
+	if err := repo.Update(ctx, nil, 0); err != apperrors.ErrInvalidInput {
 		t.Fatalf("expected invalid input for nil post, got %v", err)
 	}
 }
 
 func TestPostRepository_UpdateEmptyID(t *testing.T) {
 	repo := NewPostRepository()
+	ctx := context.Background()
 
 	post := &domain.Post{ID: "", Title: "title", Content: "content", Author: "author"}
-	if err := repo.Update(post); err != apperrors.ErrInvalidInput {
+	if err := repo.Update(ctx, post, 1); err != apperrors.ErrInvalidInput {
 		t.Fatalf("expected invalid input for empty id, got %v", err)
 	}
 }
 
 func TestPostRepository_UpdateNotFound(t *testing.T) {
 	repo := NewPostRepository()
+	ctx := context.Background()
 
 	post := &domain.Post{ID: "nonexistent", Title: "title", Content: "content", Author: "author"}
-	if err := repo.Update(post); err != apperrors.ErrPostNotFound {
+	if err := repo.Update(ctx, post, 1); err != apperrors.ErrPostNotFound {
 		t.Fatalf("expected not found, got %v", err)
 	}
 }
 
 func TestPostRepository_Delete(t *testing.T) {
 	repo := NewPostRepository()
+	ctx := context.Background()
 
 	post := &domain.Post{ID: "id1", Title: "title", Content: "content", Author: "author"}
-	_ = repo.Create(post)
+	_ = repo.Create(ctx, post)
 
-	if err := repo.Delete("id1"); err != nil {
+	if err := repo.Delete(ctx, "id1", 0); err != nil {
 		t.Fatalf("delete failed: %v", err)
 	}
 
-	_, err := repo.GetByID("id1")
+	_, err := repo.GetByID(ctx, "id1")
 	if err != apperrors.ErrPostNotFound {
 		t.Fatalf("expected post to be deleted, got %v", err)
 	}
@@ -140,32 +189,35 @@ func TestPostRepository_Delete(t *testing.T) {
 
 func TestPostRepository_DeleteEmptyID(t *testing.T) {
 	repo := NewPostRepository()
+	ctx := context.Background()
 
-	if err := repo.Delete(""); err != apperrors.ErrInvalidInput {
+	if err := repo.Delete(ctx, "", 0); err != apperrors.ErrInvalidInput {
 		t.Fatalf("expected invalid input for empty id, got %v", err)
 	}
 }
 
 func TestPostRepository_DeleteNotFound(t *testing.T) {
 	repo := NewPostRepository()
+	ctx := context.Background()
 
-	if err := repo.Delete("missing"); err != apperrors.ErrPostNotFound {
+	if err := repo.Delete(ctx, "missing", 0); err != apperrors.ErrPostNotFound {
 		t.Fatalf("expected not found, got %v", err)
 	}
 }
 
 func TestPostRepository_List(t *testing.T) {
 	repo := NewPostRepository()
+	ctx := context.Background()
 
 	post1 := &domain.Post{ID: "id1", Title: "title1", Content: "content1", Author: "author1"}
 	post2 := &domain.Post{ID: "id2", Title: "title2", Content: "content2", Author: "author2"}
 	post3 := &domain.Post{ID: "id3", Title: "title3", Content: "content3", Author: "author3"}
 
-	_ = repo.Create(post1)
-	_ = repo.Create(post2)
-	_ = repo.Create(post3)
+	_ = repo.Create(ctx, post1)
+	_ = repo.Create(ctx, post2)
+	_ = repo.Create(ctx, post3)
 
-	posts, err := repo.List()
+	posts, err := repo.List(ctx)
 	if err != nil {
 		t.Fatalf("list failed: %v", err)
 	}
@@ -177,8 +229,9 @@ func TestPostRepository_List(t *testing.T) {
 
 func TestPostRepository_ListEmpty(t *testing.T) {
 	repo := NewPostRepository()
+	ctx := context.Background()
 
-	posts, err := repo.List()
+	posts, err := repo.List(ctx)
 	if err != nil {
 		t.Fatalf("list failed: %v", err)
 	}
@@ -190,6 +243,7 @@ func TestPostRepository_ListEmpty(t *testing.T) {
 
 func TestPostRepository_ConcurrentAccess(t *testing.T) {
 	repo := NewPostRepository()
+	ctx := context.Background()
 
 	const workers = 1000
 	var wg sync.WaitGroup
@@ -202,14 +256,14 @@ func TestPostRepository_ConcurrentAccess(t *testing.T) {
 			id := fmt.Sprintf("id-%d", i)
 			post := &domain.Post{ID: id, Title: "title", Content: "content", Author: "author"}
 
-			_ = repo.Create(post)
-			_, _ = repo.GetByID(id)
+			_ = repo.Create(ctx, post)
+			_, _ = repo.GetByID(ctx, id)
 		}(i)
 	}
 
 	wg.Wait()
 
-	posts, err := repo.List()
+	posts, err := repo.List(ctx)
 	if err != nil {
 		t.Fatalf("list failed: %v", err)
 	}
@@ -217,3 +271,47 @@ func TestPostRepository_ConcurrentAccess(t *testing.T) {
 		t.Fatalf("expected some posts after concurrent access")
 	}
 }
+
+func TestPostRepository_UpdateVersionConflict(t *testing.T) {
+	repo := NewPostRepository()
+	ctx := context.Background()
+
+	post := &domain.Post{ID: "id1", Title: "title", Content: "content", Author: "author"}
+	_ = repo.Create(ctx, post)
+	if post.Version != 1 {
+		t.Fatalf("expected version 1 after create, got %d", post.Version)
+	}
+
+	first := &domain.Post{ID: "id1", Title: "first", Content: "content", Author: "author"}
+	if err := repo.Update(ctx, first, 1); err != nil {
+		t.Fatalf("update failed: %v", err)
+	}
+	if first.Version != 2 {
+		t.Fatalf("expected version 2 after update, got %d", first.Version)
+	}
+
+	second := &domain.Post{ID: "id1", Title: "second", Content: "content", Author: "author"}
+	if err := repo.Update(ctx, second, 1); err != apperrors.ErrVersionConflict {
+		t.Fatalf("expected version conflict, got %v", err)
+	}
+
+	loaded, _ := repo.GetByID(ctx, "id1")
+	if loaded.Title != "first" || loaded.Version != 2 {
+		t.Fatalf("unexpected stored post: %+v", loaded)
+	}
+}
+
+func TestPostRepository_DeleteVersionConflict(t *testing.T) {
+	repo := NewPostRepository()
+	ctx := context.Background()
+
+	post := &domain.Post{ID: "id1", Title: "title", Content: "content", Author: "author"}
+	_ = repo.Create(ctx, post)
+
+	if err := repo.Delete(ctx, "id1", 5); err != apperrors.ErrVersionConflict {
+		t.Fatalf("expected version conflict, got %v", err)
+	}
+	if err := repo.Delete(ctx, "id1", 1); err != nil {
+		t.Fatalf("delete failed: %v", err)
+	}
+}
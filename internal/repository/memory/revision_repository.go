@@ -0,0 +1,115 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/BhaveetKumar/gRPC-server-go/internal/domain"
+	apperrors "github.com/BhaveetKumar/gRPC-server-go/internal/errors"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/repository"
+)
+
+type RevisionRepository struct {
+	mu        sync.RWMutex
+	revisions map[string]map[int64]*domain.Revision
+}
+
+var _ repository.RevisionRepository = (*RevisionRepository)(nil)
+
+func NewRevisionRepository() *RevisionRepository {
+	return &RevisionRepository{
+		revisions: make(map[string]map[int64]*domain.Revision),
+	}
+}
+
+func (r *RevisionRepository) AddRevision(ctx context.Context, rev *domain.Revision) error {
+	if rev == nil || rev.Post == nil || rev.Post.ID == "" {
+		return apperrors.ErrInvalidInput
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	byVersion, ok := r.revisions[rev.Post.ID]
+	if !ok {
+		byVersion = make(map[int64]*domain.Revision)
+		r.revisions[rev.Post.ID] = byVersion
+	}
+	if _, exists := byVersion[rev.Post.Version]; exists {
+		return apperrors.ErrVersionConflict
+	}
+
+	byVersion[rev.Post.Version] = rev.Clone()
+	return nil
+}
+
+func (r *RevisionRepository) GetRevision(ctx context.Context, postID string, version int64) (*domain.Revision, error) {
+	if postID == "" {
+		return nil, apperrors.ErrInvalidInput
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	rev, ok := r.revisions[postID][version]
+	if !ok {
+		return nil, apperrors.ErrRevisionNotFound
+	}
+
+	return rev.Clone(), nil
+}
+
+func (r *RevisionRepository) ListRevisions(ctx context.Context, postID string) ([]*domain.Revision, error) {
+	if postID == "" {
+		return nil, apperrors.ErrInvalidInput
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make([]*domain.Revision, 0, len(r.revisions[postID]))
+	for _, rev := range r.revisions[postID] {
+		result = append(result, rev.Clone())
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Post.Version > result[j].Post.Version
+	})
+
+	return result, nil
+}
+
+func (r *RevisionRepository) DeleteRevisions(ctx context.Context, postID string, versions []int64) error {
+	if postID == "" {
+		return apperrors.ErrInvalidInput
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	byVersion := r.revisions[postID]
+	for _, version := range versions {
+		delete(byVersion, version)
+	}
+	if len(byVersion) == 0 {
+		delete(r.revisions, postID)
+	}
+
+	return nil
+}
@@ -0,0 +1,84 @@
+package memory
+
+import (
+	"context"
+	"sync"
+
+	apperrors "github.com/BhaveetKumar/gRPC-server-go/internal/errors"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/repository"
+)
+
+type SlugRepository struct {
+	mu sync.RWMutex
+	// posts maps each claimed slug to the ID of the post holding it.
+	posts map[string]string
+}
+
+var _ repository.SlugRepository = (*SlugRepository)(nil)
+
+func NewSlugRepository() *SlugRepository {
+	return &SlugRepository{
+		posts: make(map[string]string),
+	}
+}
+
+func (r *SlugRepository) ClaimSlug(ctx context.Context, slug, postID string) error {
+	if slug == "" || postID == "" {
+		return apperrors.ErrInvalidInput
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if holder, taken := r.posts[slug]; taken && holder != postID {
+		return apperrors.ErrDuplicateSlug
+	}
+	r.posts[slug] = postID
+
+	return nil
+}
+
+func (r *SlugRepository) ResolveSlug(ctx context.Context, slug string) (string, error) {
+	if slug == "" {
+		return "", apperrors.ErrInvalidInput
+	}
+
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	postID, ok := r.posts[slug]
+	if !ok {
+		return "", apperrors.ErrPostNotFound
+	}
+
+	return postID, nil
+}
+
+func (r *SlugRepository) DeleteSlugs(ctx context.Context, postID string) error {
+	if postID == "" {
+		return apperrors.ErrInvalidInput
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for slug, holder := range r.posts {
+		if holder == postID {
+			delete(r.posts, slug)
+		}
+	}
+
+	return nil
+}
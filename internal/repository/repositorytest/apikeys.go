@@ -0,0 +1,223 @@
+package repositorytest
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/BhaveetKumar/gRPC-server-go/internal/domain"
+	apperrors "github.com/BhaveetKumar/gRPC-server-go/internal/errors"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/repository"
+)
+
+type APIKeyFactory func(t *testing.T) repository.APIKeyRepository
+
+// RunAPIKeys checks the repository.APIKeyRepository contract against fresh,
+// empty repositories returned by newRepo.
+func RunAPIKeys(t *testing.T, newRepo APIKeyFactory) {
+	tests := []struct {
+		name string
+		fn   func(t *testing.T, repo repository.APIKeyRepository)
+	}{
+		{"CreateAndGet", testAPIKeyCreateAndGet},
+		{"CreateDuplicate", testAPIKeyCreateDuplicate},
+		{"List", testAPIKeyList},
+		{"Revoke", testAPIKeyRevoke},
+		{"Touch", testAPIKeyTouch},
+		{"NotFound", testAPIKeyNotFound},
+		{"Invalid", testAPIKeyInvalid},
+		{"CanceledContext", testAPIKeyCanceledContext},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.fn(t, newRepo(t))
+		})
+	}
+}
+
+var apiKeyEpoch = time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+func newAPIKey(id string, created time.Time) *domain.APIKey {
+	return &domain.APIKey{
+		ID:         id,
+		Name:       "nightly export " + id,
+		Owner:      "alice",
+		Scopes:     []string{domain.ScopePostsRead, domain.ScopePostsWrite},
+		SecretHash: "hash-" + id,
+		CreatedAt:  created,
+		ExpiresAt:  created.Add(24 * time.Hour),
+	}
+}
+
+func mustGetAPIKey(t *testing.T, repo repository.APIKeyRepository, id string) *domain.APIKey {
+	t.Helper()
+
+	key, err := repo.GetAPIKey(context.Background(), id)
+	if err != nil {
+		t.Fatalf("get %s failed: %v", id, err)
+	}
+	return key
+}
+
+func testAPIKeyCreateAndGet(t *testing.T, repo repository.APIKeyRepository) {
+	key := newAPIKey("k1", apiKeyEpoch)
+	if err := repo.CreateAPIKey(context.Background(), key); err != nil {
+		t.Fatalf("create failed: %v", err)
+	}
+
+	got := mustGetAPIKey(t, repo, "k1")
+	if got.Name != key.Name || got.Owner != key.Owner || got.SecretHash != key.SecretHash {
+		t.Fatalf("expected %+v, got %+v", key, got)
+	}
+	if len(got.Scopes) != 2 || got.Scopes[0] != domain.ScopePostsRead || got.Scopes[1] != domain.ScopePostsWrite {
+		t.Fatalf("expected scopes to round-trip, got %v", got.Scopes)
+	}
+	if !got.CreatedAt.Equal(key.CreatedAt) || !got.ExpiresAt.Equal(key.ExpiresAt) {
+		t.Fatalf("expected timestamps to round-trip, got %+v", got)
+	}
+	if !got.LastUsedAt.IsZero() || got.Revoked() {
+		t.Fatalf("expected a fresh key to be unused and active, got %+v", got)
+	}
+
+	got.Scopes[0] = domain.ScopeAdmin
+	if again := mustGetAPIKey(t, repo, "k1"); again.Scopes[0] != domain.ScopePostsRead {
+		t.Fatal("expected the repository to hand out copies")
+	}
+}
+
+func testAPIKeyCreateDuplicate(t *testing.T, repo repository.APIKeyRepository) {
+	ctx := context.Background()
+
+	if err := repo.CreateAPIKey(ctx, newAPIKey("k1", apiKeyEpoch)); err != nil {
+		t.Fatalf("create failed: %v", err)
+	}
+	if err := repo.CreateAPIKey(ctx, newAPIKey("k1", apiKeyEpoch)); err != apperrors.ErrDuplicateAPIKey {
+		t.Fatalf("expected duplicate api key, got %v", err)
+	}
+}
+
+func testAPIKeyList(t *testing.T, repo repository.APIKeyRepository) {
+	ctx := context.Background()
+
+	keys, err := repo.ListAPIKeys(ctx)
+	if err != nil || len(keys) != 0 {
+		t.Fatalf("expected no keys, got %v, %v", keys, err)
+	}
+
+	for i, id := range []string{"k3", "k1", "k2"} {
+		if err := repo.CreateAPIKey(ctx, newAPIKey(id, apiKeyEpoch.Add(time.Duration(i)*time.Minute))); err != nil {
+			t.Fatalf("create %s failed: %v", id, err)
+		}
+	}
+
+	keys, err = repo.ListAPIKeys(ctx)
+	if err != nil {
+		t.Fatalf("list failed: %v", err)
+	}
+	if len(keys) != 3 || keys[0].ID != "k3" || keys[1].ID != "k1" || keys[2].ID != "k2" {
+		t.Fatalf("expected keys oldest first, got %v", keys)
+	}
+}
+
+func testAPIKeyRevoke(t *testing.T, repo repository.APIKeyRepository) {
+	ctx := context.Background()
+
+	if err := repo.CreateAPIKey(ctx, newAPIKey("k1", apiKeyEpoch)); err != nil {
+		t.Fatalf("create failed: %v", err)
+	}
+	revoked := apiKeyEpoch.Add(time.Hour)
+	if err := repo.RevokeAPIKey(ctx, "k1", revoked); err != nil {
+		t.Fatalf("revoke failed: %v", err)
+	}
+	if err := repo.RevokeAPIKey(ctx, "k1", revoked.Add(time.Hour)); err != nil {
+		t.Fatalf("expected revoking twice to succeed, got %v", err)
+	}
+
+	got := mustGetAPIKey(t, repo, "k1")
+	if !got.RevokedAt.Equal(revoked) {
+		t.Fatalf("expected the first revocation time %v to stick, got %v", revoked, got.RevokedAt)
+	}
+	if got.Active(apiKeyEpoch.Add(2 * time.Minute)) {
+		t.Fatal("expected a revoked key to be inactive")
+	}
+}
+
+func testAPIKeyTouch(t *testing.T, repo repository.APIKeyRepository) {
+	ctx := context.Background()
+
+	if err := repo.CreateAPIKey(ctx, newAPIKey("k1", apiKeyEpoch)); err != nil {
+		t.Fatalf("create failed: %v", err)
+	}
+	for _, minutes := range []int{5, 10} {
+		if err := repo.TouchAPIKey(ctx, "k1", apiKeyEpoch.Add(time.Duration(minutes)*time.Minute)); err != nil {
+			t.Fatalf("touch failed: %v", err)
+		}
+	}
+
+	if got := mustGetAPIKey(t, repo, "k1"); !got.LastUsedAt.Equal(apiKeyEpoch.Add(10 * time.Minute)) {
+		t.Fatalf("expected the latest use to be recorded, got %v", got.LastUsedAt)
+	}
+}
+
+func testAPIKeyNotFound(t *testing.T, repo repository.APIKeyRepository) {
+	ctx := context.Background()
+
+	if _, err := repo.GetAPIKey(ctx, "missing"); err != apperrors.ErrAPIKeyNotFound {
+		t.Fatalf("expected not found on get, got %v", err)
+	}
+	if err := repo.RevokeAPIKey(ctx, "missing", apiKeyEpoch); err != apperrors.ErrAPIKeyNotFound {
+		t.Fatalf("expected not found on revoke, got %v", err)
+	}
+	if err := repo.TouchAPIKey(ctx, "missing", apiKeyEpoch); err != apperrors.ErrAPIKeyNotFound {
+		t.Fatalf("expected not found on touch, got %v", err)
+	}
+}
+
+func testAPIKeyInvalid(t *testing.T, repo repository.APIKeyRepository) {
+	ctx := context.Background()
+
+	invalid := []*domain.APIKey{
+		nil,
+		{Name: "no id", Owner: "alice", Scopes: []string{domain.ScopeAdmin}, SecretHash: "h"},
+		{ID: "k1", Name: "no hash", Owner: "alice", Scopes: []string{domain.ScopeAdmin}},
+		{ID: "k1", Name: "no scopes", Owner: "alice", SecretHash: "h"},
+		{ID: "k1", Name: "bad scope", Owner: "alice", Scopes: []string{"posts:delete"}, SecretHash: "h"},
+	}
+	for _, key := range invalid {
+		if err := repo.CreateAPIKey(ctx, key); err != apperrors.ErrInvalidInput {
+			t.Fatalf("expected invalid input for %+v, got %v", key, err)
+		}
+	}
+	if _, err := repo.GetAPIKey(ctx, ""); err != apperrors.ErrInvalidInput {
+		t.Fatalf("expected invalid input for an empty id, got %v", err)
+	}
+	if err := repo.RevokeAPIKey(ctx, "", apiKeyEpoch); err != apperrors.ErrInvalidInput {
+		t.Fatalf("expected invalid input for revoking an empty id, got %v", err)
+	}
+	if err := repo.TouchAPIKey(ctx, "", apiKeyEpoch); err != apperrors.ErrInvalidInput {
+		t.Fatalf("expected invalid input for touching an empty id, got %v", err)
+	}
+}
+
+func testAPIKeyCanceledContext(t *testing.T, repo repository.APIKeyRepository) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := repo.CreateAPIKey(ctx, newAPIKey("k1", apiKeyEpoch)); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected create to honour cancellation, got %v", err)
+	}
+	if _, err := repo.GetAPIKey(ctx, "k1"); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected get to honour cancellation, got %v", err)
+	}
+	if _, err := repo.ListAPIKeys(ctx); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected list to honour cancellation, got %v", err)
+	}
+	if err := repo.RevokeAPIKey(ctx, "k1", apiKeyEpoch); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected revoke to honour cancellation, got %v", err)
+	}
+	if err := repo.TouchAPIKey(ctx, "k1", apiKeyEpoch); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected touch to honour cancellation, got %v", err)
+	}
+}
@@ -0,0 +1,272 @@
+package repositorytest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/BhaveetKumar/gRPC-server-go/internal/domain"
+	apperrors "github.com/BhaveetKumar/gRPC-server-go/internal/errors"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/repository"
+)
+
+type AuthorFactory func(t *testing.T) repository.AuthorRepository
+
+// RunAuthors checks the repository.AuthorRepository contract against fresh,
+// empty repositories returned by newRepo.
+func RunAuthors(t *testing.T, newRepo AuthorFactory) {
+	tests := []struct {
+		name string
+		fn   func(t *testing.T, repo repository.AuthorRepository)
+	}{
+		{"CreateAndGet", testAuthorCreateAndGet},
+		{"CreateInvalid", testAuthorCreateInvalid},
+		{"CreateDuplicate", testAuthorCreateDuplicate},
+		{"GetByName", testAuthorGetByName},
+		{"Update", testAuthorUpdate},
+		{"Rename", testAuthorRename},
+		{"Delete", testAuthorDelete},
+		{"List", testAuthorList},
+		{"CanceledContext", testAuthorCanceledContext},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.fn(t, newRepo(t))
+		})
+	}
+}
+
+func newAuthor(id, name string) *domain.Author {
+	at := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	return &domain.Author{
+		ID:        id,
+		Name:      name,
+		Bio:       "bio of " + name,
+		AvatarURL: "https://example.com/" + id + ".png",
+		CreatedAt: at,
+		UpdatedAt: at,
+	}
+}
+
+func mustCreateAuthor(t *testing.T, repo repository.AuthorRepository, author *domain.Author) {
+	t.Helper()
+
+	if err := repo.CreateAuthor(context.Background(), author); err != nil {
+		t.Fatalf("create author %s failed: %v", author.ID, err)
+	}
+}
+
+func listAuthorIDs(t *testing.T, repo repository.AuthorRepository) string {
+	t.Helper()
+
+	authors, err := repo.ListAuthors(context.Background())
+	if err != nil {
+		t.Fatalf("list authors failed: %v", err)
+	}
+	ids := make([]string, 0, len(authors))
+	for _, a := range authors {
+		ids = append(ids, a.ID)
+	}
+	sort.Strings(ids)
+	return fmt.Sprint(ids)
+}
+
+func testAuthorCreateAndGet(t *testing.T, repo repository.AuthorRepository) {
+	author := newAuthor("a1", "John Doe")
+	mustCreateAuthor(t, repo, author)
+	if author.Version != 1 {
+		t.Fatalf("expected version 1 after create, got %d", author.Version)
+	}
+
+	loaded, err := repo.GetAuthor(context.Background(), "a1")
+	if err != nil {
+		t.Fatalf("get author failed: %v", err)
+	}
+	if *loaded != *author {
+		t.Fatalf("unexpected author: got %+v, want %+v", loaded, author)
+	}
+
+	loaded.Bio = "mutated"
+	again, _ := repo.GetAuthor(context.Background(), "a1")
+	if again.Bio != author.Bio {
+		t.Fatalf("mutating a loaded author leaked into storage: %+v", again)
+	}
+}
+
+func testAuthorCreateInvalid(t *testing.T, repo repository.AuthorRepository) {
+	ctx := context.Background()
+
+	if err := repo.CreateAuthor(ctx, nil); err != apperrors.ErrInvalidInput {
+		t.Fatalf("expected invalid input for nil author, got %v", err)
+	}
+	if _, err := repo.GetAuthor(ctx, ""); err != apperrors.ErrInvalidInput {
+		t.Fatalf("expected invalid input for empty id, got %v", err)
+	}
+	if _, err := repo.GetAuthor(ctx, "missing"); err != apperrors.ErrAuthorNotFound {
+		t.Fatalf("expected author not found, got %v", err)
+	}
+	if _, err := repo.GetAuthorByName(ctx, "  "); err != apperrors.ErrInvalidInput {
+		t.Fatalf("expected invalid input for blank name, got %v", err)
+	}
+}
+
+func testAuthorCreateDuplicate(t *testing.T, repo repository.AuthorRepository) {
+	ctx := context.Background()
+	mustCreateAuthor(t, repo, newAuthor("a1", "John Doe"))
+
+	if err := repo.CreateAuthor(ctx, newAuthor("a1", "Someone Else")); err != apperrors.ErrDuplicateAuthor {
+		t.Fatalf("expected duplicate author for a reused id, got %v", err)
+	}
+	if err := repo.CreateAuthor(ctx, newAuthor("a2", "  john   DOE ")); err != apperrors.ErrDuplicateAuthor {
+		t.Fatalf("expected duplicate author for another spelling of the name, got %v", err)
+	}
+	if got := listAuthorIDs(t, repo); got != "[a1]" {
+		t.Fatalf("duplicate author was stored: %s", got)
+	}
+}
+
+func testAuthorGetByName(t *testing.T, repo repository.AuthorRepository) {
+	ctx := context.Background()
+	mustCreateAuthor(t, repo, newAuthor("a1", "John Doe"))
+	mustCreateAuthor(t, repo, newAuthor("a2", "Jane Roe"))
+
+	for _, name := range []string{"John Doe", "john doe", " JOHN\tdoe "} {
+		author, err := repo.GetAuthorByName(ctx, name)
+		if err != nil {
+			t.Fatalf("get author by %q failed: %v", name, err)
+		}
+		if author.ID != "a1" || author.Name != "John Doe" {
+			t.Fatalf("unexpected author for %q: %+v", name, author)
+		}
+	}
+	if _, err := repo.GetAuthorByName(ctx, "Johnny Doe"); err != apperrors.ErrAuthorNotFound {
+		t.Fatalf("expected author not found, got %v", err)
+	}
+}
+
+func testAuthorUpdate(t *testing.T, repo repository.AuthorRepository) {
+	ctx := context.Background()
+	mustCreateAuthor(t, repo, newAuthor("a1", "John Doe"))
+
+	edited := newAuthor("a1", "John Doe")
+	edited.Bio = "edited"
+	if err := repo.UpdateAuthor(ctx, edited, 1); err != nil {
+		t.Fatalf("update failed: %v", err)
+	}
+	if edited.Version != 2 {
+		t.Fatalf("expected version 2 after update, got %d", edited.Version)
+	}
+
+	if err := repo.UpdateAuthor(ctx, newAuthor("a1", "John Doe"), 1); err != apperrors.ErrVersionConflict {
+		t.Fatalf("expected version conflict, got %v", err)
+	}
+	if err := repo.UpdateAuthor(ctx, newAuthor("missing", "Nobody"), 1); err != apperrors.ErrAuthorNotFound {
+		t.Fatalf("expected author not found, got %v", err)
+	}
+	if err := repo.UpdateAuthor(ctx, nil, 1); err != apperrors.ErrInvalidInput {
+		t.Fatalf("expected invalid input for nil author, got %v", err)
+	}
+
+	loaded, _ := repo.GetAuthor(ctx, "a1")
+	if loaded.Bio != "edited" || loaded.Version != 2 {
+		t.Fatalf("unexpected stored author: %+v", loaded)
+	}
+}
+
+func testAuthorRename(t *testing.T, repo repository.AuthorRepository) {
+	ctx := context.Background()
+	mustCreateAuthor(t, repo, newAuthor("a1", "John Doe"))
+	mustCreateAuthor(t, repo, newAuthor("a2", "Jane Roe"))
+
+	if err := repo.UpdateAuthor(ctx, newAuthor("a1", "JANE ROE"), 1); err != apperrors.ErrDuplicateAuthor {
+		t.Fatalf("expected duplicate author when renaming onto another author, got %v", err)
+	}
+
+	// Changing only the spelling of the author's own name is allowed.
+	if err := repo.UpdateAuthor(ctx, newAuthor("a1", "john doe"), 1); err != nil {
+		t.Fatalf("respelling failed: %v", err)
+	}
+	if err := repo.UpdateAuthor(ctx, newAuthor("a1", "Johnny Doe"), 2); err != nil {
+		t.Fatalf("rename failed: %v", err)
+	}
+
+	if _, err := repo.GetAuthorByName(ctx, "John Doe"); err != apperrors.ErrAuthorNotFound {
+		t.Fatalf("expected the old name to be released, got %v", err)
+	}
+	if author, err := repo.GetAuthorByName(ctx, "johnny doe"); err != nil || author.ID != "a1" {
+		t.Fatalf("expected the new name to find a1, got %+v, %v", author, err)
+	}
+	mustCreateAuthor(t, repo, newAuthor("a3", "John Doe"))
+}
+
+func testAuthorDelete(t *testing.T, repo repository.AuthorRepository) {
+	ctx := context.Background()
+	mustCreateAuthor(t, repo, newAuthor("a1", "John Doe"))
+	mustCreateAuthor(t, repo, newAuthor("a2", "Jane Roe"))
+
+	if err := repo.DeleteAuthor(ctx, "a1", 5); err != apperrors.ErrVersionConflict {
+		t.Fatalf("expected version conflict, got %v", err)
+	}
+	if err := repo.DeleteAuthor(ctx, "a1", 1); err != nil {
+		t.Fatalf("delete failed: %v", err)
+	}
+	if err := repo.DeleteAuthor(ctx, "a1", 0); err != apperrors.ErrAuthorNotFound {
+		t.Fatalf("expected author not found, got %v", err)
+	}
+	if err := repo.DeleteAuthor(ctx, "", 0); err != apperrors.ErrInvalidInput {
+		t.Fatalf("expected invalid input for empty id, got %v", err)
+	}
+	if _, err := repo.GetAuthorByName(ctx, "john doe"); err != apperrors.ErrAuthorNotFound {
+		t.Fatalf("expected the deleted author's name to be released, got %v", err)
+	}
+	if got := listAuthorIDs(t, repo); got != "[a2]" {
+		t.Fatalf("unexpected authors after delete: %s", got)
+	}
+}
+
+func testAuthorList(t *testing.T, repo repository.AuthorRepository) {
+	if got := listAuthorIDs(t, repo); got != "[]" {
+		t.Fatalf("expected no authors, got %s", got)
+	}
+
+	mustCreateAuthor(t, repo, newAuthor("a2", "Jane Roe"))
+	mustCreateAuthor(t, repo, newAuthor("a1", "John Doe"))
+
+	if got := listAuthorIDs(t, repo); got != "[a1 a2]" {
+		t.Fatalf("unexpected authors: %s", got)
+	}
+}
+
+func testAuthorCanceledContext(t *testing.T, repo repository.AuthorRepository) {
+	mustCreateAuthor(t, repo, newAuthor("a1", "John Doe"))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := repo.CreateAuthor(ctx, newAuthor("a2", "Jane Roe")); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected create to honour cancellation, got %v", err)
+	}
+	if _, err := repo.GetAuthor(ctx, "a1"); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected get to honour cancellation, got %v", err)
+	}
+	if _, err := repo.GetAuthorByName(ctx, "John Doe"); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected get by name to honour cancellation, got %v", err)
+	}
+	if err := repo.UpdateAuthor(ctx, newAuthor("a1", "John Doe"), 1); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected update to honour cancellation, got %v", err)
+	}
+	if err := repo.DeleteAuthor(ctx, "a1", 0); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected delete to honour cancellation, got %v", err)
+	}
+	if _, err := repo.ListAuthors(ctx); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected list to honour cancellation, got %v", err)
+	}
+
+	if _, err := repo.GetAuthor(context.Background(), "a2"); err != apperrors.ErrAuthorNotFound {
+		t.Fatalf("canceled create must not store the author, got %v", err)
+	}
+}
@@ -0,0 +1,245 @@
+package repositorytest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/BhaveetKumar/gRPC-server-go/internal/domain"
+	apperrors "github.com/BhaveetKumar/gRPC-server-go/internal/errors"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/repository"
+)
+
+type CommentFactory func(t *testing.T) repository.CommentRepository
+
+// RunComments checks the repository.CommentRepository contract against
+// fresh, empty repositories returned by newRepo.
+func RunComments(t *testing.T, newRepo CommentFactory) {
+	tests := []struct {
+		name string
+		fn   func(t *testing.T, repo repository.CommentRepository)
+	}{
+		{"CreateAndGet", testCommentCreateAndGet},
+		{"CreateInvalid", testCommentCreateInvalid},
+		{"CreateDuplicate", testCommentCreateDuplicate},
+		{"Update", testCommentUpdate},
+		{"Delete", testCommentDelete},
+		{"ListOldestFirst", testCommentListOldestFirst},
+		{"DeleteAllForPost", testCommentDeleteAllForPost},
+		{"CanceledContext", testCommentCanceledContext},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.fn(t, newRepo(t))
+		})
+	}
+}
+
+func newComment(id, postID string, second int) *domain.Comment {
+	return &domain.Comment{
+		ID:        id,
+		PostID:    postID,
+		Author:    "reader",
+		Content:   "comment " + id,
+		CreatedAt: time.Date(2026, 1, 1, 12, 0, second, 0, time.UTC),
+		UpdatedAt: time.Date(2026, 1, 1, 12, 0, second, 0, time.UTC),
+	}
+}
+
+func mustCreateComment(t *testing.T, repo repository.CommentRepository, comment *domain.Comment) {
+	t.Helper()
+
+	if err := repo.CreateComment(context.Background(), comment); err != nil {
+		t.Fatalf("create comment %s failed: %v", comment.ID, err)
+	}
+}
+
+func listCommentIDs(t *testing.T, repo repository.CommentRepository, postID string) string {
+	t.Helper()
+
+	comments, err := repo.ListComments(context.Background(), postID)
+	if err != nil {
+		t.Fatalf("list comments of %s failed: %v", postID, err)
+	}
+	ids := make([]string, 0, len(comments))
+	for _, c := range comments {
+		ids = append(ids, c.ID)
+	}
+	return fmt.Sprint(ids)
+}
+
+func testCommentCreateAndGet(t *testing.T, repo repository.CommentRepository) {
+	reply := newComment("c2", "p1", 1)
+	reply.ParentID = "c1"
+	mustCreateComment(t, repo, reply)
+	if reply.Version != 1 {
+		t.Fatalf("expected version 1 after create, got %d", reply.Version)
+	}
+
+	loaded, err := repo.GetComment(context.Background(), "c2")
+	if err != nil {
+		t.Fatalf("get comment failed: %v", err)
+	}
+	if loaded.PostID != "p1" || loaded.ParentID != "c1" || loaded.Author != "reader" || loaded.Content != "comment c2" || loaded.Version != 1 {
+		t.Fatalf("unexpected comment: %+v", loaded)
+	}
+	if !loaded.CreatedAt.Equal(reply.CreatedAt) {
+		t.Fatalf("unexpected created at: %v", loaded.CreatedAt)
+	}
+
+	loaded.Content = "mutated"
+	again, _ := repo.GetComment(context.Background(), "c2")
+	if again.Content != "comment c2" {
+		t.Fatalf("mutating a loaded comment leaked into storage: %+v", again)
+	}
+}
+
+func testCommentCreateInvalid(t *testing.T, repo repository.CommentRepository) {
+	ctx := context.Background()
+
+	if err := repo.CreateComment(ctx, nil); err != apperrors.ErrInvalidInput {
+		t.Fatalf("expected invalid input for nil comment, got %v", err)
+	}
+	if err := repo.CreateComment(ctx, &domain.Comment{ID: "c1"}); err != apperrors.ErrInvalidInput {
+		t.Fatalf("expected invalid input for comment without post, got %v", err)
+	}
+	if _, err := repo.GetComment(ctx, ""); err != apperrors.ErrInvalidInput {
+		t.Fatalf("expected invalid input for empty id, got %v", err)
+	}
+	if _, err := repo.GetComment(ctx, "missing"); err != apperrors.ErrCommentNotFound {
+		t.Fatalf("expected comment not found, got %v", err)
+	}
+	if _, err := repo.ListComments(ctx, ""); err != apperrors.ErrInvalidInput {
+		t.Fatalf("expected invalid input for empty post id, got %v", err)
+	}
+}
+
+func testCommentCreateDuplicate(t *testing.T, repo repository.CommentRepository) {
+	mustCreateComment(t, repo, newComment("c1", "p1", 0))
+
+	if err := repo.CreateComment(context.Background(), newComment("c1", "p2", 0)); err != apperrors.ErrDuplicateComment {
+		t.Fatalf("expected duplicate comment, got %v", err)
+	}
+	if got := listCommentIDs(t, repo, "p2"); got != "[]" {
+		t.Fatalf("duplicate comment was stored: %s", got)
+	}
+}
+
+func testCommentUpdate(t *testing.T, repo repository.CommentRepository) {
+	ctx := context.Background()
+	mustCreateComment(t, repo, newComment("c1", "p1", 0))
+
+	edited := newComment("c1", "p2", 0)
+	edited.Content = "edited"
+	if err := repo.UpdateComment(ctx, edited, 1); err != nil {
+		t.Fatalf("update failed: %v", err)
+	}
+	if edited.Version != 2 || edited.PostID != "p1" {
+		t.Fatalf("expected version 2 on the original post, got %+v", edited)
+	}
+
+	if err := repo.UpdateComment(ctx, newComment("c1", "p1", 0), 1); err != apperrors.ErrVersionConflict {
+		t.Fatalf("expected version conflict, got %v", err)
+	}
+	if err := repo.UpdateComment(ctx, newComment("missing", "p1", 0), 1); err != apperrors.ErrCommentNotFound {
+		t.Fatalf("expected comment not found, got %v", err)
+	}
+
+	loaded, _ := repo.GetComment(ctx, "c1")
+	if loaded.Content != "edited" || loaded.Version != 2 {
+		t.Fatalf("unexpected stored comment: %+v", loaded)
+	}
+	if got := listCommentIDs(t, repo, "p1"); got != "[c1]" {
+		t.Fatalf("expected the comment to stay on its post, got %s", got)
+	}
+}
+
+func testCommentDelete(t *testing.T, repo repository.CommentRepository) {
+	ctx := context.Background()
+	mustCreateComment(t, repo, newComment("c1", "p1", 0))
+	mustCreateComment(t, repo, newComment("c2", "p1", 1))
+
+	if err := repo.DeleteComment(ctx, "c1"); err != nil {
+		t.Fatalf("delete failed: %v", err)
+	}
+	if _, err := repo.GetComment(ctx, "c1"); err != apperrors.ErrCommentNotFound {
+		t.Fatalf("expected deleted comment to be gone, got %v", err)
+	}
+	if err := repo.DeleteComment(ctx, "c1"); err != apperrors.ErrCommentNotFound {
+		t.Fatalf("expected comment not found, got %v", err)
+	}
+	if got := listCommentIDs(t, repo, "p1"); got != "[c2]" {
+		t.Fatalf("unexpected comments after delete: %s", got)
+	}
+}
+
+func testCommentListOldestFirst(t *testing.T, repo repository.CommentRepository) {
+	mustCreateComment(t, repo, newComment("c3", "p1", 2))
+	mustCreateComment(t, repo, newComment("c1", "p1", 0))
+	mustCreateComment(t, repo, newComment("c2b", "p1", 1))
+	mustCreateComment(t, repo, newComment("c2a", "p1", 1))
+	mustCreateComment(t, repo, newComment("other", "p2", 0))
+
+	if got := listCommentIDs(t, repo, "p1"); got != "[c1 c2a c2b c3]" {
+		t.Fatalf("unexpected order: %s", got)
+	}
+	if got := listCommentIDs(t, repo, "missing"); got != "[]" {
+		t.Fatalf("expected no comments, got %s", got)
+	}
+}
+
+func testCommentDeleteAllForPost(t *testing.T, repo repository.CommentRepository) {
+	ctx := context.Background()
+	mustCreateComment(t, repo, newComment("c1", "p1", 0))
+	mustCreateComment(t, repo, newComment("c2", "p1", 1))
+	mustCreateComment(t, repo, newComment("c3", "p2", 0))
+
+	if err := repo.DeleteComments(ctx, "p1"); err != nil {
+		t.Fatalf("delete comments failed: %v", err)
+	}
+	if got := listCommentIDs(t, repo, "p1"); got != "[]" {
+		t.Fatalf("expected p1 comments to be gone, got %s", got)
+	}
+	if _, err := repo.GetComment(ctx, "c2"); err != apperrors.ErrCommentNotFound {
+		t.Fatalf("expected comment not found, got %v", err)
+	}
+	if got := listCommentIDs(t, repo, "p2"); got != "[c3]" {
+		t.Fatalf("expected other posts to keep their comments, got %s", got)
+	}
+	if err := repo.DeleteComments(ctx, "missing"); err != nil {
+		t.Fatalf("deleting comments of an unknown post should be a no-op, got %v", err)
+	}
+}
+
+func testCommentCanceledContext(t *testing.T, repo repository.CommentRepository) {
+	mustCreateComment(t, repo, newComment("c1", "p1", 0))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := repo.CreateComment(ctx, newComment("c2", "p1", 1)); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected create to honour cancellation, got %v", err)
+	}
+	if _, err := repo.GetComment(ctx, "c1"); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected get to honour cancellation, got %v", err)
+	}
+	if err := repo.UpdateComment(ctx, newComment("c1", "p1", 0), 1); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected update to honour cancellation, got %v", err)
+	}
+	if err := repo.DeleteComment(ctx, "c1"); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected delete to honour cancellation, got %v", err)
+	}
+	if _, err := repo.ListComments(ctx, "p1"); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected list to honour cancellation, got %v", err)
+	}
+	if err := repo.DeleteComments(ctx, "p1"); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected delete comments to honour cancellation, got %v", err)
+	}
+
+	if got := listCommentIDs(t, repo, "p1"); got != "[c1]" {
+		t.Fatalf("canceled calls changed storage: %s", got)
+	}
+}
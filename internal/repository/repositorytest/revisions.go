@@ -0,0 +1,235 @@
+package repositorytest
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/BhaveetKumar/gRPC-server-go/internal/domain"
+	apperrors "github.com/BhaveetKumar/gRPC-server-go/internal/errors"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/repository"
+)
+
+type RevisionFactory func(t *testing.T) repository.RevisionRepository
+
+// RunRevisions checks the repository.RevisionRepository contract against
+// fresh, empty repositories returned by newRepo.
+func RunRevisions(t *testing.T, newRepo RevisionFactory) {
+	tests := []struct {
+		name string
+		fn   func(t *testing.T, repo repository.RevisionRepository)
+	}{
+		{"AddAndGet", testRevisionAddAndGet},
+		{"AddInvalid", testRevisionAddInvalid},
+		{"AddDuplicateVersion", testRevisionAddDuplicateVersion},
+		{"GetNotFound", testRevisionGetNotFound},
+		{"ListNewestFirst", testRevisionListNewestFirst},
+		{"Delete", testRevisionDelete},
+		{"CopyIsolation", testRevisionCopyIsolation},
+		{"CanceledContext", testRevisionCanceledContext},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.fn(t, newRepo(t))
+		})
+	}
+}
+
+func newRevision(id string, version int64) *domain.Revision {
+	post := newPost(id)
+	post.Version = version
+	return &domain.Revision{
+		Post:          post,
+		Editor:        "editor",
+		CreatedAt:     time.Date(2026, 1, 1, 12, 0, int(version), 0, time.UTC),
+		ChangedFields: []string{"title", "tags"},
+	}
+}
+
+func mustAddRevision(t *testing.T, repo repository.RevisionRepository, rev *domain.Revision) {
+	t.Helper()
+
+	if err := repo.AddRevision(context.Background(), rev); err != nil {
+		t.Fatalf("add revision %s@%d failed: %v", rev.Post.ID, rev.Post.Version, err)
+	}
+}
+
+func listVersions(t *testing.T, repo repository.RevisionRepository, id string) []int64 {
+	t.Helper()
+
+	revs, err := repo.ListRevisions(context.Background(), id)
+	if err != nil {
+		t.Fatalf("list revisions of %s failed: %v", id, err)
+	}
+	versions := make([]int64, 0, len(revs))
+	for _, rev := range revs {
+		versions = append(versions, rev.Post.Version)
+	}
+	return versions
+}
+
+func assertVersions(t *testing.T, got, want []int64) {
+	t.Helper()
+
+	if len(got) != len(want) {
+		t.Fatalf("expected versions %v, got %v", want, got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("expected versions %v, got %v", want, got)
+		}
+	}
+}
+
+func testRevisionAddAndGet(t *testing.T, repo repository.RevisionRepository) {
+	mustAddRevision(t, repo, newRevision("id1", 1))
+
+	rev, err := repo.GetRevision(context.Background(), "id1", 1)
+	if err != nil {
+		t.Fatalf("get revision failed: %v", err)
+	}
+	if rev.Post.Title != "title id1" || rev.Post.Content != "content id1" || rev.Post.Status != domain.StatusDraft {
+		t.Fatalf("unexpected revision post: %+v", rev.Post)
+	}
+	assertTags(t, rev.Post.Tags, []string{"go", "grpc"})
+	if rev.Editor != "editor" {
+		t.Fatalf("unexpected editor: %q", rev.Editor)
+	}
+	if !rev.CreatedAt.Equal(time.Date(2026, 1, 1, 12, 0, 1, 0, time.UTC)) {
+		t.Fatalf("unexpected created at: %v", rev.CreatedAt)
+	}
+	assertTags(t, rev.ChangedFields, []string{"title", "tags"})
+}
+
+func testRevisionAddInvalid(t *testing.T, repo repository.RevisionRepository) {
+	ctx := context.Background()
+
+	if err := repo.AddRevision(ctx, nil); err != apperrors.ErrInvalidInput {
+		t.Fatalf("expected invalid input for nil revision, got %v", err)
+	}
+	if err := repo.AddRevision(ctx, &domain.Revision{}); err != apperrors.ErrInvalidInput {
+		t.Fatalf("expected invalid input for revision without post, got %v", err)
+	}
+	if _, err := repo.GetRevision(ctx, "", 1); err != apperrors.ErrInvalidInput {
+		t.Fatalf("expected invalid input for empty id, got %v", err)
+	}
+	if _, err := repo.ListRevisions(ctx, ""); err != apperrors.ErrInvalidInput {
+		t.Fatalf("expected invalid input for empty id, got %v", err)
+	}
+}
+
+func testRevisionAddDuplicateVersion(t *testing.T, repo repository.RevisionRepository) {
+	mustAddRevision(t, repo, newRevision("id1", 1))
+
+	dup := newRevision("id1", 1)
+	dup.Editor = "someone else"
+	if err := repo.AddRevision(context.Background(), dup); err != apperrors.ErrVersionConflict {
+		t.Fatalf("expected version conflict, got %v", err)
+	}
+
+	rev, err := repo.GetRevision(context.Background(), "id1", 1)
+	if err != nil {
+		t.Fatalf("get revision failed: %v", err)
+	}
+	if rev.Editor != "editor" {
+		t.Fatalf("duplicate revision replaced the original: %+v", rev)
+	}
+}
+
+func testRevisionGetNotFound(t *testing.T, repo repository.RevisionRepository) {
+	mustAddRevision(t, repo, newRevision("id1", 1))
+
+	if _, err := repo.GetRevision(context.Background(), "id1", 2); err != apperrors.ErrRevisionNotFound {
+		t.Fatalf("expected revision not found, got %v", err)
+	}
+	if _, err := repo.GetRevision(context.Background(), "missing", 1); err != apperrors.ErrRevisionNotFound {
+		t.Fatalf("expected revision not found, got %v", err)
+	}
+	assertVersions(t, listVersions(t, repo, "missing"), nil)
+}
+
+func testRevisionListNewestFirst(t *testing.T, repo repository.RevisionRepository) {
+	mustAddRevision(t, repo, newRevision("id1", 2))
+	mustAddRevision(t, repo, newRevision("id1", 1))
+	mustAddRevision(t, repo, newRevision("id1", 3))
+	mustAddRevision(t, repo, newRevision("id2", 1))
+
+	assertVersions(t, listVersions(t, repo, "id1"), []int64{3, 2, 1})
+	assertVersions(t, listVersions(t, repo, "id2"), []int64{1})
+}
+
+func testRevisionDelete(t *testing.T, repo repository.RevisionRepository) {
+	ctx := context.Background()
+
+	for v := int64(1); v <= 3; v++ {
+		mustAddRevision(t, repo, newRevision("id1", v))
+	}
+	mustAddRevision(t, repo, newRevision("id2", 1))
+
+	if err := repo.DeleteRevisions(ctx, "id1", []int64{1, 3, 7}); err != nil {
+		t.Fatalf("delete revisions failed: %v", err)
+	}
+	assertVersions(t, listVersions(t, repo, "id1"), []int64{2})
+	assertVersions(t, listVersions(t, repo, "id2"), []int64{1})
+
+	if err := repo.DeleteRevisions(ctx, "missing", []int64{1}); err != nil {
+		t.Fatalf("deleting unknown revisions should be a no-op, got %v", err)
+	}
+	if err := repo.DeleteRevisions(ctx, "", []int64{1}); err != apperrors.ErrInvalidInput {
+		t.Fatalf("expected invalid input for empty id, got %v", err)
+	}
+
+	// A deleted version can be recorded again.
+	mustAddRevision(t, repo, newRevision("id1", 1))
+	assertVersions(t, listVersions(t, repo, "id1"), []int64{2, 1})
+}
+
+func testRevisionCopyIsolation(t *testing.T, repo repository.RevisionRepository) {
+	rev := newRevision("id1", 1)
+	mustAddRevision(t, repo, rev)
+	rev.Post.Title = "mutated"
+	rev.Post.Tags[0] = "mutated"
+	rev.ChangedFields[0] = "mutated"
+
+	loaded, err := repo.GetRevision(context.Background(), "id1", 1)
+	if err != nil {
+		t.Fatalf("get revision failed: %v", err)
+	}
+	if loaded.Post.Title != "title id1" {
+		t.Fatalf("mutating the added revision leaked into storage: %+v", loaded.Post)
+	}
+	assertTags(t, loaded.Post.Tags, []string{"go", "grpc"})
+	assertTags(t, loaded.ChangedFields, []string{"title", "tags"})
+
+	loaded.Post.Tags[0] = "mutated"
+	listed, _ := repo.ListRevisions(context.Background(), "id1")
+	listed[0].ChangedFields[0] = "mutated"
+
+	again, _ := repo.GetRevision(context.Background(), "id1", 1)
+	assertTags(t, again.Post.Tags, []string{"go", "grpc"})
+	assertTags(t, again.ChangedFields, []string{"title", "tags"})
+}
+
+func testRevisionCanceledContext(t *testing.T, repo repository.RevisionRepository) {
+	mustAddRevision(t, repo, newRevision("id1", 1))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := repo.AddRevision(ctx, newRevision("id1", 2)); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected add to honour cancellation, got %v", err)
+	}
+	if _, err := repo.GetRevision(ctx, "id1", 1); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected get to honour cancellation, got %v", err)
+	}
+	if _, err := repo.ListRevisions(ctx, "id1"); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected list to honour cancellation, got %v", err)
+	}
+	if err := repo.DeleteRevisions(ctx, "id1", []int64{1}); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected delete to honour cancellation, got %v", err)
+	}
+
+	assertVersions(t, listVersions(t, repo, "id1"), []int64{1})
+}
@@ -0,0 +1,147 @@
+package repositorytest
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	apperrors "github.com/BhaveetKumar/gRPC-server-go/internal/errors"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/repository"
+)
+
+type SlugFactory func(t *testing.T) repository.SlugRepository
+
+// RunSlugs checks the repository.SlugRepository contract against fresh,
+// empty repositories returned by newRepo.
+func RunSlugs(t *testing.T, newRepo SlugFactory) {
+	tests := []struct {
+		name string
+		fn   func(t *testing.T, repo repository.SlugRepository)
+	}{
+		{"ClaimAndResolve", testSlugClaimAndResolve},
+		{"ClaimTaken", testSlugClaimTaken},
+		{"KeepsHistory", testSlugKeepsHistory},
+		{"Delete", testSlugDelete},
+		{"Invalid", testSlugInvalid},
+		{"CanceledContext", testSlugCanceledContext},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.fn(t, newRepo(t))
+		})
+	}
+}
+
+func mustResolve(t *testing.T, repo repository.SlugRepository, slug, want string) {
+	t.Helper()
+
+	got, err := repo.ResolveSlug(context.Background(), slug)
+	if err != nil {
+		t.Fatalf("resolve %s failed: %v", slug, err)
+	}
+	if got != want {
+		t.Fatalf("expected %s to resolve to %s, got %s", slug, want, got)
+	}
+}
+
+func testSlugClaimAndResolve(t *testing.T, repo repository.SlugRepository) {
+	ctx := context.Background()
+
+	if err := repo.ClaimSlug(ctx, "hello-world", "p1"); err != nil {
+		t.Fatalf("claim failed: %v", err)
+	}
+	mustResolve(t, repo, "hello-world", "p1")
+
+	if err := repo.ClaimSlug(ctx, "hello-world", "p1"); err != nil {
+		t.Fatalf("expected claiming an own slug again to succeed, got %v", err)
+	}
+	if _, err := repo.ResolveSlug(ctx, "missing"); err != apperrors.ErrPostNotFound {
+		t.Fatalf("expected not found for an unknown slug, got %v", err)
+	}
+}
+
+func testSlugClaimTaken(t *testing.T, repo repository.SlugRepository) {
+	ctx := context.Background()
+
+	if err := repo.ClaimSlug(ctx, "hello-world", "p1"); err != nil {
+		t.Fatalf("claim failed: %v", err)
+	}
+	if err := repo.ClaimSlug(ctx, "hello-world", "p2"); err != apperrors.ErrDuplicateSlug {
+		t.Fatalf("expected duplicate slug, got %v", err)
+	}
+	mustResolve(t, repo, "hello-world", "p1")
+}
+
+func testSlugKeepsHistory(t *testing.T, repo repository.SlugRepository) {
+	ctx := context.Background()
+
+	for _, slug := range []string{"first", "second"} {
+		if err := repo.ClaimSlug(ctx, slug, "p1"); err != nil {
+			t.Fatalf("claim %s failed: %v", slug, err)
+		}
+	}
+	mustResolve(t, repo, "first", "p1")
+	mustResolve(t, repo, "second", "p1")
+	if err := repo.ClaimSlug(ctx, "first", "p2"); err != apperrors.ErrDuplicateSlug {
+		t.Fatalf("expected a former slug to stay taken, got %v", err)
+	}
+}
+
+func testSlugDelete(t *testing.T, repo repository.SlugRepository) {
+	ctx := context.Background()
+
+	for _, claim := range [][2]string{{"first", "p1"}, {"second", "p1"}, {"other", "p2"}} {
+		if err := repo.ClaimSlug(ctx, claim[0], claim[1]); err != nil {
+			t.Fatalf("claim %s failed: %v", claim[0], err)
+		}
+	}
+	if err := repo.DeleteSlugs(ctx, "p1"); err != nil {
+		t.Fatalf("delete failed: %v", err)
+	}
+	for _, slug := range []string{"first", "second"} {
+		if _, err := repo.ResolveSlug(ctx, slug); err != apperrors.ErrPostNotFound {
+			t.Fatalf("expected %s to be released, got %v", slug, err)
+		}
+	}
+	mustResolve(t, repo, "other", "p2")
+
+	if err := repo.ClaimSlug(ctx, "first", "p3"); err != nil {
+		t.Fatalf("expected a released slug to be claimable, got %v", err)
+	}
+	if err := repo.DeleteSlugs(ctx, "missing"); err != nil {
+		t.Fatalf("expected deleting the slugs of an unknown post to succeed, got %v", err)
+	}
+}
+
+func testSlugInvalid(t *testing.T, repo repository.SlugRepository) {
+	ctx := context.Background()
+
+	if err := repo.ClaimSlug(ctx, "", "p1"); err != apperrors.ErrInvalidInput {
+		t.Fatalf("expected invalid input for an empty slug, got %v", err)
+	}
+	if err := repo.ClaimSlug(ctx, "slug", ""); err != apperrors.ErrInvalidInput {
+		t.Fatalf("expected invalid input for an empty post id, got %v", err)
+	}
+	if _, err := repo.ResolveSlug(ctx, ""); err != apperrors.ErrInvalidInput {
+		t.Fatalf("expected invalid input for resolving an empty slug, got %v", err)
+	}
+	if err := repo.DeleteSlugs(ctx, ""); err != apperrors.ErrInvalidInput {
+		t.Fatalf("expected invalid input for an empty post id, got %v", err)
+	}
+}
+
+func testSlugCanceledContext(t *testing.T, repo repository.SlugRepository) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := repo.ClaimSlug(ctx, "slug", "p1"); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected claim to honour cancellation, got %v", err)
+	}
+	if _, err := repo.ResolveSlug(ctx, "slug"); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected resolve to honour cancellation, got %v", err)
+	}
+	if err := repo.DeleteSlugs(ctx, "p1"); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected delete to honour cancellation, got %v", err)
+	}
+}
@@ -0,0 +1,531 @@
+// Package repositorytest holds the behavioural contract every
+// repository.PostRepository backend must satisfy. Backends call Run from
+// their own tests with a factory that returns a fresh, empty repository.
+package repositorytest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/BhaveetKumar/gRPC-server-go/internal/domain"
+	apperrors "github.com/BhaveetKumar/gRPC-server-go/internal/errors"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/repository"
+)
+
+type Factory func(t *testing.T) repository.PostRepository
+
+func Run(t *testing.T, newRepo Factory) {
+	tests := []struct {
+		name string
+		fn   func(t *testing.T, repo repository.PostRepository)
+	}{
+		{"CreateAndGet", testCreateAndGet},
+		{"CreateInvalid", testCreateInvalid},
+		{"CreateDuplicate", testCreateDuplicate},
+		{"GetInvalidAndNotFound", testGetInvalidAndNotFound},
+		{"Update", testUpdate},
+		{"UpdateInvalidAndNotFound", testUpdateInvalidAndNotFound},
+		{"UpdateVersionConflict", testUpdateVersionConflict},
+		{"UpdateAll", testUpdateAll},
+		{"UpdateAllIsAtomic", testUpdateAllIsAtomic},
+		{"Delete", testDelete},
+		{"DeleteInvalidAndNotFound", testDeleteInvalidAndNotFound},
+		{"DeleteVersionConflict", testDeleteVersionConflict},
+		{"List", testList},
+		{"DeletedAtRoundTrip", testDeletedAtRoundTrip},
+		{"ModerationRoundTrip", testModerationRoundTrip},
+		{"CopyIsolation", testCopyIsolation},
+		{"ConcurrentAccess", testConcurrentAccess},
+		{"ConcurrentCompareAndSwap", testConcurrentCompareAndSwap},
+		{"CanceledContext", testCanceledContext},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.fn(t, newRepo(t))
+		})
+	}
+}
+
+func newPost(id string) *domain.Post {
+	return &domain.Post{
+		ID:              id,
+		Title:           "title " + id,
+		Content:         "content " + id,
+		Author:          "author",
+		AuthorID:        "author-1",
+		Slug:            "title-" + id,
+		PublicationDate: "2026-01-01",
+		Tags:            []string{"go", "grpc"},
+		Status:          domain.StatusDraft,
+	}
+}
+
+func mustCreate(t *testing.T, repo repository.PostRepository, post *domain.Post) {
+	t.Helper()
+
+	ctx := context.Background()
+	if err := repo.Create(ctx, post); err != nil {
+		t.Fatalf("create %s failed: %v", post.ID, err)
+	}
+}
+
+func mustGet(t *testing.T, repo repository.PostRepository, id string) *domain.Post {
+	t.Helper()
+
+	ctx := context.Background()
+	post, err := repo.GetByID(ctx, id)
+	if err != nil {
+		t.Fatalf("get %s failed: %v", id, err)
+	}
+	return post
+}
+
+func assertTags(t *testing.T, got, want []string) {
+	t.Helper()
+
+	if len(got) != len(want) {
+		t.Fatalf("unexpected tags: got %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("unexpected tags: got %v, want %v", got, want)
+		}
+	}
+}
+
+func testCreateAndGet(t *testing.T, repo repository.PostRepository) {
+	post := newPost("id1")
+	mustCreate(t, repo, post)
+
+	if post.Version != 1 {
+		t.Fatalf("expected create to set version 1, got %d", post.Version)
+	}
+
+	loaded := mustGet(t, repo, "id1")
+	if loaded.ID != post.ID || loaded.Title != post.Title || loaded.Content != post.Content ||
+		loaded.Author != post.Author || loaded.AuthorID != post.AuthorID || loaded.Slug != post.Slug || loaded.PublicationDate != post.PublicationDate || loaded.Status != post.Status || loaded.Version != 1 {
+		t.Fatalf("unexpected post: got %+v, want %+v", loaded, post)
+	}
+	assertTags(t, loaded.Tags, post.Tags)
+}
+
+func testCreateInvalid(t *testing.T, repo repository.PostRepository) {
+	ctx := context.Background()
+
+	if err := repo.Create(ctx, nil); err != apperrors.ErrInvalidInput {
+		t.Fatalf("expected invalid input for nil post, got %v", err)
+	}
+}
+
+func testCreateDuplicate(t *testing.T, repo repository.PostRepository) {
+	ctx := context.Background()
+
+	mustCreate(t, repo, newPost("id1"))
+
+	if err := repo.Create(ctx, newPost("id1")); err != apperrors.ErrDuplicatePost {
+		t.Fatalf("expected duplicate error, got %v", err)
+	}
+}
+
+func testGetInvalidAndNotFound(t *testing.T, repo repository.PostRepository) {
+	ctx := context.Background()
+
+	if _, err := repo.GetByID(ctx, ""); err != apperrors.ErrInvalidInput {
+		t.Fatalf("expected invalid input for empty id, got %v", err)
+	}
+	if _, err := repo.GetByID(ctx, "missing"); err != apperrors.ErrPostNotFound {
+		t.Fatalf("expected not found, got %v", err)
+	}
+}
+
+func testUpdate(t *testing.T, repo repository.PostRepository) {
+	ctx := context.Background()
+
+	mustCreate(t, repo, newPost("id1"))
+
+	updated := &domain.Post{ID: "id1", Title: "new title", Content: "new content", Author: "someone", PublicationDate: "2026-02-02", Tags: []string{"x"}, Status: domain.StatusPublished}
+	if err := repo.Update(ctx, updated, 1); err != nil {
+		t.Fatalf("update failed: %v", err)
+	}
+	if updated.Version != 2 {
+		t.Fatalf("expected update to set version 2, got %d", updated.Version)
+	}
+
+	loaded := mustGet(t, repo, "id1")
+	if loaded.Title != "new title" || loaded.Content != "new content" || loaded.Author != "someone" ||
+		loaded.PublicationDate != "2026-02-02" || loaded.Status != domain.StatusPublished || loaded.Version != 2 {
+		t.Fatalf("update not applied: %+v", loaded)
+	}
+	assertTags(t, loaded.Tags, []string{"x"})
+}
+
+func testUpdateInvalidAndNotFound(t *testing.T, repo repository.PostRepository) {
+	ctx := context.Background()
+
+	if err := repo.Update(ctx, nil, 1); err != apperrors.ErrInvalidInput {
+		t.Fatalf("expected invalid input for nil post, got %v", err)
+	}
+	if err := repo.Update(ctx, &domain.Post{Title: "title"}, 1); err != apperrors.ErrInvalidInput {
+		t.Fatalf("expected invalid input for empty id, got %v", err)
+	}
+	if err := repo.Update(ctx, newPost("missing"), 1); err != apperrors.ErrPostNotFound {
+		t.Fatalf("expected not found, got %v", err)
+	}
+}
+
+func testUpdateVersionConflict(t *testing.T, repo repository.PostRepository) {
+	ctx := context.Background()
+
+	mustCreate(t, repo, newPost("id1"))
+
+	stale := newPost("id1")
+	stale.Title = "stale"
+	if err := repo.Update(ctx, stale, 2); err != apperrors.ErrVersionConflict {
+		t.Fatalf("expected version conflict, got %v", err)
+	}
+
+	loaded := mustGet(t, repo, "id1")
+	if loaded.Title == "stale" || loaded.Version != 1 {
+		t.Fatalf("conflicting update must not be applied: %+v", loaded)
+	}
+}
+
+func testUpdateAll(t *testing.T, repo repository.PostRepository) {
+	ctx := context.Background()
+
+	mustCreate(t, repo, newPost("id1"))
+	mustCreate(t, repo, newPost("id2"))
+
+	first, second := mustGet(t, repo, "id1"), mustGet(t, repo, "id2")
+	first.Tags = []string{"a"}
+	second.Tags = nil
+	if err := repo.UpdateAll(ctx, []*domain.Post{first, second}); err != nil {
+		t.Fatalf("update all failed: %v", err)
+	}
+	if first.Version != 2 || second.Version != 2 {
+		t.Fatalf("expected update all to set version 2, got %d and %d", first.Version, second.Version)
+	}
+
+	loaded := mustGet(t, repo, "id1")
+	if loaded.Version != 2 {
+		t.Fatalf("expected stored version 2, got %d", loaded.Version)
+	}
+	assertTags(t, loaded.Tags, []string{"a"})
+	assertTags(t, mustGet(t, repo, "id2").Tags, nil)
+
+	if err := repo.UpdateAll(ctx, nil); err != nil {
+		t.Fatalf("expected empty update all to succeed, got %v", err)
+	}
+	if err := repo.UpdateAll(ctx, []*domain.Post{first, first}); err != apperrors.ErrInvalidInput {
+		t.Fatalf("expected invalid input for a repeated post, got %v", err)
+	}
+	if err := repo.UpdateAll(ctx, []*domain.Post{first, nil}); err != apperrors.ErrInvalidInput {
+		t.Fatalf("expected invalid input for a nil post, got %v", err)
+	}
+}
+
+func testUpdateAllIsAtomic(t *testing.T, repo repository.PostRepository) {
+	ctx := context.Background()
+
+	mustCreate(t, repo, newPost("id1"))
+	mustCreate(t, repo, newPost("id2"))
+
+	fresh, stale := mustGet(t, repo, "id1"), mustGet(t, repo, "id2")
+	stale.Version = 5
+	fresh.Title, stale.Title = "changed", "changed"
+	if err := repo.UpdateAll(ctx, []*domain.Post{fresh, stale}); err != apperrors.ErrVersionConflict {
+		t.Fatalf("expected version conflict, got %v", err)
+	}
+
+	missing := newPost("missing")
+	missing.Version = 1
+	if err := repo.UpdateAll(ctx, []*domain.Post{fresh, missing}); err != apperrors.ErrPostNotFound {
+		t.Fatalf("expected not found, got %v", err)
+	}
+
+	for _, id := range []string{"id1", "id2"} {
+		if loaded := mustGet(t, repo, id); loaded.Title == "changed" || loaded.Version != 1 {
+			t.Fatalf("failed update all must not write anything: %+v", loaded)
+		}
+	}
+}
+
+func testDelete(t *testing.T, repo repository.PostRepository) {
+	ctx := context.Background()
+
+	mustCreate(t, repo, newPost("id1"))
+
+	if err := repo.Delete(ctx, "id1", 0); err != nil {
+		t.Fatalf("delete failed: %v", err)
+	}
+	if _, err := repo.GetByID(ctx, "id1"); err != apperrors.ErrPostNotFound {
+		t.Fatalf("expected deleted post to be gone, got %v", err)
+	}
+
+	mustCreate(t, repo, newPost("id1"))
+	if err := repo.Delete(ctx, "id1", 1); err != nil {
+		t.Fatalf("delete with matching version failed: %v", err)
+	}
+}
+
+func testDeleteInvalidAndNotFound(t *testing.T, repo repository.PostRepository) {
+	ctx := context.Background()
+
+	if err := repo.Delete(ctx, "", 0); err != apperrors.ErrInvalidInput {
+		t.Fatalf("expected invalid input for empty id, got %v", err)
+	}
+	if err := repo.Delete(ctx, "missing", 0); err != apperrors.ErrPostNotFound {
+		t.Fatalf("expected not found, got %v", err)
+	}
+}
+
+func testDeleteVersionConflict(t *testing.T, repo repository.PostRepository) {
+	ctx := context.Background()
+
+	mustCreate(t, repo, newPost("id1"))
+
+	if err := repo.Delete(ctx, "id1", 3); err != apperrors.ErrVersionConflict {
+		t.Fatalf("expected version conflict, got %v", err)
+	}
+	mustGet(t, repo, "id1")
+}
+
+func testList(t *testing.T, repo repository.PostRepository) {
+	ctx := context.Background()
+
+	posts, err := repo.List(ctx)
+	if err != nil {
+		t.Fatalf("list failed: %v", err)
+	}
+	if len(posts) != 0 {
+		t.Fatalf("expected empty list, got %d posts", len(posts))
+	}
+
+	for i := 0; i < 3; i++ {
+		mustCreate(t, repo, newPost(fmt.Sprintf("id%d", i)))
+	}
+
+	posts, err = repo.List(ctx)
+	if err != nil {
+		t.Fatalf("list failed: %v", err)
+	}
+	if len(posts) != 3 {
+		t.Fatalf("expected 3 posts, got %d", len(posts))
+	}
+
+	seen := make(map[string]bool)
+	for _, post := range posts {
+		seen[post.ID] = true
+		assertTags(t, post.Tags, []string{"go", "grpc"})
+	}
+	if len(seen) != 3 {
+		t.Fatalf("expected 3 distinct posts, got %v", seen)
+	}
+}
+
+func testDeletedAtRoundTrip(t *testing.T, repo repository.PostRepository) {
+	ctx := context.Background()
+
+	mustCreate(t, repo, newPost("id1"))
+	if loaded := mustGet(t, repo, "id1"); loaded.Deleted() {
+		t.Fatalf("a new post must not be deleted: %+v", loaded)
+	}
+
+	deletedAt := time.Date(2026, 2, 3, 4, 5, 6, 7000, time.UTC)
+	trashed := newPost("id1")
+	trashed.DeletedAt = deletedAt
+	if err := repo.Update(ctx, trashed, 1); err != nil {
+		t.Fatalf("update failed: %v", err)
+	}
+	if loaded := mustGet(t, repo, "id1"); !loaded.DeletedAt.Equal(deletedAt) {
+		t.Fatalf("expected deleted at %v, got %v", deletedAt, loaded.DeletedAt)
+	}
+	listed, _ := repo.List(ctx)
+	if len(listed) != 1 || !listed[0].DeletedAt.Equal(deletedAt) {
+		t.Fatalf("list lost deleted at: %+v", listed)
+	}
+
+	if err := repo.Update(ctx, newPost("id1"), 2); err != nil {
+		t.Fatalf("update failed: %v", err)
+	}
+	if loaded := mustGet(t, repo, "id1"); loaded.Deleted() {
+		t.Fatalf("expected deleted at to be cleared, got %v", loaded.DeletedAt)
+	}
+}
+
+func testModerationRoundTrip(t *testing.T, repo repository.PostRepository) {
+	ctx := context.Background()
+
+	moderation := domain.Moderation{
+		State:     domain.ModerationRejected,
+		Reason:    "spam",
+		Moderator: "mod",
+		DecidedAt: time.Date(2026, 2, 3, 4, 5, 6, 7000, time.UTC),
+	}
+	post := newPost("id1")
+	post.Moderation = moderation
+	mustCreate(t, repo, post)
+	if loaded := mustGet(t, repo, "id1"); loaded.Moderation != moderation {
+		t.Fatalf("expected moderation %+v, got %+v", moderation, loaded.Moderation)
+	}
+
+	moderation.State, moderation.Reason = domain.ModerationApproved, ""
+	updated := newPost("id1")
+	updated.Moderation = moderation
+	if err := repo.Update(ctx, updated, 1); err != nil {
+		t.Fatalf("update failed: %v", err)
+	}
+	listed, _ := repo.List(ctx)
+	if len(listed) != 1 || listed[0].Moderation != moderation {
+		t.Fatalf("list lost moderation: %+v", listed)
+	}
+}
+
+// testCopyIsolation checks that callers never share memory with the stored
+// post: not through the value passed to Create or Update, and not through the
+// values returned by GetByID or List.
+func testCopyIsolation(t *testing.T, repo repository.PostRepository) {
+	ctx := context.Background()
+
+	post := newPost("id1")
+	mustCreate(t, repo, post)
+	post.Title = "mutated"
+	post.Tags[0] = "mutated"
+
+	loaded := mustGet(t, repo, "id1")
+	if loaded.Title != "title id1" {
+		t.Fatalf("mutating the created post leaked into storage: %+v", loaded)
+	}
+	assertTags(t, loaded.Tags, []string{"go", "grpc"})
+
+	loaded.Title = "mutated"
+	loaded.Tags[0] = "mutated"
+
+	listed, _ := repo.List(ctx)
+	listed[0].Tags[1] = "mutated"
+
+	again := mustGet(t, repo, "id1")
+	if again.Title != "title id1" {
+		t.Fatalf("mutating a loaded post leaked into storage: %+v", again)
+	}
+	assertTags(t, again.Tags, []string{"go", "grpc"})
+
+	update := newPost("id1")
+	if err := repo.Update(ctx, update, 1); err != nil {
+		t.Fatalf("update failed: %v", err)
+	}
+	update.Tags[0] = "mutated"
+
+	assertTags(t, mustGet(t, repo, "id1").Tags, []string{"go", "grpc"})
+}
+
+func testConcurrentAccess(t *testing.T, repo repository.PostRepository) {
+	ctx := context.Background()
+
+	const workers = 50
+	var wg sync.WaitGroup
+
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			id := fmt.Sprintf("id-%d", i)
+			if err := repo.Create(ctx, newPost(id)); err != nil {
+				t.Errorf("create %s failed: %v", id, err)
+				return
+			}
+			if _, err := repo.GetByID(ctx, id); err != nil {
+				t.Errorf("get %s failed: %v", id, err)
+			}
+			if _, err := repo.List(ctx); err != nil {
+				t.Errorf("list failed: %v", err)
+			}
+		}(i)
+	}
+
+	wg.Wait()
+
+	posts, err := repo.List(ctx)
+	if err != nil {
+		t.Fatalf("list failed: %v", err)
+	}
+	if len(posts) != workers {
+		t.Fatalf("expected %d posts after concurrent creates, got %d", workers, len(posts))
+	}
+}
+
+func testConcurrentCompareAndSwap(t *testing.T, repo repository.PostRepository) {
+	ctx := context.Background()
+
+	mustCreate(t, repo, newPost("id1"))
+
+	const workers = 20
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	succeeded := 0
+
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			post := newPost("id1")
+			post.Title = fmt.Sprintf("writer %d", i)
+			err := repo.Update(ctx, post, 1)
+			switch err {
+			case nil:
+				mu.Lock()
+				succeeded++
+				mu.Unlock()
+			case apperrors.ErrVersionConflict:
+			default:
+				t.Errorf("unexpected update error: %v", err)
+			}
+		}(i)
+	}
+
+	wg.Wait()
+
+	if succeeded != 1 {
+		t.Fatalf("expected exactly one writer to win, got %d", succeeded)
+	}
+	if loaded := mustGet(t, repo, "id1"); loaded.Version != 2 {
+		t.Fatalf("expected version 2 after one successful swap, got %d", loaded.Version)
+	}
+}
+
+func testCanceledContext(t *testing.T, repo repository.PostRepository) {
+	mustCreate(t, repo, newPost("id1"))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := repo.Create(ctx, newPost("id2")); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected create to honour cancellation, got %v", err)
+	}
+	if _, err := repo.GetByID(ctx, "id1"); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected get to honour cancellation, got %v", err)
+	}
+	if err := repo.Update(ctx, newPost("id1"), 1); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected update to honour cancellation, got %v", err)
+	}
+	if err := repo.Delete(ctx, "id1", 0); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected delete to honour cancellation, got %v", err)
+	}
+	if _, err := repo.List(ctx); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected list to honour cancellation, got %v", err)
+	}
+
+	if _, err := repo.GetByID(context.Background(), "id2"); err != apperrors.ErrPostNotFound {
+		t.Fatalf("canceled create must not store the post, got %v", err)
+	}
+	if loaded := mustGet(t, repo, "id1"); loaded.Version != 1 {
+		t.Fatalf("canceled update must not be applied: %+v", loaded)
+	}
+}
@@ -0,0 +1,174 @@
+package sqldb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/BhaveetKumar/gRPC-server-go/internal/domain"
+	apperrors "github.com/BhaveetKumar/gRPC-server-go/internal/errors"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/repository"
+)
+
+// APIKeyRepository stores API keys in the api_keys table.
+type APIKeyRepository struct {
+	db     *sql.DB
+	driver string
+}
+
+var _ repository.APIKeyRepository = (*APIKeyRepository)(nil)
+
+func NewAPIKeyRepository(db *sql.DB, driver string) *APIKeyRepository {
+	return &APIKeyRepository{db: db, driver: driver}
+}
+
+const apiKeyColumns = `id, name, owner, scopes, secret_hash, created_at, expires_at, last_used_at, revoked_at`
+
+func (r *APIKeyRepository) q(query string) string {
+	return rebind(r.driver, query)
+}
+
+func (r *APIKeyRepository) CreateAPIKey(ctx context.Context, key *domain.APIKey) error {
+	if err := key.Validate(); err != nil {
+		return err
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var count int
+	if err := tx.QueryRowContext(ctx, r.q(`SELECT COUNT(*) FROM api_keys WHERE id = ?`), key.ID).Scan(&count); err != nil {
+		return fmt.Errorf("check api key: %w", err)
+	}
+	if count > 0 {
+		return apperrors.ErrDuplicateAPIKey
+	}
+
+	_, err = tx.ExecContext(ctx, r.q(`INSERT INTO api_keys (`+apiKeyColumns+`) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`),
+		key.ID, key.Name, key.Owner, strings.Join(key.Scopes, ","), key.SecretHash,
+		formatTime(key.CreatedAt), formatTime(key.ExpiresAt), formatTime(key.LastUsedAt), formatTime(key.RevokedAt))
+	if err != nil {
+		return fmt.Errorf("insert api key: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+	return nil
+}
+
+func (r *APIKeyRepository) GetAPIKey(ctx context.Context, id string) (*domain.APIKey, error) {
+	if id == "" {
+		return nil, apperrors.ErrInvalidInput
+	}
+
+	key, err := scanAPIKey(r.db.QueryRowContext(ctx, r.q(`SELECT `+apiKeyColumns+` FROM api_keys WHERE id = ?`), id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, apperrors.ErrAPIKeyNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("select api key: %w", err)
+	}
+	return key, nil
+}
+
+func (r *APIKeyRepository) ListAPIKeys(ctx context.Context) ([]*domain.APIKey, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT `+apiKeyColumns+` FROM api_keys`)
+	if err != nil {
+		return nil, fmt.Errorf("list api keys: %w", err)
+	}
+	defer rows.Close()
+
+	result := make([]*domain.APIKey, 0)
+	for rows.Next() {
+		key, err := scanAPIKey(rows)
+		if err != nil {
+			return nil, fmt.Errorf("list api keys: %w", err)
+		}
+		result = append(result, key)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("list api keys: %w", err)
+	}
+
+	// Sorted here rather than in SQL because the stored timestamps drop
+	// trailing zeros and so do not compare as text.
+	sort.Slice(result, func(i, j int) bool {
+		if !result[i].CreatedAt.Equal(result[j].CreatedAt) {
+			return result[i].CreatedAt.Before(result[j].CreatedAt)
+		}
+		return result[i].ID < result[j].ID
+	})
+
+	return result, nil
+}
+
+func (r *APIKeyRepository) RevokeAPIKey(ctx context.Context, id string, at time.Time) error {
+	if id == "" {
+		return apperrors.ErrInvalidInput
+	}
+
+	res, err := r.db.ExecContext(ctx, r.q(`UPDATE api_keys SET revoked_at = ? WHERE id = ? AND revoked_at = ''`), formatTime(at), id)
+	if err != nil {
+		return fmt.Errorf("revoke api key: %w", err)
+	}
+	if n, err := res.RowsAffected(); err == nil && n > 0 {
+		return nil
+	}
+
+	// Nothing changed: either the key is already revoked or it is unknown.
+	_, err = r.GetAPIKey(ctx, id)
+	return err
+}
+
+func (r *APIKeyRepository) TouchAPIKey(ctx context.Context, id string, at time.Time) error {
+	if id == "" {
+		return apperrors.ErrInvalidInput
+	}
+
+	res, err := r.db.ExecContext(ctx, r.q(`UPDATE api_keys SET last_used_at = ? WHERE id = ?`), formatTime(at), id)
+	if err != nil {
+		return fmt.Errorf("touch api key: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("touch api key: %w", err)
+	}
+	if n == 0 {
+		return apperrors.ErrAPIKeyNotFound
+	}
+	return nil
+}
+
+func scanAPIKey(row rowScanner) (*domain.APIKey, error) {
+	key := &domain.APIKey{}
+	var scopes, createdAt, expiresAt, lastUsedAt, revokedAt string
+	if err := row.Scan(&key.ID, &key.Name, &key.Owner, &scopes, &key.SecretHash, &createdAt, &expiresAt, &lastUsedAt, &revokedAt); err != nil {
+		return nil, err
+	}
+	key.Scopes = strings.Split(scopes, ",")
+
+	var err error
+	for _, field := range []struct {
+		name string
+		raw  string
+		dst  *time.Time
+	}{
+		{"created_at", createdAt, &key.CreatedAt},
+		{"expires_at", expiresAt, &key.ExpiresAt},
+		{"last_used_at", lastUsedAt, &key.LastUsedAt},
+		{"revoked_at", revokedAt, &key.RevokedAt},
+	} {
+		if *field.dst, err = parseTime(field.raw); err != nil {
+			return nil, fmt.Errorf("decode %s: %w", field.name, err)
+		}
+	}
+	return key, nil
+}
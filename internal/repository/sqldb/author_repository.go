@@ -0,0 +1,205 @@
+package sqldb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/BhaveetKumar/gRPC-server-go/internal/domain"
+	apperrors "github.com/BhaveetKumar/gRPC-server-go/internal/errors"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/repository"
+)
+
+// AuthorRepository stores author profiles in the authors table. The unique
+// name_key column holds each author's normalized name.
+type AuthorRepository struct {
+	db     *sql.DB
+	driver string
+}
+
+var _ repository.AuthorRepository = (*AuthorRepository)(nil)
+
+func NewAuthorRepository(db *sql.DB, driver string) *AuthorRepository {
+	return &AuthorRepository{db: db, driver: driver}
+}
+
+const authorColumns = `id, name, bio, avatar_url, created_at, updated_at, version`
+
+func (r *AuthorRepository) q(query string) string {
+	return rebind(r.driver, query)
+}
+
+func (r *AuthorRepository) CreateAuthor(ctx context.Context, author *domain.Author) error {
+	if author == nil || author.ID == "" {
+		return apperrors.ErrInvalidInput
+	}
+
+	return r.inTx(ctx, func(tx *sql.Tx) error {
+		var count int
+		if err := tx.QueryRowContext(ctx, r.q(`SELECT COUNT(*) FROM authors WHERE id = ? OR name_key = ?`),
+			author.ID, domain.NormalizeAuthorName(author.Name)).Scan(&count); err != nil {
+			return fmt.Errorf("check author: %w", err)
+		}
+		if count > 0 {
+			return apperrors.ErrDuplicateAuthor
+		}
+
+		_, err := tx.ExecContext(ctx, r.q(`INSERT INTO authors (id, name, name_key, bio, avatar_url, created_at, updated_at, version) VALUES (?, ?, ?, ?, ?, ?, ?, 1)`),
+			author.ID, author.Name, domain.NormalizeAuthorName(author.Name), author.Bio, author.AvatarURL,
+			formatTime(author.CreatedAt), formatTime(author.UpdatedAt))
+		if err != nil {
+			return fmt.Errorf("insert author: %w", err)
+		}
+
+		author.Version = 1
+		return nil
+	})
+}
+
+func (r *AuthorRepository) GetAuthor(ctx context.Context, id string) (*domain.Author, error) {
+	if id == "" {
+		return nil, apperrors.ErrInvalidInput
+	}
+
+	return r.getWhere(ctx, `id = ?`, id)
+}
+
+func (r *AuthorRepository) GetAuthorByName(ctx context.Context, name string) (*domain.Author, error) {
+	key := domain.NormalizeAuthorName(name)
+	if key == "" {
+		return nil, apperrors.ErrInvalidInput
+	}
+
+	return r.getWhere(ctx, `name_key = ?`, key)
+}
+
+func (r *AuthorRepository) getWhere(ctx context.Context, cond string, arg any) (*domain.Author, error) {
+	author, err := scanAuthor(r.db.QueryRowContext(ctx, r.q(`SELECT `+authorColumns+` FROM authors WHERE `+cond), arg))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, apperrors.ErrAuthorNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("select author: %w", err)
+	}
+	return author, nil
+}
+
+func (r *AuthorRepository) UpdateAuthor(ctx context.Context, author *domain.Author, expectedVersion int64) error {
+	if author == nil || author.ID == "" {
+		return apperrors.ErrInvalidInput
+	}
+
+	return r.inTx(ctx, func(tx *sql.Tx) error {
+		var version int64
+		err := tx.QueryRowContext(ctx, r.q(`SELECT version FROM authors WHERE id = ?`), author.ID).Scan(&version)
+		if errors.Is(err, sql.ErrNoRows) {
+			return apperrors.ErrAuthorNotFound
+		}
+		if err != nil {
+			return fmt.Errorf("check author: %w", err)
+		}
+		if version != expectedVersion {
+			return apperrors.ErrVersionConflict
+		}
+
+		key := domain.NormalizeAuthorName(author.Name)
+		var taken int
+		if err := tx.QueryRowContext(ctx, r.q(`SELECT COUNT(*) FROM authors WHERE name_key = ? AND id <> ?`), key, author.ID).Scan(&taken); err != nil {
+			return fmt.Errorf("check author name: %w", err)
+		}
+		if taken > 0 {
+			return apperrors.ErrDuplicateAuthor
+		}
+
+		_, err = tx.ExecContext(ctx, r.q(`UPDATE authors SET name = ?, name_key = ?, bio = ?, avatar_url = ?, created_at = ?, updated_at = ?, version = version + 1 WHERE id = ?`),
+			author.Name, key, author.Bio, author.AvatarURL, formatTime(author.CreatedAt), formatTime(author.UpdatedAt), author.ID)
+		if err != nil {
+			return fmt.Errorf("update author: %w", err)
+		}
+
+		author.Version = expectedVersion + 1
+		return nil
+	})
+}
+
+func (r *AuthorRepository) DeleteAuthor(ctx context.Context, id string, expectedVersion int64) error {
+	if id == "" {
+		return apperrors.ErrInvalidInput
+	}
+
+	return r.inTx(ctx, func(tx *sql.Tx) error {
+		var version int64
+		err := tx.QueryRowContext(ctx, r.q(`SELECT version FROM authors WHERE id = ?`), id).Scan(&version)
+		if errors.Is(err, sql.ErrNoRows) {
+			return apperrors.ErrAuthorNotFound
+		}
+		if err != nil {
+			return fmt.Errorf("check author: %w", err)
+		}
+		if expectedVersion != 0 && version != expectedVersion {
+			return apperrors.ErrVersionConflict
+		}
+
+		if _, err := tx.ExecContext(ctx, r.q(`DELETE FROM authors WHERE id = ?`), id); err != nil {
+			return fmt.Errorf("delete author: %w", err)
+		}
+		return nil
+	})
+}
+
+func (r *AuthorRepository) ListAuthors(ctx context.Context) ([]*domain.Author, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT `+authorColumns+` FROM authors`)
+	if err != nil {
+		return nil, fmt.Errorf("list authors: %w", err)
+	}
+	defer rows.Close()
+
+	result := make([]*domain.Author, 0)
+	for rows.Next() {
+		author, err := scanAuthor(rows)
+		if err != nil {
+			return nil, fmt.Errorf("list authors: %w", err)
+		}
+		result = append(result, author)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("list authors: %w", err)
+	}
+
+	return result, nil
+}
+
+func scanAuthor(row rowScanner) (*domain.Author, error) {
+	author := &domain.Author{}
+	var createdAt, updatedAt string
+	if err := row.Scan(&author.ID, &author.Name, &author.Bio, &author.AvatarURL, &createdAt, &updatedAt, &author.Version); err != nil {
+		return nil, err
+	}
+
+	var err error
+	if author.CreatedAt, err = parseTime(createdAt); err != nil {
+		return nil, fmt.Errorf("decode created_at: %w", err)
+	}
+	if author.UpdatedAt, err = parseTime(updatedAt); err != nil {
+		return nil, fmt.Errorf("decode updated_at: %w", err)
+	}
+	return author, nil
+}
+
+func (r *AuthorRepository) inTx(ctx context.Context, fn func(tx *sql.Tx) error) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+
+	if err := fn(tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,215 @@
+package sqldb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/BhaveetKumar/gRPC-server-go/internal/domain"
+	apperrors "github.com/BhaveetKumar/gRPC-server-go/internal/errors"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/repository"
+)
+
+// CommentRepository stores post comments in the comments table.
+type CommentRepository struct {
+	db     *sql.DB
+	driver string
+}
+
+var _ repository.CommentRepository = (*CommentRepository)(nil)
+
+func NewCommentRepository(db *sql.DB, driver string) *CommentRepository {
+	return &CommentRepository{db: db, driver: driver}
+}
+
+const commentColumns = `id, post_id, parent_id, author, content, created_at, updated_at, version, deleted`
+
+func (r *CommentRepository) q(query string) string {
+	return rebind(r.driver, query)
+}
+
+func (r *CommentRepository) CreateComment(ctx context.Context, comment *domain.Comment) error {
+	if comment == nil || comment.ID == "" || comment.PostID == "" {
+		return apperrors.ErrInvalidInput
+	}
+
+	return r.inTx(ctx, func(tx *sql.Tx) error {
+		var count int
+		if err := tx.QueryRowContext(ctx, r.q(`SELECT COUNT(*) FROM comments WHERE id = ?`), comment.ID).Scan(&count); err != nil {
+			return fmt.Errorf("check comment: %w", err)
+		}
+		if count > 0 {
+			return apperrors.ErrDuplicateComment
+		}
+
+		_, err := tx.ExecContext(ctx, r.q(`INSERT INTO comments (`+commentColumns+`) VALUES (?, ?, ?, ?, ?, ?, ?, 1, ?)`),
+			comment.ID, comment.PostID, comment.ParentID, comment.Author, comment.Content,
+			formatTime(comment.CreatedAt), formatTime(comment.UpdatedAt), boolInt(comment.Deleted))
+		if err != nil {
+			return fmt.Errorf("insert comment: %w", err)
+		}
+
+		comment.Version = 1
+		return nil
+	})
+}
+
+func (r *CommentRepository) GetComment(ctx context.Context, id string) (*domain.Comment, error) {
+	if id == "" {
+		return nil, apperrors.ErrInvalidInput
+	}
+
+	comment, err := scanComment(r.db.QueryRowContext(ctx, r.q(`SELECT `+commentColumns+` FROM comments WHERE id = ?`), id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, apperrors.ErrCommentNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("select comment: %w", err)
+	}
+	return comment, nil
+}
+
+func (r *CommentRepository) UpdateComment(ctx context.Context, comment *domain.Comment, expectedVersion int64) error {
+	if comment == nil || comment.ID == "" {
+		return apperrors.ErrInvalidInput
+	}
+
+	return r.inTx(ctx, func(tx *sql.Tx) error {
+		var postID, parentID string
+		var version int64
+		err := tx.QueryRowContext(ctx, r.q(`SELECT post_id, parent_id, version FROM comments WHERE id = ?`), comment.ID).
+			Scan(&postID, &parentID, &version)
+		if errors.Is(err, sql.ErrNoRows) {
+			return apperrors.ErrCommentNotFound
+		}
+		if err != nil {
+			return fmt.Errorf("check comment: %w", err)
+		}
+		if version != expectedVersion {
+			return apperrors.ErrVersionConflict
+		}
+
+		// A comment cannot move to another post or thread, so post_id and
+		// parent_id are left as they are.
+		_, err = tx.ExecContext(ctx, r.q(`UPDATE comments SET author = ?, content = ?, created_at = ?, updated_at = ?, deleted = ?, version = version + 1 WHERE id = ?`),
+			comment.Author, comment.Content, formatTime(comment.CreatedAt), formatTime(comment.UpdatedAt), boolInt(comment.Deleted), comment.ID)
+		if err != nil {
+			return fmt.Errorf("update comment: %w", err)
+		}
+
+		comment.PostID, comment.ParentID = postID, parentID
+		comment.Version = expectedVersion + 1
+		return nil
+	})
+}
+
+func (r *CommentRepository) DeleteComment(ctx context.Context, id string) error {
+	if id == "" {
+		return apperrors.ErrInvalidInput
+	}
+
+	res, err := r.db.ExecContext(ctx, r.q(`DELETE FROM comments WHERE id = ?`), id)
+	if err != nil {
+		return fmt.Errorf("delete comment: %w", err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("delete comment: %w", err)
+	}
+	if n == 0 {
+		return apperrors.ErrCommentNotFound
+	}
+	return nil
+}
+
+func (r *CommentRepository) ListComments(ctx context.Context, postID string) ([]*domain.Comment, error) {
+	if postID == "" {
+		return nil, apperrors.ErrInvalidInput
+	}
+
+	rows, err := r.db.QueryContext(ctx, r.q(`SELECT `+commentColumns+` FROM comments WHERE post_id = ?`), postID)
+	if err != nil {
+		return nil, fmt.Errorf("list comments: %w", err)
+	}
+	defer rows.Close()
+
+	result := make([]*domain.Comment, 0)
+	for rows.Next() {
+		comment, err := scanComment(rows)
+		if err != nil {
+			return nil, fmt.Errorf("list comments: %w", err)
+		}
+		result = append(result, comment)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("list comments: %w", err)
+	}
+
+	// Sorted here rather than in SQL because the stored timestamps drop
+	// trailing zeros and so do not compare as text.
+	sort.Slice(result, func(i, j int) bool {
+		if !result[i].CreatedAt.Equal(result[j].CreatedAt) {
+			return result[i].CreatedAt.Before(result[j].CreatedAt)
+		}
+		return result[i].ID < result[j].ID
+	})
+
+	return result, nil
+}
+
+func (r *CommentRepository) DeleteComments(ctx context.Context, postID string) error {
+	if postID == "" {
+		return apperrors.ErrInvalidInput
+	}
+
+	if _, err := r.db.ExecContext(ctx, r.q(`DELETE FROM comments WHERE post_id = ?`), postID); err != nil {
+		return fmt.Errorf("delete comments: %w", err)
+	}
+	return nil
+}
+
+func scanComment(row rowScanner) (*domain.Comment, error) {
+	comment := &domain.Comment{}
+	var createdAt, updatedAt string
+	var deleted int
+	if err := row.Scan(&comment.ID, &comment.PostID, &comment.ParentID, &comment.Author, &comment.Content,
+		&createdAt, &updatedAt, &comment.Version, &deleted); err != nil {
+		return nil, err
+	}
+	comment.Deleted = deleted != 0
+
+	var err error
+	if comment.CreatedAt, err = parseTime(createdAt); err != nil {
+		return nil, fmt.Errorf("decode created_at: %w", err)
+	}
+	if comment.UpdatedAt, err = parseTime(updatedAt); err != nil {
+		return nil, fmt.Errorf("decode updated_at: %w", err)
+	}
+	return comment, nil
+}
+
+func boolInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+func (r *CommentRepository) inTx(ctx context.Context, fn func(tx *sql.Tx) error) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+
+	if err := fn(tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+	return nil
+}
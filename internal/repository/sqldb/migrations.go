@@ -0,0 +1,237 @@
+package sqldb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+type migration struct {
+	version    int
+	name       string
+	statements []string
+}
+
+// migrations are applied in order and must never be edited once released;
+// schema changes go in a new entry with the next version number.
+var migrations = []migration{
+	{
+		version: 1,
+		name:    "create posts and post_tags",
+		statements: []string{
+			`CREATE TABLE posts (
+				id TEXT PRIMARY KEY,
+				title TEXT NOT NULL,
+				content TEXT NOT NULL,
+				author TEXT NOT NULL,
+				publication_date TEXT NOT NULL,
+				version BIGINT NOT NULL
+			)`,
+			`CREATE TABLE post_tags (
+				post_id TEXT NOT NULL REFERENCES posts(id),
+				position INTEGER NOT NULL,
+				tag TEXT NOT NULL,
+				PRIMARY KEY (post_id, position)
+			)`,
+			`CREATE INDEX post_tags_tag_idx ON post_tags (tag)`,
+			`CREATE INDEX posts_author_idx ON posts (author)`,
+		},
+	},
+	{
+		version: 2,
+		name:    "add posts.status",
+		statements: []string{
+			// Posts created before statuses existed were visible to everyone.
+			`ALTER TABLE posts ADD COLUMN status TEXT NOT NULL DEFAULT 'published'`,
+			`CREATE INDEX posts_status_idx ON posts (status)`,
+		},
+	},
+	{
+		version: 3,
+		name:    "create post_revisions",
+		statements: []string{
+			// Revisions are removed by the service when their post is
+			// deleted, so there is no foreign key to posts.
+			`CREATE TABLE post_revisions (
+				post_id TEXT NOT NULL,
+				version BIGINT NOT NULL,
+				title TEXT NOT NULL,
+				content TEXT NOT NULL,
+				author TEXT NOT NULL,
+				publication_date TEXT NOT NULL,
+				status TEXT NOT NULL,
+				tags TEXT NOT NULL,
+				editor TEXT NOT NULL,
+				created_at TEXT NOT NULL,
+				changed_fields TEXT NOT NULL,
+				PRIMARY KEY (post_id, version)
+			)`,
+		},
+	},
+	{
+		version: 4,
+		name:    "add posts.deleted_at",
+		statements: []string{
+			// Empty while the post is live, an RFC 3339 timestamp once it is
+			// moved to the trash.
+			`ALTER TABLE posts ADD COLUMN deleted_at TEXT NOT NULL DEFAULT ''`,
+		},
+	},
+	{
+		version: 5,
+		name:    "add posts moderation columns",
+		statements: []string{
+			// An empty state marks posts stored before moderation existed,
+			// which count as approved.
+			`ALTER TABLE posts ADD COLUMN moderation_state TEXT NOT NULL DEFAULT ''`,
+			`ALTER TABLE posts ADD COLUMN moderation_reason TEXT NOT NULL DEFAULT ''`,
+			`ALTER TABLE posts ADD COLUMN moderator TEXT NOT NULL DEFAULT ''`,
+			`ALTER TABLE posts ADD COLUMN moderated_at TEXT NOT NULL DEFAULT ''`,
+		},
+	},
+	{
+		version: 6,
+		name:    "create authors and add posts.author_id",
+		statements: []string{
+			// name_key is the normalized name that makes author names
+			// unique ignoring case and spacing.
+			`CREATE TABLE authors (
+				id TEXT PRIMARY KEY,
+				name TEXT NOT NULL,
+				name_key TEXT NOT NULL UNIQUE,
+				bio TEXT NOT NULL,
+				avatar_url TEXT NOT NULL,
+				created_at TEXT NOT NULL,
+				updated_at TEXT NOT NULL,
+				version BIGINT NOT NULL
+			)`,
+			// Existing posts keep an empty author_id until the server links
+			// their bylines to authors at startup.
+			`ALTER TABLE posts ADD COLUMN author_id TEXT NOT NULL DEFAULT ''`,
+			`CREATE INDEX posts_author_id_idx ON posts (author_id)`,
+		},
+	},
+	{
+		version: 7,
+		name:    "create post_slugs and add posts.slug",
+		statements: []string{
+			// post_slugs holds every slug a post has had, so old links keep
+			// resolving; posts.slug is the current one. Existing posts get
+			// their slugs at server startup.
+			`CREATE TABLE post_slugs (
+				slug TEXT PRIMARY KEY,
+				post_id TEXT NOT NULL
+			)`,
+			`CREATE INDEX post_slugs_post_id_idx ON post_slugs (post_id)`,
+			`ALTER TABLE posts ADD COLUMN slug TEXT NOT NULL DEFAULT ''`,
+		},
+	},
+	{
+		version: 8,
+		name:    "create api_keys",
+		statements: []string{
+			// scopes is a comma-separated list; secret_hash is the only
+			// trace of the secret that is kept.
+			`CREATE TABLE api_keys (
+				id TEXT PRIMARY KEY,
+				name TEXT NOT NULL,
+				owner TEXT NOT NULL,
+				scopes TEXT NOT NULL,
+				secret_hash TEXT NOT NULL,
+				created_at TEXT NOT NULL,
+				expires_at TEXT NOT NULL,
+				last_used_at TEXT NOT NULL,
+				revoked_at TEXT NOT NULL
+			)`,
+		},
+	},
+	{
+		version: 9,
+		name:    "create comments",
+		statements: []string{
+			// deleted is 1 for a tombstone kept so its replies stay in
+			// place.
+			`CREATE TABLE comments (
+				id TEXT PRIMARY KEY,
+				post_id TEXT NOT NULL,
+				parent_id TEXT NOT NULL,
+				author TEXT NOT NULL,
+				content TEXT NOT NULL,
+				created_at TEXT NOT NULL,
+				updated_at TEXT NOT NULL,
+				version BIGINT NOT NULL,
+				deleted INTEGER NOT NULL
+			)`,
+			`CREATE INDEX comments_post_id_idx ON comments (post_id)`,
+		},
+	},
+}
+
+// Migrate brings the schema up to the latest version and returns the versions
+// it applied. Each migration runs in its own transaction together with the
+// row recording it in schema_migrations.
+func Migrate(ctx context.Context, db *sql.DB, driver string) ([]int, error) {
+	if _, err := db.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS schema_migrations (
+		version INTEGER PRIMARY KEY,
+		name TEXT NOT NULL,
+		applied_at TEXT NOT NULL
+	)`); err != nil {
+		return nil, fmt.Errorf("create schema_migrations: %w", err)
+	}
+
+	applied := make(map[int]bool)
+	rows, err := db.QueryContext(ctx, `SELECT version FROM schema_migrations`)
+	if err != nil {
+		return nil, fmt.Errorf("read schema_migrations: %w", err)
+	}
+	for rows.Next() {
+		var version int
+		if err := rows.Scan(&version); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("read schema_migrations: %w", err)
+		}
+		applied[version] = true
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("read schema_migrations: %w", err)
+	}
+
+	var ran []int
+	for _, m := range migrations {
+		if applied[m.version] {
+			continue
+		}
+		if err := runMigration(ctx, db, driver, m); err != nil {
+			return ran, err
+		}
+		ran = append(ran, m.version)
+	}
+
+	return ran, nil
+}
+
+func runMigration(ctx context.Context, db *sql.DB, driver string, m migration) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("migration %d: %w", m.version, err)
+	}
+	defer tx.Rollback()
+
+	for _, stmt := range m.statements {
+		if _, err := tx.ExecContext(ctx, stmt); err != nil {
+			return fmt.Errorf("migration %d (%s): %w", m.version, m.name, err)
+		}
+	}
+
+	insert := rebind(driver, `INSERT INTO schema_migrations (version, name, applied_at) VALUES (?, ?, ?)`)
+	if _, err := tx.ExecContext(ctx, insert, m.version, m.name, time.Now().UTC().Format(time.RFC3339)); err != nil {
+		return fmt.Errorf("migration %d: record version: %w", m.version, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("migration %d: %w", m.version, err)
+	}
+	return nil
+}
@@ -0,0 +1,321 @@
+package sqldb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/BhaveetKumar/gRPC-server-go/internal/domain"
+	apperrors "github.com/BhaveetKumar/gRPC-server-go/internal/errors"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/repository"
+)
+
+// PostRepository stores posts in a relational database through database/sql.
+// Queries are written with ? placeholders and rewritten for drivers that use
+// numbered ones.
+type PostRepository struct {
+	db     *sql.DB
+	driver string
+}
+
+var _ repository.PostRepository = (*PostRepository)(nil)
+
+func NewPostRepository(db *sql.DB, driver string) *PostRepository {
+	return &PostRepository{db: db, driver: driver}
+}
+
+func rebind(driver, query string) string {
+	switch driver {
+	case "postgres", "pgx":
+	default:
+		return query
+	}
+
+	var b strings.Builder
+	n := 0
+	for _, r := range query {
+		if r == '?' {
+			n++
+			b.WriteString("$" + strconv.Itoa(n))
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+func (r *PostRepository) q(query string) string {
+	return rebind(r.driver, query)
+}
+
+func (r *PostRepository) Create(ctx context.Context, post *domain.Post) error {
+	if post == nil {
+		return apperrors.ErrInvalidInput
+	}
+
+	return r.inTx(ctx, func(tx *sql.Tx) error {
+		exists, err := r.exists(ctx, tx, post.ID)
+		if err != nil {
+			return err
+		}
+		if exists {
+			return apperrors.ErrDuplicatePost
+		}
+
+		_, err = tx.ExecContext(ctx, r.q(`INSERT INTO posts (id, title, content, author, author_id, slug, publication_date, status, deleted_at, moderation_state, moderation_reason, moderator, moderated_at, version) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, 1)`),
+			post.ID, post.Title, post.Content, post.Author, post.AuthorID, post.Slug, post.PublicationDate, post.Status, formatTime(post.DeletedAt),
+			post.Moderation.State, post.Moderation.Reason, post.Moderation.Moderator, formatTime(post.Moderation.DecidedAt))
+		if err != nil {
+			return fmt.Errorf("insert post: %w", err)
+		}
+
+		if err := r.insertTags(ctx, tx, post.ID, post.Tags); err != nil {
+			return err
+		}
+
+		post.Version = 1
+		return nil
+	})
+}
+
+func (r *PostRepository) GetByID(ctx context.Context, id string) (*domain.Post, error) {
+	if id == "" {
+		return nil, apperrors.ErrInvalidInput
+	}
+
+	post, err := scanPost(r.db.QueryRowContext(ctx, r.q(`SELECT `+postColumns+` FROM posts WHERE id = ?`), id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, apperrors.ErrPostNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("select post: %w", err)
+	}
+
+	rows, err := r.db.QueryContext(ctx, r.q(`SELECT tag FROM post_tags WHERE post_id = ? ORDER BY position`), id)
+	if err != nil {
+		return nil, fmt.Errorf("select tags: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var tag string
+		if err := rows.Scan(&tag); err != nil {
+			return nil, fmt.Errorf("select tags: %w", err)
+		}
+		post.Tags = append(post.Tags, tag)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("select tags: %w", err)
+	}
+
+	return post, nil
+}
+
+func (r *PostRepository) Update(ctx context.Context, post *domain.Post, expectedVersion int64) error {
+	if post == nil || post.ID == "" {
+		return apperrors.ErrInvalidInput
+	}
+
+	return r.inTx(ctx, func(tx *sql.Tx) error {
+		if err := r.update(ctx, tx, post, expectedVersion); err != nil {
+			return err
+		}
+		post.Version = expectedVersion + 1
+		return nil
+	})
+}
+
+func (r *PostRepository) UpdateAll(ctx context.Context, posts []*domain.Post) error {
+	if !repository.ValidBatch(posts) {
+		return apperrors.ErrInvalidInput
+	}
+
+	return r.inTx(ctx, func(tx *sql.Tx) error {
+		for _, post := range posts {
+			if err := r.update(ctx, tx, post, post.Version); err != nil {
+				return err
+			}
+		}
+		for _, post := range posts {
+			post.Version++
+		}
+		return nil
+	})
+}
+
+func (r *PostRepository) update(ctx context.Context, tx *sql.Tx, post *domain.Post, expectedVersion int64) error {
+	res, err := tx.ExecContext(ctx, r.q(`UPDATE posts SET title = ?, content = ?, author = ?, author_id = ?, slug = ?, publication_date = ?, status = ?, deleted_at = ?, moderation_state = ?, moderation_reason = ?, moderator = ?, moderated_at = ?, version = version + 1 WHERE id = ? AND version = ?`),
+		post.Title, post.Content, post.Author, post.AuthorID, post.Slug, post.PublicationDate, post.Status, formatTime(post.DeletedAt),
+		post.Moderation.State, post.Moderation.Reason, post.Moderation.Moderator, formatTime(post.Moderation.DecidedAt), post.ID, expectedVersion)
+	if err != nil {
+		return fmt.Errorf("update post: %w", err)
+	}
+	if err := r.checkAffected(ctx, tx, res, post.ID); err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, r.q(`DELETE FROM post_tags WHERE post_id = ?`), post.ID); err != nil {
+		return fmt.Errorf("delete tags: %w", err)
+	}
+	return r.insertTags(ctx, tx, post.ID, post.Tags)
+}
+
+func (r *PostRepository) Delete(ctx context.Context, id string, expectedVersion int64) error {
+	if id == "" {
+		return apperrors.ErrInvalidInput
+	}
+
+	return r.inTx(ctx, func(tx *sql.Tx) error {
+		if _, err := tx.ExecContext(ctx, r.q(`DELETE FROM post_tags WHERE post_id = ?`), id); err != nil {
+			return fmt.Errorf("delete tags: %w", err)
+		}
+
+		query, args := `DELETE FROM posts WHERE id = ?`, []any{id}
+		if expectedVersion != 0 {
+			query, args = `DELETE FROM posts WHERE id = ? AND version = ?`, []any{id, expectedVersion}
+		}
+
+		res, err := tx.ExecContext(ctx, r.q(query), args...)
+		if err != nil {
+			return fmt.Errorf("delete post: %w", err)
+		}
+		return r.checkAffected(ctx, tx, res, id)
+	})
+}
+
+func (r *PostRepository) List(ctx context.Context) ([]*domain.Post, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT `+postColumns+` FROM posts`)
+	if err != nil {
+		return nil, fmt.Errorf("list posts: %w", err)
+	}
+	defer rows.Close()
+
+	result := make([]*domain.Post, 0)
+	byID := make(map[string]*domain.Post)
+	for rows.Next() {
+		post, err := scanPost(rows)
+		if err != nil {
+			return nil, fmt.Errorf("list posts: %w", err)
+		}
+		result = append(result, post)
+		byID[post.ID] = post
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("list posts: %w", err)
+	}
+
+	tagRows, err := r.db.QueryContext(ctx, `SELECT post_id, tag FROM post_tags ORDER BY post_id, position`)
+	if err != nil {
+		return nil, fmt.Errorf("list tags: %w", err)
+	}
+	defer tagRows.Close()
+
+	for tagRows.Next() {
+		var postID, tag string
+		if err := tagRows.Scan(&postID, &tag); err != nil {
+			return nil, fmt.Errorf("list tags: %w", err)
+		}
+		if post, ok := byID[postID]; ok {
+			post.Tags = append(post.Tags, tag)
+		}
+	}
+	if err := tagRows.Err(); err != nil {
+		return nil, fmt.Errorf("list tags: %w", err)
+	}
+
+	return result, nil
+}
+
+const postColumns = `id, title, content, author, author_id, slug, publication_date, status, deleted_at, moderation_state, moderation_reason, moderator, moderated_at, version`
+
+func scanPost(row rowScanner) (*domain.Post, error) {
+	post := &domain.Post{}
+	var deletedAt, moderatedAt string
+	if err := row.Scan(&post.ID, &post.Title, &post.Content, &post.Author, &post.AuthorID, &post.Slug, &post.PublicationDate, &post.Status, &deletedAt,
+		&post.Moderation.State, &post.Moderation.Reason, &post.Moderation.Moderator, &moderatedAt, &post.Version); err != nil {
+		return nil, err
+	}
+
+	var err error
+	if post.DeletedAt, err = parseTime(deletedAt); err != nil {
+		return nil, fmt.Errorf("decode deleted_at: %w", err)
+	}
+	if post.Moderation.DecidedAt, err = parseTime(moderatedAt); err != nil {
+		return nil, fmt.Errorf("decode moderated_at: %w", err)
+	}
+	return post, nil
+}
+
+// formatTime stores the zero time as an empty string.
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339Nano)
+}
+
+func parseTime(raw string) (time.Time, error) {
+	if raw == "" {
+		return time.Time{}, nil
+	}
+	return time.Parse(time.RFC3339Nano, raw)
+}
+
+func (r *PostRepository) inTx(ctx context.Context, fn func(tx *sql.Tx) error) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+
+	if err := fn(tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+	return nil
+}
+
+func (r *PostRepository) exists(ctx context.Context, tx *sql.Tx, id string) (bool, error) {
+	var count int
+	if err := tx.QueryRowContext(ctx, r.q(`SELECT COUNT(*) FROM posts WHERE id = ?`), id).Scan(&count); err != nil {
+		return false, fmt.Errorf("check post: %w", err)
+	}
+	return count > 0, nil
+}
+
+// checkAffected turns a conditional write that matched no rows into either
+// ErrPostNotFound or ErrVersionConflict.
+func (r *PostRepository) checkAffected(ctx context.Context, tx *sql.Tx, res sql.Result, id string) error {
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("rows affected: %w", err)
+	}
+	if affected > 0 {
+		return nil
+	}
+
+	exists, err := r.exists(ctx, tx, id)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return apperrors.ErrPostNotFound
+	}
+	return apperrors.ErrVersionConflict
+}
+
+func (r *PostRepository) insertTags(ctx context.Context, tx *sql.Tx, postID string, tags []string) error {
+	for i, tag := range tags {
+		if _, err := tx.ExecContext(ctx, r.q(`INSERT INTO post_tags (post_id, position, tag) VALUES (?, ?, ?)`), postID, i, tag); err != nil {
+			return fmt.Errorf("insert tag: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,154 @@
+package sqldb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/BhaveetKumar/gRPC-server-go/internal/domain"
+	apperrors "github.com/BhaveetKumar/gRPC-server-go/internal/errors"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/repository"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/repository/repositorytest"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func openTestDB(t *testing.T) *sql.DB {
+	t.Helper()
+
+	dsn := fmt.Sprintf("file:%s?_busy_timeout=5000&_txlock=immediate", filepath.Join(t.TempDir(), "blog.db"))
+	db, err := sql.Open("sqlite3", dsn)
+	if err != nil {
+		t.Fatalf("open database: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	if _, err := Migrate(context.Background(), db, "sqlite3"); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+	return db
+}
+
+func TestPostRepository_Conformance(t *testing.T) {
+	repositorytest.Run(t, func(t *testing.T) repository.PostRepository {
+		return NewPostRepository(openTestDB(t), "sqlite3")
+	})
+}
+
+func TestRevisionRepository_Conformance(t *testing.T) {
+	repositorytest.RunRevisions(t, func(t *testing.T) repository.RevisionRepository {
+		return NewRevisionRepository(openTestDB(t), "sqlite3")
+	})
+}
+
+func TestCommentRepository_Conformance(t *testing.T) {
+	repositorytest.RunComments(t, func(t *testing.T) repository.CommentRepository {
+		return NewCommentRepository(openTestDB(t), "sqlite3")
+	})
+}
+
+func TestAuthorRepository_Conformance(t *testing.T) {
+	repositorytest.RunAuthors(t, func(t *testing.T) repository.AuthorRepository {
+		return NewAuthorRepository(openTestDB(t), "sqlite3")
+	})
+}
+
+func TestSlugRepository_Conformance(t *testing.T) {
+	repositorytest.RunSlugs(t, func(t *testing.T) repository.SlugRepository {
+		return NewSlugRepository(openTestDB(t), "sqlite3")
+	})
+}
+
+func TestAPIKeyRepository_Conformance(t *testing.T) {
+	repositorytest.RunAPIKeys(t, func(t *testing.T) repository.APIKeyRepository {
+		return NewAPIKeyRepository(openTestDB(t), "sqlite3")
+	})
+}
+
+func TestMigrate_Idempotent(t *testing.T) {
+	db := openTestDB(t)
+
+	applied, err := Migrate(context.Background(), db, "sqlite3")
+	if err != nil {
+		t.Fatalf("second migrate failed: %v", err)
+	}
+	if len(applied) != 0 {
+		t.Fatalf("expected no pending migrations, got %v", applied)
+	}
+}
+
+func TestMigrate_ExistingPostsBecomePublished(t *testing.T) {
+	dsn := fmt.Sprintf("file:%s?_busy_timeout=5000&_txlock=immediate", filepath.Join(t.TempDir(), "blog.db"))
+	db, err := sql.Open("sqlite3", dsn)
+	if err != nil {
+		t.Fatalf("open database: %v", err)
+	}
+	defer db.Close()
+
+	ctx := context.Background()
+	all := migrations
+	migrations = all[:1]
+	_, err = Migrate(ctx, db, "sqlite3")
+	migrations = all
+	if err != nil {
+		t.Fatalf("migrate to version 1: %v", err)
+	}
+
+	if _, err := db.ExecContext(ctx, `INSERT INTO posts (id, title, content, author, publication_date, version) VALUES ('old', 't', 'c', 'a', '', 1)`); err != nil {
+		t.Fatalf("insert legacy post: %v", err)
+	}
+
+	if _, err := Migrate(ctx, db, "sqlite3"); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+
+	loaded, err := NewPostRepository(db, "sqlite3").GetByID(ctx, "old")
+	if err != nil {
+		t.Fatalf("get failed: %v", err)
+	}
+	if loaded.Status != domain.StatusPublished {
+		t.Fatalf("expected legacy post to be published, got %q", loaded.Status)
+	}
+}
+
+func TestPostRepository_CreateAndGet(t *testing.T) {
+	repo := NewPostRepository(openTestDB(t), "sqlite3")
+	ctx := context.Background()
+
+	post := &domain.Post{ID: "id1", Title: "title", Content: "content", Author: "author", PublicationDate: "2026-01-01", Tags: []string{"b", "a"}}
+	if err := repo.Create(ctx, post); err != nil {
+		t.Fatalf("create failed: %v", err)
+	}
+	if post.Version != 1 {
+		t.Fatalf("expected version 1, got %d", post.Version)
+	}
+
+	loaded, err := repo.GetByID(ctx, "id1")
+	if err != nil {
+		t.Fatalf("get failed: %v", err)
+	}
+	if loaded.Title != "title" || loaded.PublicationDate != "2026-01-01" {
+		t.Fatalf("unexpected post: %+v", loaded)
+	}
+	if len(loaded.Tags) != 2 || loaded.Tags[0] != "b" || loaded.Tags[1] != "a" {
+		t.Fatalf("tags not preserved in order: %v", loaded.Tags)
+	}
+
+	if err := repo.Create(ctx, post); err != apperrors.ErrDuplicatePost {
+		t.Fatalf("expected duplicate, got %v", err)
+	}
+	if _, err := repo.GetByID(ctx, "missing"); err != apperrors.ErrPostNotFound {
+		t.Fatalf("expected not found, got %v", err)
+	}
+}
+
+func TestRebind(t *testing.T) {
+	got := rebind("postgres", "SELECT * FROM posts WHERE id = ? AND version = ?")
+	if got != "SELECT * FROM posts WHERE id = $1 AND version = $2" {
+		t.Fatalf("unexpected rebind result: %s", got)
+	}
+	if rebind("sqlite3", "id = ?") != "id = ?" {
+		t.Fatal("sqlite3 queries should be left alone")
+	}
+}
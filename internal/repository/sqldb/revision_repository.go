@@ -0,0 +1,183 @@
+package sqldb
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/BhaveetKumar/gRPC-server-go/internal/domain"
+	apperrors "github.com/BhaveetKumar/gRPC-server-go/internal/errors"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/repository"
+)
+
+// RevisionRepository stores post revisions in the post_revisions table. Tags
+// and changed fields are kept as JSON arrays since they are only ever read
+// back whole.
+type RevisionRepository struct {
+	db     *sql.DB
+	driver string
+}
+
+var _ repository.RevisionRepository = (*RevisionRepository)(nil)
+
+func NewRevisionRepository(db *sql.DB, driver string) *RevisionRepository {
+	return &RevisionRepository{db: db, driver: driver}
+}
+
+const revisionColumns = `post_id, version, title, content, author, publication_date, status, tags, editor, created_at, changed_fields`
+
+func (r *RevisionRepository) q(query string) string {
+	return rebind(r.driver, query)
+}
+
+func (r *RevisionRepository) AddRevision(ctx context.Context, rev *domain.Revision) error {
+	if rev == nil || rev.Post == nil || rev.Post.ID == "" {
+		return apperrors.ErrInvalidInput
+	}
+
+	tags, err := json.Marshal(nonNil(rev.Post.Tags))
+	if err != nil {
+		return fmt.Errorf("encode tags: %w", err)
+	}
+	changed, err := json.Marshal(nonNil(rev.ChangedFields))
+	if err != nil {
+		return fmt.Errorf("encode changed fields: %w", err)
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var count int
+	if err := tx.QueryRowContext(ctx, r.q(`SELECT COUNT(*) FROM post_revisions WHERE post_id = ? AND version = ?`), rev.Post.ID, rev.Post.Version).Scan(&count); err != nil {
+		return fmt.Errorf("check revision: %w", err)
+	}
+	if count > 0 {
+		return apperrors.ErrVersionConflict
+	}
+
+	p := rev.Post
+	_, err = tx.ExecContext(ctx, r.q(`INSERT INTO post_revisions (`+revisionColumns+`) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`),
+		p.ID, p.Version, p.Title, p.Content, p.Author, p.PublicationDate, p.Status, string(tags),
+		rev.Editor, rev.CreatedAt.UTC().Format(time.RFC3339Nano), string(changed))
+	if err != nil {
+		return fmt.Errorf("insert revision: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+	return nil
+}
+
+func (r *RevisionRepository) GetRevision(ctx context.Context, postID string, version int64) (*domain.Revision, error) {
+	if postID == "" {
+		return nil, apperrors.ErrInvalidInput
+	}
+
+	row := r.db.QueryRowContext(ctx, r.q(`SELECT `+revisionColumns+` FROM post_revisions WHERE post_id = ? AND version = ?`), postID, version)
+	rev, err := scanRevision(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, apperrors.ErrRevisionNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("select revision: %w", err)
+	}
+	return rev, nil
+}
+
+func (r *RevisionRepository) ListRevisions(ctx context.Context, postID string) ([]*domain.Revision, error) {
+	if postID == "" {
+		return nil, apperrors.ErrInvalidInput
+	}
+
+	rows, err := r.db.QueryContext(ctx, r.q(`SELECT `+revisionColumns+` FROM post_revisions WHERE post_id = ? ORDER BY version DESC`), postID)
+	if err != nil {
+		return nil, fmt.Errorf("list revisions: %w", err)
+	}
+	defer rows.Close()
+
+	result := make([]*domain.Revision, 0)
+	for rows.Next() {
+		rev, err := scanRevision(rows)
+		if err != nil {
+			return nil, fmt.Errorf("list revisions: %w", err)
+		}
+		result = append(result, rev)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("list revisions: %w", err)
+	}
+
+	return result, nil
+}
+
+func (r *RevisionRepository) DeleteRevisions(ctx context.Context, postID string, versions []int64) error {
+	if postID == "" {
+		return apperrors.ErrInvalidInput
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	for _, version := range versions {
+		if _, err := tx.ExecContext(ctx, r.q(`DELETE FROM post_revisions WHERE post_id = ? AND version = ?`), postID, version); err != nil {
+			return fmt.Errorf("delete revision: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+	return nil
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanRevision(row rowScanner) (*domain.Revision, error) {
+	post := &domain.Post{}
+	rev := &domain.Revision{Post: post}
+	var tags, createdAt, changed string
+
+	if err := row.Scan(&post.ID, &post.Version, &post.Title, &post.Content, &post.Author, &post.PublicationDate, &post.Status,
+		&tags, &rev.Editor, &createdAt, &changed); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal([]byte(tags), &post.Tags); err != nil {
+		return nil, fmt.Errorf("decode tags: %w", err)
+	}
+	if err := json.Unmarshal([]byte(changed), &rev.ChangedFields); err != nil {
+		return nil, fmt.Errorf("decode changed fields: %w", err)
+	}
+	created, err := time.Parse(time.RFC3339Nano, createdAt)
+	if err != nil {
+		return nil, fmt.Errorf("decode created_at: %w", err)
+	}
+	rev.CreatedAt = created
+
+	if len(post.Tags) == 0 {
+		post.Tags = nil
+	}
+	if len(rev.ChangedFields) == 0 {
+		rev.ChangedFields = nil
+	}
+	return rev, nil
+}
+
+func nonNil(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
@@ -0,0 +1,84 @@
+package sqldb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	apperrors "github.com/BhaveetKumar/gRPC-server-go/internal/errors"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/repository"
+)
+
+// SlugRepository stores every slug a post has held in the post_slugs table.
+type SlugRepository struct {
+	db     *sql.DB
+	driver string
+}
+
+var _ repository.SlugRepository = (*SlugRepository)(nil)
+
+func NewSlugRepository(db *sql.DB, driver string) *SlugRepository {
+	return &SlugRepository{db: db, driver: driver}
+}
+
+func (r *SlugRepository) q(query string) string {
+	return rebind(r.driver, query)
+}
+
+func (r *SlugRepository) ClaimSlug(ctx context.Context, slug, postID string) error {
+	if slug == "" || postID == "" {
+		return apperrors.ErrInvalidInput
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var holder string
+	err = tx.QueryRowContext(ctx, r.q(`SELECT post_id FROM post_slugs WHERE slug = ?`), slug).Scan(&holder)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		if _, err := tx.ExecContext(ctx, r.q(`INSERT INTO post_slugs (slug, post_id) VALUES (?, ?)`), slug, postID); err != nil {
+			return fmt.Errorf("insert slug: %w", err)
+		}
+	case err != nil:
+		return fmt.Errorf("select slug: %w", err)
+	case holder != postID:
+		return apperrors.ErrDuplicateSlug
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+	return nil
+}
+
+func (r *SlugRepository) ResolveSlug(ctx context.Context, slug string) (string, error) {
+	if slug == "" {
+		return "", apperrors.ErrInvalidInput
+	}
+
+	var postID string
+	err := r.db.QueryRowContext(ctx, r.q(`SELECT post_id FROM post_slugs WHERE slug = ?`), slug).Scan(&postID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", apperrors.ErrPostNotFound
+	}
+	if err != nil {
+		return "", fmt.Errorf("select slug: %w", err)
+	}
+	return postID, nil
+}
+
+func (r *SlugRepository) DeleteSlugs(ctx context.Context, postID string) error {
+	if postID == "" {
+		return apperrors.ErrInvalidInput
+	}
+
+	if _, err := r.db.ExecContext(ctx, r.q(`DELETE FROM post_slugs WHERE post_id = ?`), postID); err != nil {
+		return fmt.Errorf("delete slugs: %w", err)
+	}
+	return nil
+}
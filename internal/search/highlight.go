@@ -0,0 +1,65 @@
+package search
+
+import (
+	"html"
+	"strings"
+)
+
+const snippetWindow = 30
+
+// Snippet returns an HTML excerpt of text around the first matching term
+// with every match wrapped in <em></em>. The text itself is escaped, so the
+// tags are the only markup. It returns an empty string when none of the
+// terms occur in text.
+func Snippet(text string, terms []string) string {
+	tokens := tokenize(text)
+
+	matched := make(map[string]bool, len(terms))
+	for _, term := range terms {
+		matched[term] = true
+	}
+
+	first := -1
+	for i, tok := range tokens {
+		if matched[tok.term] {
+			first = i
+			break
+		}
+	}
+	if first < 0 {
+		return ""
+	}
+
+	from := max(first-snippetWindow/3, 0)
+	to := min(from+snippetWindow, len(tokens))
+
+	start, end := tokens[from].start, tokens[to-1].end
+	if from == 0 {
+		start = 0
+	}
+	if to == len(tokens) {
+		end = len(text)
+	}
+
+	var b strings.Builder
+	if start > 0 {
+		b.WriteString("…")
+	}
+	cursor := start
+	for _, tok := range tokens[from:to] {
+		if !matched[tok.term] {
+			continue
+		}
+		b.WriteString(html.EscapeString(text[cursor:tok.start]))
+		b.WriteString("<em>")
+		b.WriteString(html.EscapeString(text[tok.start:tok.end]))
+		b.WriteString("</em>")
+		cursor = tok.end
+	}
+	b.WriteString(html.EscapeString(text[cursor:end]))
+	if end < len(text) {
+		b.WriteString("…")
+	}
+
+	return b.String()
+}
@@ -0,0 +1,295 @@
+package search
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"sync"
+)
+
+const (
+	bm25K1 = 1.2
+	bm25B  = 0.75
+
+	// titleBoost counts a term in the title as this many occurrences.
+	titleBoost = 2
+)
+
+type occurrence struct {
+	positions []int
+	tf        float64
+}
+
+type document struct {
+	version int64
+	length  float64
+	terms   []string
+}
+
+type Hit struct {
+	ID    string
+	Score float64
+	// Terms are the indexed terms that matched, with prefixes expanded.
+	Terms []string
+}
+
+// Index is an in-memory inverted index over post titles and content, ranked
+// with BM25. Title and content share one position space with a gap between
+// them so that phrases never match across the two.
+type Index struct {
+	mu          sync.RWMutex
+	docs        map[string]*document
+	postings    map[string]map[string]*occurrence
+	totalLength float64
+}
+
+func NewIndex() *Index {
+	return &Index{
+		docs:     make(map[string]*document),
+		postings: make(map[string]map[string]*occurrence),
+	}
+}
+
+// Add indexes a post, replacing any earlier version of it. Versions older
+// than the one already indexed are ignored so that racing writers cannot
+// roll the index back.
+func (ix *Index) Add(id string, version int64, title, content string) {
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+
+	if existing, ok := ix.docs[id]; ok {
+		if existing.version > version {
+			return
+		}
+		ix.removeLocked(id)
+	}
+
+	titleTokens := tokenize(title)
+	contentTokens := tokenize(content)
+
+	occurrences := make(map[string]*occurrence)
+	add := func(term string, position int, weight float64) {
+		occ, ok := occurrences[term]
+		if !ok {
+			occ = &occurrence{}
+			occurrences[term] = occ
+		}
+		occ.positions = append(occ.positions, position)
+		occ.tf += weight
+	}
+
+	for i, tok := range titleTokens {
+		add(tok.term, i, titleBoost)
+	}
+	offset := len(titleTokens) + 1
+	for i, tok := range contentTokens {
+		add(tok.term, offset+i, 1)
+	}
+
+	doc := &document{
+		version: version,
+		length:  float64(len(titleTokens)*titleBoost + len(contentTokens)),
+		terms:   make([]string, 0, len(occurrences)),
+	}
+	for term, occ := range occurrences {
+		postings, ok := ix.postings[term]
+		if !ok {
+			postings = make(map[string]*occurrence)
+			ix.postings[term] = postings
+		}
+		postings[id] = occ
+		doc.terms = append(doc.terms, term)
+	}
+
+	ix.docs[id] = doc
+	ix.totalLength += doc.length
+}
+
+func (ix *Index) Remove(id string) {
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+
+	ix.removeLocked(id)
+}
+
+func (ix *Index) removeLocked(id string) {
+	doc, ok := ix.docs[id]
+	if !ok {
+		return
+	}
+
+	for _, term := range doc.terms {
+		postings := ix.postings[term]
+		delete(postings, id)
+		if len(postings) == 0 {
+			delete(ix.postings, term)
+		}
+	}
+
+	ix.totalLength -= doc.length
+	delete(ix.docs, id)
+}
+
+func (ix *Index) Reset() {
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+
+	ix.docs = make(map[string]*document)
+	ix.postings = make(map[string]map[string]*occurrence)
+	ix.totalLength = 0
+}
+
+func (ix *Index) Len() int {
+	ix.mu.RLock()
+	defer ix.mu.RUnlock()
+
+	return len(ix.docs)
+}
+
+// Search returns every matching post ordered by descending score, with ties
+// broken by ID.
+func (ix *Index) Search(query string) ([]Hit, error) {
+	root, err := parseQuery(query)
+	if err != nil {
+		return nil, err
+	}
+
+	ix.mu.RLock()
+	defer ix.mu.RUnlock()
+
+	matches := ix.eval(root)
+
+	hits := make([]Hit, 0, len(matches))
+	for id, terms := range matches {
+		hit := Hit{ID: id, Terms: make([]string, 0, len(terms))}
+		for term := range terms {
+			hit.Score += ix.score(term, id)
+			hit.Terms = append(hit.Terms, term)
+		}
+		sort.Strings(hit.Terms)
+		hits = append(hits, hit)
+	}
+
+	sort.Slice(hits, func(i, j int) bool {
+		if hits[i].Score != hits[j].Score {
+			return hits[i].Score > hits[j].Score
+		}
+		return hits[i].ID < hits[j].ID
+	})
+
+	return hits, nil
+}
+
+type termSet map[string]struct{}
+
+// eval returns the matching documents, each with the terms that made it
+// match. Callers must hold ix.mu.
+func (ix *Index) eval(n node) map[string]termSet {
+	result := make(map[string]termSet)
+
+	switch n := n.(type) {
+	case termNode:
+		for id := range ix.postings[n.term] {
+			result[id] = termSet{n.term: {}}
+		}
+	case prefixNode:
+		for term, postings := range ix.postings {
+			if !strings.HasPrefix(term, n.prefix) {
+				continue
+			}
+			for id := range postings {
+				addTerm(result, id, term)
+			}
+		}
+	case phraseNode:
+		for id := range ix.postings[n.terms[0]] {
+			if ix.containsPhrase(id, n.terms) {
+				for _, term := range n.terms {
+					addTerm(result, id, term)
+				}
+			}
+		}
+	case andNode:
+		result = ix.eval(n.children[0])
+		for _, child := range n.children[1:] {
+			other := ix.eval(child)
+			for id, terms := range result {
+				otherTerms, ok := other[id]
+				if !ok {
+					delete(result, id)
+					continue
+				}
+				for term := range otherTerms {
+					terms[term] = struct{}{}
+				}
+			}
+		}
+	case orNode:
+		for _, child := range n.children {
+			for id, terms := range ix.eval(child) {
+				for term := range terms {
+					addTerm(result, id, term)
+				}
+			}
+		}
+	}
+
+	return result
+}
+
+func addTerm(result map[string]termSet, id, term string) {
+	terms, ok := result[id]
+	if !ok {
+		terms = make(termSet)
+		result[id] = terms
+	}
+	terms[term] = struct{}{}
+}
+
+func (ix *Index) containsPhrase(id string, terms []string) bool {
+	positions := make([]map[int]bool, len(terms))
+	for i, term := range terms {
+		occ, ok := ix.postings[term][id]
+		if !ok {
+			return false
+		}
+		positions[i] = make(map[int]bool, len(occ.positions))
+		for _, p := range occ.positions {
+			positions[i][p] = true
+		}
+	}
+
+	for start := range positions[0] {
+		matched := true
+		for i := 1; i < len(terms); i++ {
+			if !positions[i][start+i] {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return true
+		}
+	}
+	return false
+}
+
+func (ix *Index) score(term, id string) float64 {
+	postings := ix.postings[term]
+	occ, ok := postings[id]
+	if !ok {
+		return 0
+	}
+
+	n := float64(len(ix.docs))
+	df := float64(len(postings))
+	idf := math.Log(1 + (n-df+0.5)/(df+0.5))
+
+	avgLength := ix.totalLength / n
+	norm := 1 - bm25B
+	if avgLength > 0 {
+		norm += bm25B * ix.docs[id].length / avgLength
+	}
+
+	return idf * occ.tf * (bm25K1 + 1) / (occ.tf + bm25K1*norm)
+}
@@ -0,0 +1,174 @@
+package search
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func newTestIndex() *Index {
+	ix := NewIndex()
+	ix.Add("1", 1, "Streaming with gRPC", "Server streaming lets the server push many messages.")
+	ix.Add("2", 1, "Protocol buffers", "Schemas for gRPC services are written as protocol buffers.")
+	ix.Add("3", 1, "Cooking pasta", "Boil water, add salt, then the pasta.")
+	return ix
+}
+
+func hitIDs(hits []Hit) []string {
+	ids := make([]string, len(hits))
+	for i, hit := range hits {
+		ids[i] = hit.ID
+	}
+	return ids
+}
+
+func TestIndex_Search(t *testing.T) {
+	ix := newTestIndex()
+
+	tests := []struct {
+		query string
+		want  []string
+	}{
+		{query: "grpc", want: []string{"1", "2"}},
+		{query: "GRPC pasta", want: []string{}},
+		{query: "grpc AND streaming", want: []string{"1"}},
+		{query: "streaming OR pasta", want: []string{"1", "3"}},
+		{query: "proto*", want: []string{"2"}},
+		{query: `"server push"`, want: []string{"1"}},
+		{query: `"push server"`, want: []string{}},
+		{query: "(pasta OR schemas) salt", want: []string{"3"}},
+	}
+
+	for _, tt := range tests {
+		hits, err := ix.Search(tt.query)
+		if err != nil {
+			t.Fatalf("%q: search failed: %v", tt.query, err)
+		}
+
+		got := hitIDs(hits)
+		if len(got) != len(tt.want) {
+			t.Fatalf("%q: expected %v, got %v", tt.query, tt.want, got)
+		}
+		seen := make(map[string]bool)
+		for _, id := range got {
+			seen[id] = true
+		}
+		for _, id := range tt.want {
+			if !seen[id] {
+				t.Fatalf("%q: expected %v, got %v", tt.query, tt.want, got)
+			}
+		}
+	}
+}
+
+func TestIndex_SearchRanksTitleMatchesHigher(t *testing.T) {
+	ix := newTestIndex()
+
+	hits, err := ix.Search("streaming")
+	if err != nil {
+		t.Fatalf("search failed: %v", err)
+	}
+	if len(hits) != 1 || hits[0].ID != "1" {
+		t.Fatalf("unexpected hits: %v", hits)
+	}
+
+	ix.Add("4", 1, "Notes", "Some streaming notes.")
+	hits, err = ix.Search("streaming")
+	if err != nil {
+		t.Fatalf("search failed: %v", err)
+	}
+	if len(hits) != 2 || hits[0].ID != "1" {
+		t.Fatalf("expected post with title match first, got %v", hitIDs(hits))
+	}
+	if hits[0].Score <= hits[1].Score {
+		t.Fatalf("expected descending scores, got %v", hits)
+	}
+}
+
+func TestIndex_PhraseDoesNotSpanFields(t *testing.T) {
+	ix := NewIndex()
+	ix.Add("1", 1, "Hello", "world")
+
+	hits, err := ix.Search(`"hello world"`)
+	if err != nil {
+		t.Fatalf("search failed: %v", err)
+	}
+	if len(hits) != 0 {
+		t.Fatalf("expected no hits, got %v", hitIDs(hits))
+	}
+}
+
+func TestIndex_AddReplacesAndRemove(t *testing.T) {
+	ix := newTestIndex()
+
+	ix.Add("3", 2, "Cooking rice", "Rinse the rice first.")
+	if hits, _ := ix.Search("pasta"); len(hits) != 0 {
+		t.Fatalf("expected old content to be gone, got %v", hitIDs(hits))
+	}
+	if hits, _ := ix.Search("rice"); len(hits) != 1 {
+		t.Fatalf("expected new content to be indexed, got %v", hitIDs(hits))
+	}
+
+	// An older version must not replace a newer one.
+	ix.Add("3", 1, "Cooking pasta", "Boil water.")
+	if hits, _ := ix.Search("rice"); len(hits) != 1 {
+		t.Fatalf("expected stale version to be ignored, got %v", hitIDs(hits))
+	}
+
+	ix.Remove("3")
+	if hits, _ := ix.Search("rice"); len(hits) != 0 {
+		t.Fatalf("expected removed post to be gone, got %v", hitIDs(hits))
+	}
+	if ix.Len() != 2 {
+		t.Fatalf("expected 2 documents, got %d", ix.Len())
+	}
+}
+
+func TestIndex_InvalidQuery(t *testing.T) {
+	ix := newTestIndex()
+
+	for _, query := range []string{"", "   ", `"unterminated`, "(grpc", "grpc)", "grpc OR", "AND grpc", "*", "!!"} {
+		if _, err := ix.Search(query); !errors.Is(err, ErrInvalidQuery) {
+			t.Fatalf("%q: expected ErrInvalidQuery, got %v", query, err)
+		}
+	}
+}
+
+func TestSnippet(t *testing.T) {
+	got := Snippet("Server streaming lets the server push.", []string{"server"})
+	want := "<em>Server</em> streaming lets the <em>server</em> push."
+	if got != want {
+		t.Fatalf("expected %q, got %q", want, got)
+	}
+
+	if got := Snippet("nothing here", []string{"server"}); got != "" {
+		t.Fatalf("expected empty snippet, got %q", got)
+	}
+}
+
+func TestSnippet_EscapesText(t *testing.T) {
+	got := Snippet(`Server <script>alert("x")</script> & more`, []string{"server", "script"})
+	want := "<em>Server</em> &lt;<em>script</em>&gt;alert(&#34;x&#34;)&lt;/<em>script</em>&gt; &amp; more"
+	if got != want {
+		t.Fatalf("expected %q, got %q", want, got)
+	}
+}
+
+func TestSnippet_LongText(t *testing.T) {
+	text := ""
+	for i := 0; i < 50; i++ {
+		text += "filler "
+	}
+	text += "needle"
+	for i := 0; i < 50; i++ {
+		text += " filler"
+	}
+
+	got := Snippet(text, []string{"needle"})
+	if !strings.HasPrefix(got, "…") || !strings.HasSuffix(got, "…") {
+		t.Fatalf("expected ellipses on both ends, got %q", got)
+	}
+	if !strings.Contains(got, "<em>needle</em>") {
+		t.Fatalf("expected highlighted match, got %q", got)
+	}
+}
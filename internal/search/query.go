@@ -0,0 +1,223 @@
+package search
+
+import (
+	"errors"
+	"strings"
+)
+
+var ErrInvalidQuery = errors.New("invalid search query")
+
+// A query is a sequence of terms combined with AND (also implied between
+// adjacent terms) and OR, where AND binds tighter. Terms are plain words,
+// prefixes such as grp*, or "quoted phrases", and can be grouped with
+// parentheses. AND and OR are only operators when written in upper case.
+type node interface{}
+
+type termNode struct{ term string }
+
+type prefixNode struct{ prefix string }
+
+type phraseNode struct{ terms []string }
+
+type andNode struct{ children []node }
+
+type orNode struct{ children []node }
+
+type lexKind int
+
+const (
+	lexWord lexKind = iota
+	lexPhrase
+	lexAnd
+	lexOr
+	lexOpen
+	lexClose
+)
+
+type lexeme struct {
+	kind lexKind
+	text string
+}
+
+func lex(query string) ([]lexeme, error) {
+	var out []lexeme
+	for i := 0; i < len(query); {
+		switch c := query[i]; {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '(':
+			out = append(out, lexeme{kind: lexOpen})
+			i++
+		case c == ')':
+			out = append(out, lexeme{kind: lexClose})
+			i++
+		case c == '"':
+			end := strings.IndexByte(query[i+1:], '"')
+			if end < 0 {
+				return nil, ErrInvalidQuery
+			}
+			out = append(out, lexeme{kind: lexPhrase, text: query[i+1 : i+1+end]})
+			i += end + 2
+		default:
+			j := i
+			for j < len(query) && !strings.ContainsRune(" \t\n\r()\"", rune(query[j])) {
+				j++
+			}
+			word := query[i:j]
+			switch word {
+			case "AND":
+				out = append(out, lexeme{kind: lexAnd})
+			case "OR":
+				out = append(out, lexeme{kind: lexOr})
+			default:
+				out = append(out, lexeme{kind: lexWord, text: word})
+			}
+			i = j
+		}
+	}
+	return out, nil
+}
+
+type parser struct {
+	lexemes []lexeme
+	pos     int
+}
+
+func parseQuery(query string) (node, error) {
+	lexemes, err := lex(query)
+	if err != nil {
+		return nil, err
+	}
+	if len(lexemes) == 0 {
+		return nil, ErrInvalidQuery
+	}
+
+	p := &parser{lexemes: lexemes}
+	n, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos != len(p.lexemes) {
+		return nil, ErrInvalidQuery
+	}
+	return n, nil
+}
+
+func (p *parser) peek() (lexeme, bool) {
+	if p.pos >= len(p.lexemes) {
+		return lexeme{}, false
+	}
+	return p.lexemes[p.pos], true
+}
+
+func (p *parser) parseOr() (node, error) {
+	first, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	children := []node{first}
+	for {
+		next, ok := p.peek()
+		if !ok || next.kind != lexOr {
+			break
+		}
+		p.pos++
+		child, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		children = append(children, child)
+	}
+
+	if len(children) == 1 {
+		return first, nil
+	}
+	return orNode{children: children}, nil
+}
+
+func (p *parser) parseAnd() (node, error) {
+	first, err := p.parseTerm()
+	if err != nil {
+		return nil, err
+	}
+
+	children := []node{first}
+	for {
+		next, ok := p.peek()
+		if !ok || next.kind == lexOr || next.kind == lexClose {
+			break
+		}
+		if next.kind == lexAnd {
+			p.pos++
+		}
+		child, err := p.parseTerm()
+		if err != nil {
+			return nil, err
+		}
+		children = append(children, child)
+	}
+
+	if len(children) == 1 {
+		return first, nil
+	}
+	return andNode{children: children}, nil
+}
+
+func (p *parser) parseTerm() (node, error) {
+	next, ok := p.peek()
+	if !ok {
+		return nil, ErrInvalidQuery
+	}
+	p.pos++
+
+	switch next.kind {
+	case lexOpen:
+		n, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		closing, ok := p.peek()
+		if !ok || closing.kind != lexClose {
+			return nil, ErrInvalidQuery
+		}
+		p.pos++
+		return n, nil
+	case lexPhrase:
+		return phraseFromText(next.text)
+	case lexWord:
+		return wordNode(next.text)
+	default:
+		return nil, ErrInvalidQuery
+	}
+}
+
+func wordNode(word string) (node, error) {
+	if strings.HasSuffix(word, "*") {
+		tokens := tokenize(strings.TrimSuffix(word, "*"))
+		if len(tokens) != 1 {
+			return nil, ErrInvalidQuery
+		}
+		return prefixNode{prefix: tokens[0].term}, nil
+	}
+
+	// Punctuation inside a word splits it into several terms, so "grpc-go"
+	// is searched for as the phrase "grpc go".
+	return phraseFromText(word)
+}
+
+func phraseFromText(text string) (node, error) {
+	tokens := tokenize(text)
+	switch len(tokens) {
+	case 0:
+		return nil, ErrInvalidQuery
+	case 1:
+		return termNode{term: tokens[0].term}, nil
+	}
+
+	terms := make([]string, len(tokens))
+	for i, tok := range tokens {
+		terms[i] = tok.term
+	}
+	return phraseNode{terms: terms}, nil
+}
@@ -0,0 +1,35 @@
+package search
+
+import (
+	"strings"
+	"unicode"
+)
+
+type token struct {
+	term  string
+	start int
+	end   int
+}
+
+// tokenize splits text into lower-cased runs of letters and digits and keeps
+// the byte offsets of each run so matches can be highlighted in the original.
+func tokenize(text string) []token {
+	var tokens []token
+	start := -1
+	for i, r := range text {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			if start < 0 {
+				start = i
+			}
+			continue
+		}
+		if start >= 0 {
+			tokens = append(tokens, token{term: strings.ToLower(text[start:i]), start: start, end: i})
+			start = -1
+		}
+	}
+	if start >= 0 {
+		tokens = append(tokens, token{term: strings.ToLower(text[start:]), start: start, end: len(text)})
+	}
+	return tokens
+}
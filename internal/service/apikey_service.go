@@ -0,0 +1,238 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/BhaveetKumar/gRPC-server-go/internal/clock"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/domain"
+	apperrors "github.com/BhaveetKumar/gRPC-server-go/internal/errors"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/repository"
+)
+
+const (
+	// APIKeyPrefix starts every raw key, so that leaked keys are easy to
+	// recognise. A raw key is the prefix, the key ID, a dot and the secret.
+	APIKeyPrefix = "blog_"
+
+	apiKeyIDBytes     = 8
+	apiKeySecretBytes = 32
+
+	// lastUsedResolution bounds how often a busy key's last use is written.
+	lastUsedResolution = time.Minute
+)
+
+type apiKeyService struct {
+	repo  repository.APIKeyRepository
+	clock clock.Clock
+}
+
+var _ APIKeyService = (*apiKeyService)(nil)
+
+type APIKeyOption func(*apiKeyService)
+
+func WithAPIKeyClock(c clock.Clock) APIKeyOption {
+	return func(s *apiKeyService) {
+		s.clock = c
+	}
+}
+
+func NewAPIKeyService(repo repository.APIKeyRepository, opts ...APIKeyOption) APIKeyService {
+	s := &apiKeyService{
+		repo:  repo,
+		clock: clock.Real(),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+func (s *apiKeyService) IssueAPIKey(ctx context.Context, name, owner string, scopes []string, ttl time.Duration) (*domain.APIKey, string, error) {
+	if ttl < 0 {
+		return nil, "", apperrors.ErrInvalidInput
+	}
+
+	id, err := randomBytes(apiKeyIDBytes)
+	if err != nil {
+		return nil, "", err
+	}
+	secret, err := randomBytes(apiKeySecretBytes)
+	if err != nil {
+		return nil, "", err
+	}
+
+	now := s.clock.Now()
+	key := &domain.APIKey{
+		ID:         hex.EncodeToString(id),
+		Name:       strings.TrimSpace(name),
+		Owner:      domain.CleanAuthorName(owner),
+		Scopes:     cleanScopes(scopes),
+		CreatedAt:  now,
+		SecretHash: hashSecret(base64.RawURLEncoding.EncodeToString(secret)),
+	}
+	if ttl > 0 {
+		key.ExpiresAt = now.Add(ttl)
+	}
+	if err := key.Validate(); err != nil {
+		return nil, "", err
+	}
+
+	if err := s.repo.CreateAPIKey(ctx, key); err != nil {
+		return nil, "", err
+	}
+
+	raw := APIKeyPrefix + key.ID + "." + base64.RawURLEncoding.EncodeToString(secret)
+	return key, raw, nil
+}
+
+func (s *apiKeyService) ListAPIKeys(ctx context.Context, params ListAPIKeysParams) ([]*domain.APIKey, string, error) {
+	if params.PageSize < 0 {
+		return nil, "", apperrors.ErrInvalidInput
+	}
+
+	pageSize := params.PageSize
+	if pageSize == 0 {
+		pageSize = defaultPageSize
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+
+	var cursor *pageToken
+	if params.PageToken != "" {
+		token, err := decodePageToken(params.PageToken, apiKeysFingerprint)
+		if err != nil {
+			return nil, "", err
+		}
+		cursor = token
+	}
+
+	keys, err := s.repo.ListAPIKeys(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	key := func(k *domain.APIKey) string {
+		return fmt.Sprintf("%020d", k.CreatedAt.UnixNano())
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return compareKeys(key(keys[i]), keys[i].ID, key(keys[j]), keys[j].ID, OrderTitleAsc) < 0
+	})
+
+	start := 0
+	if cursor != nil {
+		start = sort.Search(len(keys), func(i int) bool {
+			return compareKeys(cursor.Key, cursor.ID, key(keys[i]), keys[i].ID, OrderTitleAsc) < 0
+		})
+	}
+
+	end := min(start+pageSize, len(keys))
+	page := keys[start:end]
+
+	nextToken := ""
+	if end < len(keys) {
+		last := page[len(page)-1]
+		nextToken = encodePageToken(pageToken{Key: key(last), ID: last.ID, Query: apiKeysFingerprint})
+	}
+
+	return page, nextToken, nil
+}
+
+func (s *apiKeyService) RevokeAPIKey(ctx context.Context, id string) (*domain.APIKey, error) {
+	if id == "" {
+		return nil, apperrors.ErrInvalidInput
+	}
+
+	if err := s.repo.RevokeAPIKey(ctx, id, s.clock.Now()); err != nil {
+		return nil, err
+	}
+	return s.repo.GetAPIKey(ctx, id)
+}
+
+func (s *apiKeyService) VerifyAPIKey(ctx context.Context, raw string) (*domain.APIKey, error) {
+	id, secret, ok := splitAPIKey(raw)
+	if !ok {
+		return nil, apperrors.ErrUnauthenticated
+	}
+
+	key, err := s.repo.GetAPIKey(ctx, id)
+	if err == apperrors.ErrAPIKeyNotFound {
+		return nil, apperrors.ErrUnauthenticated
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if subtle.ConstantTimeCompare([]byte(hashSecret(secret)), []byte(key.SecretHash)) != 1 {
+		return nil, apperrors.ErrUnauthenticated
+	}
+
+	now := s.clock.Now()
+	if !key.Active(now) {
+		return nil, apperrors.ErrUnauthenticated
+	}
+
+	// Recording the use is best effort: a key that checks out is not
+	// rejected because its last use could not be written.
+	if now.Sub(key.LastUsedAt) >= lastUsedResolution {
+		if err := s.repo.TouchAPIKey(ctx, key.ID, now); err == nil {
+			key.LastUsedAt = now
+		}
+	}
+	return key, nil
+}
+
+// IsAPIKey reports whether raw looks like a key issued by APIKeyService.
+func IsAPIKey(raw string) bool {
+	_, _, ok := splitAPIKey(raw)
+	return ok
+}
+
+func splitAPIKey(raw string) (id, secret string, ok bool) {
+	rest, ok := strings.CutPrefix(raw, APIKeyPrefix)
+	if !ok {
+		return "", "", false
+	}
+	id, secret, ok = strings.Cut(rest, ".")
+	if !ok || len(id) != 2*apiKeyIDBytes || secret == "" {
+		return "", "", false
+	}
+	return id, secret, true
+}
+
+func hashSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+func randomBytes(n int) ([]byte, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return nil, fmt.Errorf("generate api key: %w", err)
+	}
+	return b, nil
+}
+
+// cleanScopes trims and deduplicates scopes, keeping their order.
+func cleanScopes(scopes []string) []string {
+	result := make([]string, 0, len(scopes))
+	seen := make(map[string]bool, len(scopes))
+	for _, scope := range scopes {
+		scope = strings.TrimSpace(scope)
+		if scope == "" || seen[scope] {
+			continue
+		}
+		seen[scope] = true
+		result = append(result, scope)
+	}
+	return result
+}
@@ -0,0 +1,179 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/BhaveetKumar/gRPC-server-go/internal/clock"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/domain"
+	apperrors "github.com/BhaveetKumar/gRPC-server-go/internal/errors"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/repository/memory"
+)
+
+func newAPIKeyFixture(t *testing.T) (APIKeyService, *memory.APIKeyRepository, *clock.Fake) {
+	t.Helper()
+
+	repo := memory.NewAPIKeyRepository()
+	clk := clock.NewFake(schedulerEpoch)
+	return NewAPIKeyService(repo, WithAPIKeyClock(clk)), repo, clk
+}
+
+func TestAPIKeyService_IssueAndVerify(t *testing.T) {
+	keys, repo, clk := newAPIKeyFixture(t)
+	ctx := context.Background()
+
+	key, raw, err := keys.IssueAPIKey(ctx, " nightly export ", "export bot", []string{domain.ScopePostsRead, domain.ScopePostsRead, domain.ScopePostsWrite}, time.Hour)
+	if err != nil {
+		t.Fatalf("issue failed: %v", err)
+	}
+	if !strings.HasPrefix(raw, APIKeyPrefix+key.ID+".") || !IsAPIKey(raw) {
+		t.Fatalf("unexpected raw key %q for id %s", raw, key.ID)
+	}
+	if key.Name != "nightly export" || key.Owner != "export bot" || len(key.Scopes) != 2 {
+		t.Fatalf("expected a cleaned up key, got %+v", key)
+	}
+	if !key.ExpiresAt.Equal(schedulerEpoch.Add(time.Hour)) {
+		t.Fatalf("expected the key to expire in an hour, got %v", key.ExpiresAt)
+	}
+
+	stored, _ := repo.GetAPIKey(ctx, key.ID)
+	if stored.SecretHash == "" || strings.Contains(raw, stored.SecretHash) {
+		t.Fatalf("expected only a hash of the secret to be stored, got %q", stored.SecretHash)
+	}
+
+	verified, err := keys.VerifyAPIKey(ctx, raw)
+	if err != nil {
+		t.Fatalf("verify failed: %v", err)
+	}
+	if verified.ID != key.ID || !verified.LastUsedAt.Equal(schedulerEpoch) {
+		t.Fatalf("expected the key with its use recorded, got %+v", verified)
+	}
+
+	clk.Advance(time.Hour)
+	if _, err := keys.VerifyAPIKey(ctx, raw); err != apperrors.ErrUnauthenticated {
+		t.Fatalf("expected an expired key to be rejected, got %v", err)
+	}
+}
+
+func TestAPIKeyService_VerifyRejects(t *testing.T) {
+	keys, _, _ := newAPIKeyFixture(t)
+	ctx := context.Background()
+
+	key, raw, err := keys.IssueAPIKey(ctx, "export", "bot", []string{domain.ScopePostsRead}, 0)
+	if err != nil {
+		t.Fatalf("issue failed: %v", err)
+	}
+	if !key.ExpiresAt.IsZero() {
+		t.Fatalf("expected a key without ttl to never expire, got %v", key.ExpiresAt)
+	}
+
+	_, other, _ := keys.IssueAPIKey(ctx, "other", "bot", []string{domain.ScopePostsRead}, 0)
+	wrongSecret := raw[:strings.Index(raw, ".")] + other[strings.Index(other, "."):]
+
+	for _, bad := range []string{
+		"",
+		"not-a-key",
+		APIKeyPrefix + key.ID,
+		APIKeyPrefix + "0123456789abcdef.secret",
+		wrongSecret,
+	} {
+		if _, err := keys.VerifyAPIKey(ctx, bad); err != apperrors.ErrUnauthenticated {
+			t.Fatalf("expected %q to be rejected, got %v", bad, err)
+		}
+	}
+
+	if _, err := keys.RevokeAPIKey(ctx, key.ID); err != nil {
+		t.Fatalf("revoke failed: %v", err)
+	}
+	if _, err := keys.VerifyAPIKey(ctx, raw); err != apperrors.ErrUnauthenticated {
+		t.Fatalf("expected a revoked key to be rejected, got %v", err)
+	}
+	if _, err := keys.VerifyAPIKey(ctx, other); err != nil {
+		t.Fatalf("expected other keys to keep working, got %v", err)
+	}
+}
+
+func TestAPIKeyService_IssueInvalid(t *testing.T) {
+	keys, _, _ := newAPIKeyFixture(t)
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		owner  string
+		scopes []string
+		ttl    time.Duration
+	}{
+		{"", "bot", []string{domain.ScopeAdmin}, 0},
+		{"export", " ", []string{domain.ScopeAdmin}, 0},
+		{"export", "bot", nil, 0},
+		{"export", "bot", []string{"posts:delete"}, 0},
+		{"export", "bot", []string{domain.ScopeAdmin}, -time.Hour},
+	}
+	for _, tt := range tests {
+		if _, _, err := keys.IssueAPIKey(ctx, tt.name, tt.owner, tt.scopes, tt.ttl); err != apperrors.ErrInvalidInput {
+			t.Fatalf("expected invalid input for %+v, got %v", tt, err)
+		}
+	}
+}
+
+func TestAPIKeyService_LastUsedThrottled(t *testing.T) {
+	keys, repo, clk := newAPIKeyFixture(t)
+	ctx := context.Background()
+
+	key, raw, _ := keys.IssueAPIKey(ctx, "export", "bot", []string{domain.ScopePostsRead}, 0)
+	for _, step := range []time.Duration{0, 10 * time.Second, 20 * time.Second} {
+		clk.Advance(step)
+		if _, err := keys.VerifyAPIKey(ctx, raw); err != nil {
+			t.Fatalf("verify failed: %v", err)
+		}
+	}
+	if stored, _ := repo.GetAPIKey(ctx, key.ID); !stored.LastUsedAt.Equal(schedulerEpoch) {
+		t.Fatalf("expected uses within a minute to be written once, got %v", stored.LastUsedAt)
+	}
+
+	clk.Advance(time.Minute)
+	_, _ = keys.VerifyAPIKey(ctx, raw)
+	if stored, _ := repo.GetAPIKey(ctx, key.ID); !stored.LastUsedAt.Equal(clk.Now()) {
+		t.Fatalf("expected a later use to be written, got %v", stored.LastUsedAt)
+	}
+}
+
+func TestAPIKeyService_ListAndRevoke(t *testing.T) {
+	keys, _, clk := newAPIKeyFixture(t)
+	ctx := context.Background()
+
+	var ids []string
+	for _, name := range []string{"a", "b", "c"} {
+		key, _, err := keys.IssueAPIKey(ctx, name, "bot", []string{domain.ScopePostsRead}, 0)
+		if err != nil {
+			t.Fatalf("issue failed: %v", err)
+		}
+		ids = append(ids, key.ID)
+		clk.Advance(time.Second)
+	}
+
+	first, token, err := keys.ListAPIKeys(ctx, ListAPIKeysParams{PageSize: 2})
+	if err != nil || len(first) != 2 || token == "" {
+		t.Fatalf("expected a full first page, got %d keys, %q, %v", len(first), token, err)
+	}
+	rest, token, err := keys.ListAPIKeys(ctx, ListAPIKeysParams{PageSize: 2, PageToken: token})
+	if err != nil || len(rest) != 1 || token != "" {
+		t.Fatalf("expected a last page of one, got %d keys, %q, %v", len(rest), token, err)
+	}
+	if got := []string{first[0].ID, first[1].ID, rest[0].ID}; got[0] != ids[0] || got[1] != ids[1] || got[2] != ids[2] {
+		t.Fatalf("expected keys oldest first %v, got %v", ids, got)
+	}
+
+	revoked, err := keys.RevokeAPIKey(ctx, ids[1])
+	if err != nil || !revoked.RevokedAt.Equal(clk.Now()) {
+		t.Fatalf("expected the key to be revoked now, got %+v, %v", revoked, err)
+	}
+	if _, err := keys.RevokeAPIKey(ctx, "missing"); err != apperrors.ErrAPIKeyNotFound {
+		t.Fatalf("expected not found for an unknown key, got %v", err)
+	}
+	if _, _, err := keys.ListAPIKeys(ctx, ListAPIKeysParams{PageToken: "garbage"}); err != apperrors.ErrInvalidInput {
+		t.Fatalf("expected invalid input for a bad page token, got %v", err)
+	}
+}
@@ -0,0 +1,205 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/BhaveetKumar/gRPC-server-go/internal/clock"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/domain"
+	apperrors "github.com/BhaveetKumar/gRPC-server-go/internal/errors"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/repository"
+	"github.com/google/uuid"
+)
+
+// defaultAuthorMask is applied when UpdateAuthor gets no mask.
+var defaultAuthorMask = []string{FieldName, FieldBio, FieldAvatarURL}
+
+// authorService manages the author profiles that the bylines of a
+// PostService's posts point to.
+type authorService struct {
+	repo  repository.AuthorRepository
+	posts PostService
+	clock clock.Clock
+
+	// mu serializes updates and deletes, so the bylines of an author's
+	// posts always end up with the name of the last rename.
+	mu sync.Mutex
+}
+
+var _ AuthorService = (*authorService)(nil)
+
+type AuthorOption func(*authorService)
+
+func WithAuthorClock(c clock.Clock) AuthorOption {
+	return func(s *authorService) {
+		s.clock = c
+	}
+}
+
+func NewAuthorService(repo repository.AuthorRepository, posts PostService, opts ...AuthorOption) AuthorService {
+	s := &authorService{
+		repo:  repo,
+		posts: posts,
+		clock: clock.Real(),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+func (s *authorService) CreateAuthor(ctx context.Context, name, bio, avatarURL string) (*domain.Author, error) {
+	now := s.clock.Now()
+	author := &domain.Author{
+		ID:        uuid.NewString(),
+		Name:      domain.CleanAuthorName(name),
+		Bio:       bio,
+		AvatarURL: avatarURL,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := author.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.CreateAuthor(ctx, author); err != nil {
+		return nil, err
+	}
+	return author, nil
+}
+
+func (s *authorService) GetAuthor(ctx context.Context, id string) (*domain.Author, error) {
+	if id == "" {
+		return nil, apperrors.ErrInvalidInput
+	}
+	return s.repo.GetAuthor(ctx, id)
+}
+
+func (s *authorService) UpdateAuthor(ctx context.Context, id string, update AuthorUpdate, mask []string, etag string) (*domain.Author, error) {
+	if id == "" {
+		return nil, apperrors.ErrInvalidInput
+	}
+
+	if len(mask) == 0 {
+		mask = defaultAuthorMask
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	existing, err := s.repo.GetAuthor(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if etag != "" && etag != existing.ETag() {
+		return nil, apperrors.ErrVersionConflict
+	}
+
+	for _, path := range mask {
+		switch path {
+		case FieldName:
+			existing.Name = domain.CleanAuthorName(update.Name)
+		case FieldBio:
+			existing.Bio = update.Bio
+		case FieldAvatarURL:
+			existing.AvatarURL = update.AvatarURL
+		default:
+			return nil, apperrors.ErrInvalidInput
+		}
+	}
+	if err := existing.Validate(); err != nil {
+		return nil, err
+	}
+
+	existing.UpdatedAt = s.clock.Now()
+	if err := s.repo.UpdateAuthor(ctx, existing, existing.Version); err != nil {
+		return nil, err
+	}
+
+	if err := s.posts.RenameAuthor(ctx, existing); err != nil {
+		return nil, err
+	}
+	return existing, nil
+}
+
+func (s *authorService) DeleteAuthor(ctx context.Context, id, etag string) error {
+	if id == "" {
+		return apperrors.ErrInvalidInput
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	existing, err := s.repo.GetAuthor(ctx, id)
+	if err != nil {
+		return err
+	}
+	if etag != "" && etag != existing.ETag() {
+		return apperrors.ErrVersionConflict
+	}
+
+	hasPosts, err := s.posts.AuthorHasPosts(ctx, id)
+	if err != nil {
+		return err
+	}
+	if hasPosts {
+		return apperrors.ErrAuthorHasPosts
+	}
+
+	return s.repo.DeleteAuthor(ctx, id, existing.Version)
+}
+
+// ListAuthors returns authors ordered by name.
+func (s *authorService) ListAuthors(ctx context.Context, params ListAuthorsParams) ([]*domain.Author, string, error) {
+	if params.PageSize < 0 {
+		return nil, "", apperrors.ErrInvalidInput
+	}
+
+	pageSize := params.PageSize
+	if pageSize == 0 {
+		pageSize = defaultPageSize
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+
+	var cursor *pageToken
+	if params.PageToken != "" {
+		token, err := decodePageToken(params.PageToken, authorsFingerprint)
+		if err != nil {
+			return nil, "", err
+		}
+		cursor = token
+	}
+
+	authors, err := s.repo.ListAuthors(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	key := func(author *domain.Author) string {
+		return domain.NormalizeAuthorName(author.Name)
+	}
+	sort.Slice(authors, func(i, j int) bool {
+		return compareKeys(key(authors[i]), authors[i].ID, key(authors[j]), authors[j].ID, OrderTitleAsc) < 0
+	})
+
+	start := 0
+	if cursor != nil {
+		start = sort.Search(len(authors), func(i int) bool {
+			return compareKeys(cursor.Key, cursor.ID, key(authors[i]), authors[i].ID, OrderTitleAsc) < 0
+		})
+	}
+
+	end := min(start+pageSize, len(authors))
+	page := authors[start:end]
+
+	nextToken := ""
+	if end < len(authors) {
+		last := page[len(page)-1]
+		nextToken = encodePageToken(pageToken{Key: key(last), ID: last.ID, Query: authorsFingerprint})
+	}
+
+	return page, nextToken, nil
+}
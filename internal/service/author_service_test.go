@@ -0,0 +1,222 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/BhaveetKumar/gRPC-server-go/internal/domain"
+	apperrors "github.com/BhaveetKumar/gRPC-server-go/internal/errors"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/repository/memory"
+)
+
+// newAuthorFixture returns a post service and an author service sharing an
+// author store.
+func newAuthorFixture(t *testing.T) (PostService, AuthorService) {
+	t.Helper()
+
+	authors := memory.NewAuthorRepository()
+	posts := NewPostService(memory.NewPostRepository(), WithAuthors(authors))
+	return posts, NewAuthorService(authors, posts)
+}
+
+func TestPostService_BylinesShareAuthor(t *testing.T) {
+	posts, authors := newAuthorFixture(t)
+	ctx := context.Background()
+
+	first, err := posts.CreatePost(ctx, "first", "content", " John  Doe", "", nil)
+	if err != nil {
+		t.Fatalf("create failed: %v", err)
+	}
+	second, err := posts.CreatePost(ctx, "second", "content", "john doe", "", nil)
+	if err != nil {
+		t.Fatalf("create failed: %v", err)
+	}
+	other := mustCreatePublished(t, posts, "other", "Jane Roe", "", nil)
+
+	if first.AuthorID == "" || first.AuthorID != second.AuthorID || first.AuthorID == other.AuthorID {
+		t.Fatalf("expected both spellings to share an author: %q, %q, %q", first.AuthorID, second.AuthorID, other.AuthorID)
+	}
+	if first.Author != "John Doe" || second.Author != "John Doe" {
+		t.Fatalf("expected bylines to use the author's name, got %q and %q", first.Author, second.Author)
+	}
+
+	author, err := authors.GetAuthor(ctx, first.AuthorID)
+	if err != nil || author.Name != "John Doe" {
+		t.Fatalf("expected an author record for the byline, got %+v, %v", author, err)
+	}
+
+	// Any spelling of the name sees the author's drafts.
+	listed, _, err := posts.ListPosts(WithCaller(ctx, "JOHN DOE"), ListPostsParams{AuthorID: first.AuthorID})
+	if err != nil {
+		t.Fatalf("list failed: %v", err)
+	}
+	if len(listed) != 2 {
+		t.Fatalf("expected both drafts by author id, got %d", len(listed))
+	}
+	listed, _, _ = posts.ListPosts(ctx, ListPostsParams{Author: "jane roe"})
+	if len(listed) != 1 || listed[0].ID != other.ID {
+		t.Fatalf("expected the author filter to ignore case, got %+v", listed)
+	}
+
+	updated, err := posts.UpdatePost(WithCaller(ctx, "john doe"), second.ID, PostUpdate{Author: "JANE ROE"}, []string{FieldAuthor}, "")
+	if err != nil {
+		t.Fatalf("update failed: %v", err)
+	}
+	if updated.AuthorID != other.AuthorID || updated.Author != "Jane Roe" {
+		t.Fatalf("expected the update to move the post to Jane Roe, got %+v", updated)
+	}
+}
+
+func TestAuthorService_CRUD(t *testing.T) {
+	posts, authors := newAuthorFixture(t)
+	ctx := context.Background()
+
+	author, err := authors.CreateAuthor(ctx, "  Ada   Lovelace ", "First programmer", "https://example.com/ada.png")
+	if err != nil {
+		t.Fatalf("create failed: %v", err)
+	}
+	if author.Name != "Ada Lovelace" || author.Version != 1 {
+		t.Fatalf("unexpected author: %+v", author)
+	}
+	if _, err := authors.CreateAuthor(ctx, "ada lovelace", "", ""); err != apperrors.ErrDuplicateAuthor {
+		t.Fatalf("expected duplicate author, got %v", err)
+	}
+	if _, err := authors.CreateAuthor(ctx, "Bad Avatar", "", "ftp://example.com/x.png"); err != apperrors.ErrInvalidInput {
+		t.Fatalf("expected invalid input for a non-http avatar, got %v", err)
+	}
+	if _, err := authors.CreateAuthor(ctx, "   ", "", ""); err != apperrors.ErrInvalidInput {
+		t.Fatalf("expected invalid input for a blank name, got %v", err)
+	}
+
+	// A byline naming an existing author links to it.
+	post, err := posts.CreatePost(ctx, "title", "content", "ADA LOVELACE", "", nil)
+	if err != nil {
+		t.Fatalf("create post failed: %v", err)
+	}
+	if post.AuthorID != author.ID || post.Author != "Ada Lovelace" {
+		t.Fatalf("expected the post to link to the existing author, got %+v", post)
+	}
+
+	if _, err := authors.UpdateAuthor(ctx, author.ID, AuthorUpdate{Bio: "x"}, []string{FieldBio}, `"9"`); err != apperrors.ErrVersionConflict {
+		t.Fatalf("expected version conflict for a stale etag, got %v", err)
+	}
+	if _, err := authors.UpdateAuthor(ctx, author.ID, AuthorUpdate{}, []string{"email"}, ""); err != apperrors.ErrInvalidInput {
+		t.Fatalf("expected invalid input for an unknown mask path, got %v", err)
+	}
+	renamed, err := authors.UpdateAuthor(ctx, author.ID, AuthorUpdate{Name: "Augusta Ada King"}, []string{FieldName}, author.ETag())
+	if err != nil {
+		t.Fatalf("rename failed: %v", err)
+	}
+	if renamed.Name != "Augusta Ada King" || renamed.Bio != "First programmer" || renamed.Version != 2 {
+		t.Fatalf("unexpected renamed author: %+v", renamed)
+	}
+
+	loaded, err := posts.GetPost(WithCaller(ctx, "augusta ada king"), post.ID)
+	if err != nil {
+		t.Fatalf("get post after rename failed: %v", err)
+	}
+	if loaded.Author != "Augusta Ada King" {
+		t.Fatalf("expected the rename to reach the byline, got %q", loaded.Author)
+	}
+
+	if err := authors.DeleteAuthor(ctx, author.ID, ""); err != apperrors.ErrAuthorHasPosts {
+		t.Fatalf("expected author has posts, got %v", err)
+	}
+	callerCtx := WithCaller(ctx, "Augusta Ada King")
+	if err := posts.DeletePost(callerCtx, post.ID, ""); err != nil {
+		t.Fatalf("delete post failed: %v", err)
+	}
+	if err := authors.DeleteAuthor(ctx, author.ID, ""); err != apperrors.ErrAuthorHasPosts {
+		t.Fatalf("expected a trashed post to keep its author, got %v", err)
+	}
+	if err := posts.PurgePost(callerCtx, post.ID, ""); err != nil {
+		t.Fatalf("purge failed: %v", err)
+	}
+	if err := authors.DeleteAuthor(ctx, author.ID, renamed.ETag()); err != nil {
+		t.Fatalf("delete author failed: %v", err)
+	}
+	if _, err := authors.GetAuthor(ctx, author.ID); err != apperrors.ErrAuthorNotFound {
+		t.Fatalf("expected author not found, got %v", err)
+	}
+}
+
+func TestAuthorService_ListAuthorsPagination(t *testing.T) {
+	_, authors := newAuthorFixture(t)
+	ctx := context.Background()
+
+	for _, name := range []string{"carol", "Alice", "bob", "Dave", "erin"} {
+		if _, err := authors.CreateAuthor(ctx, name, "", ""); err != nil {
+			t.Fatalf("create %s failed: %v", name, err)
+		}
+	}
+
+	var names []string
+	token := ""
+	for {
+		page, next, err := authors.ListAuthors(ctx, ListAuthorsParams{PageSize: 2, PageToken: token})
+		if err != nil {
+			t.Fatalf("list failed: %v", err)
+		}
+		for _, a := range page {
+			names = append(names, a.Name)
+		}
+		if next == "" {
+			break
+		}
+		token = next
+	}
+
+	if got := strings.Join(names, ","); got != "Alice,bob,carol,Dave,erin" {
+		t.Fatalf("unexpected order: %s", got)
+	}
+	if _, _, err := authors.ListAuthors(ctx, ListAuthorsParams{PageToken: "garbage"}); err != apperrors.ErrInvalidInput {
+		t.Fatalf("expected invalid input for a bad token, got %v", err)
+	}
+}
+
+func TestPostService_MigrateAuthors(t *testing.T) {
+	repo := memory.NewPostRepository()
+	ctx := context.Background()
+
+	// Posts stored before authors existed carry only a byline.
+	for _, post := range []*domain.Post{
+		{ID: "p1", Title: "t", Content: "c", Author: "john doe", PublicationDate: "2026-02-01", Status: domain.StatusPublished},
+		{ID: "p2", Title: "t", Content: "c", Author: "John Doe", PublicationDate: "2026-01-01", Status: domain.StatusPublished},
+		{ID: "p3", Title: "t", Content: "c", Author: "Jane", PublicationDate: "2026-03-01", Status: domain.StatusDraft},
+	} {
+		if err := repo.Create(ctx, post); err != nil {
+			t.Fatalf("seed %s failed: %v", post.ID, err)
+		}
+	}
+
+	authors := memory.NewAuthorRepository()
+	posts := NewPostService(repo, WithAuthors(authors))
+
+	migrated, err := posts.MigrateAuthors(ctx)
+	if err != nil {
+		t.Fatalf("migrate failed: %v", err)
+	}
+	if migrated != 3 {
+		t.Fatalf("expected 3 posts to be linked, got %d", migrated)
+	}
+
+	p1, _ := repo.GetByID(ctx, "p1")
+	p2, _ := repo.GetByID(ctx, "p2")
+	p3, _ := repo.GetByID(ctx, "p3")
+	if p1.AuthorID == "" || p1.AuthorID != p2.AuthorID || p3.AuthorID == "" || p3.AuthorID == p1.AuthorID {
+		t.Fatalf("unexpected author ids: %q, %q, %q", p1.AuthorID, p2.AuthorID, p3.AuthorID)
+	}
+	// The earliest post's spelling becomes the author's name.
+	if p1.Author != "John Doe" || p2.Author != "John Doe" {
+		t.Fatalf("expected bylines to use the earliest spelling, got %q and %q", p1.Author, p2.Author)
+	}
+	all, _ := authors.ListAuthors(ctx)
+	if len(all) != 2 {
+		t.Fatalf("expected 2 authors, got %d", len(all))
+	}
+
+	if migrated, err := posts.MigrateAuthors(ctx); err != nil || migrated != 0 {
+		t.Fatalf("expected a second migration to do nothing, got %d, %v", migrated, err)
+	}
+}
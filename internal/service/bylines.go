@@ -0,0 +1,156 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/BhaveetKumar/gRPC-server-go/internal/domain"
+	apperrors "github.com/BhaveetKumar/gRPC-server-go/internal/errors"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/repository"
+	"github.com/google/uuid"
+)
+
+// WithAuthors links post bylines to the Author records in store, which
+// should be the one given to NewAuthorService. Without it the service keeps
+// its own in-memory authors.
+func WithAuthors(store repository.AuthorRepository) Option {
+	return func(s *postService) {
+		s.authors = store
+	}
+}
+
+// resolveAuthor points post at its Author record and replaces the byline
+// with the author's name. A post that already has an AuthorID keeps that
+// author while it exists; otherwise the author is looked up by the byline
+// and created if no author has that name yet.
+func (s *postService) resolveAuthor(ctx context.Context, post *domain.Post) error {
+	if post.AuthorID != "" {
+		author, err := s.authors.GetAuthor(ctx, post.AuthorID)
+		if err == nil {
+			post.Author = author.Name
+			return nil
+		}
+		if !errors.Is(err, apperrors.ErrAuthorNotFound) {
+			return err
+		}
+	}
+
+	author, err := s.authors.GetAuthorByName(ctx, post.Author)
+	if errors.Is(err, apperrors.ErrAuthorNotFound) {
+		now := s.clock.Now()
+		author = &domain.Author{
+			ID:        uuid.NewString(),
+			Name:      domain.CleanAuthorName(post.Author),
+			CreatedAt: now,
+			UpdatedAt: now,
+		}
+		if err = author.Validate(); err != nil {
+			return err
+		}
+		err = s.authors.CreateAuthor(ctx, author)
+		if errors.Is(err, apperrors.ErrDuplicateAuthor) {
+			// Another write created the author first.
+			author, err = s.authors.GetAuthorByName(ctx, post.Author)
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	post.AuthorID = author.ID
+	post.Author = author.Name
+	return nil
+}
+
+func (s *postService) MigrateAuthors(ctx context.Context) (int, error) {
+	posts, err := s.repo.List(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	// Link the oldest posts first so an author's earliest byline is the
+	// spelling the new record keeps.
+	sort.Slice(posts, func(i, j int) bool {
+		return postLess(posts[i], posts[j], OrderPublicationDateAsc)
+	})
+
+	return s.rewriteEach(ctx, posts, func(post *domain.Post) (bool, error) {
+		if post.AuthorID != "" {
+			return false, nil
+		}
+		return true, s.resolveAuthor(ctx, post)
+	})
+}
+
+func (s *postService) RenameAuthor(ctx context.Context, author *domain.Author) error {
+	posts, err := s.repo.List(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.rewriteEach(ctx, posts, func(post *domain.Post) (bool, error) {
+		if post.AuthorID != author.ID || post.Author == author.Name {
+			return false, nil
+		}
+		post.Author = author.Name
+		return true, nil
+	})
+	return err
+}
+
+func (s *postService) AuthorHasPosts(ctx context.Context, authorID string) (bool, error) {
+	posts, err := s.repo.List(ctx)
+	if err != nil {
+		return false, err
+	}
+	for _, post := range posts {
+		if post.AuthorID == authorID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// rewriteEach stores change applied to each of posts, trashed ones included,
+// and returns how many it rewrote. change reports whether it modified the
+// post. Posts written concurrently are reloaded and changed again; posts
+// purged in the meantime are skipped. These writes bypass moderation since
+// they only touch how the byline or tags are spelled, not what the post says.
+func (s *postService) rewriteEach(ctx context.Context, posts []*domain.Post, change func(*domain.Post) (bool, error)) (int, error) {
+	rewritten := 0
+	for _, post := range posts {
+		for {
+			previous := post.Clone()
+			changed, err := change(post)
+			if err != nil {
+				return rewritten, err
+			}
+			if !changed {
+				break
+			}
+
+			err = s.repo.Update(ctx, post, previous.Version)
+			if errors.Is(err, apperrors.ErrVersionConflict) {
+				if post, err = s.repo.GetByID(ctx, previous.ID); err == nil {
+					continue
+				}
+			}
+			if errors.Is(err, apperrors.ErrPostNotFound) {
+				break
+			}
+			if err != nil {
+				return rewritten, err
+			}
+
+			rewritten++
+			s.indexPost(post)
+			if !post.Deleted() {
+				s.events.publish(domain.PostUpdated, post)
+			}
+			s.recordRevision(ctx, previous, post)
+			break
+		}
+	}
+	return rewritten, nil
+}
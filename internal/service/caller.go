@@ -0,0 +1,29 @@
+package service
+
+import "context"
+
+type callerKey struct{}
+
+// WithCaller returns a context that identifies the author making a request.
+// Requests without a caller are anonymous and only see published posts.
+func WithCaller(ctx context.Context, author string) context.Context {
+	return context.WithValue(ctx, callerKey{}, author)
+}
+
+func CallerFromContext(ctx context.Context) string {
+	author, _ := ctx.Value(callerKey{}).(string)
+	return author
+}
+
+type moderatorKey struct{}
+
+// WithModeratorRights returns a context whose caller may moderate even if
+// WithModerators does not name them, because their role allows it.
+func WithModeratorRights(ctx context.Context) context.Context {
+	return context.WithValue(ctx, moderatorKey{}, true)
+}
+
+func hasModeratorRights(ctx context.Context) bool {
+	rights, _ := ctx.Value(moderatorKey{}).(bool)
+	return rights
+}
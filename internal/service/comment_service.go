@@ -0,0 +1,329 @@
+package service
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/BhaveetKumar/gRPC-server-go/internal/clock"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/domain"
+	apperrors "github.com/BhaveetKumar/gRPC-server-go/internal/errors"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/repository"
+	"github.com/google/uuid"
+)
+
+// commentService stores comments on the posts of a PostService. A comment
+// can only be read or written by callers who can read its post, so comments
+// on a trashed post are hidden until it is restored; purging the post
+// removes them.
+type commentService struct {
+	repo  repository.CommentRepository
+	posts PostService
+	clock clock.Clock
+
+	// mu serializes writes, so a reply cannot be added under a comment
+	// while that comment is being removed.
+	mu sync.Mutex
+}
+
+var _ CommentService = (*commentService)(nil)
+
+type CommentOption func(*commentService)
+
+func WithCommentClock(c clock.Clock) CommentOption {
+	return func(s *commentService) {
+		s.clock = c
+	}
+}
+
+func NewCommentService(repo repository.CommentRepository, posts PostService, opts ...CommentOption) CommentService {
+	s := &commentService{
+		repo:  repo,
+		posts: posts,
+		clock: clock.Real(),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+func (s *commentService) AddComment(ctx context.Context, postID, parentID, author, content string) (*domain.Comment, error) {
+	now := s.clock.Now()
+	comment := &domain.Comment{
+		ID:        uuid.NewString(),
+		PostID:    postID,
+		ParentID:  parentID,
+		Author:    author,
+		Content:   content,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := comment.Validate(); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.posts.GetPost(ctx, postID); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if parentID != "" {
+		parent, err := s.repo.GetComment(ctx, parentID)
+		if err != nil {
+			return nil, err
+		}
+		if parent.PostID != postID {
+			return nil, apperrors.ErrInvalidInput
+		}
+		if parent.Deleted {
+			return nil, apperrors.ErrCommentNotFound
+		}
+	}
+
+	if err := s.repo.CreateComment(ctx, comment); err != nil {
+		return nil, err
+	}
+	return comment, nil
+}
+
+func (s *commentService) EditComment(ctx context.Context, id, content, etag string) (*domain.Comment, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	comment, err := s.getLive(ctx, id, etag)
+	if err != nil {
+		return nil, err
+	}
+
+	expectedVersion := comment.Version
+	comment.Content = content
+	comment.UpdatedAt = s.clock.Now()
+	if err := comment.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.UpdateComment(ctx, comment, expectedVersion); err != nil {
+		return nil, err
+	}
+	return comment, nil
+}
+
+// DeleteComment removes a comment. A comment that still has replies is
+// replaced by a tombstone instead so the thread keeps its shape; tombstones
+// left without replies by the removal are removed as well.
+func (s *commentService) DeleteComment(ctx context.Context, id, etag string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	comment, err := s.getLive(ctx, id, etag)
+	if err != nil {
+		return err
+	}
+
+	all, err := s.repo.ListComments(ctx, comment.PostID)
+	if err != nil {
+		return err
+	}
+	byID := make(map[string]*domain.Comment, len(all))
+	replies := make(map[string]int, len(all))
+	for _, c := range all {
+		byID[c.ID] = c
+		replies[c.ParentID]++
+	}
+
+	if replies[id] > 0 {
+		expectedVersion := comment.Version
+		comment.Deleted = true
+		comment.Author, comment.Content = "", ""
+		comment.UpdatedAt = s.clock.Now()
+		return s.repo.UpdateComment(ctx, comment, expectedVersion)
+	}
+
+	for {
+		if err := s.repo.DeleteComment(ctx, comment.ID); err != nil {
+			return err
+		}
+		replies[comment.ParentID]--
+
+		parent, ok := byID[comment.ParentID]
+		if !ok || !parent.Deleted || replies[parent.ID] > 0 {
+			return nil
+		}
+		comment = parent
+	}
+}
+
+// CommentAuthor returns the author of a live comment the caller can read.
+func (s *commentService) CommentAuthor(ctx context.Context, id string) (string, error) {
+	comment, err := s.getLive(ctx, id, "")
+	if err != nil {
+		return "", err
+	}
+	return comment.Author, nil
+}
+
+// getLive loads a comment that is not a tombstone, checks etag against it
+// and makes sure the caller can read its post.
+func (s *commentService) getLive(ctx context.Context, id, etag string) (*domain.Comment, error) {
+	if id == "" {
+		return nil, apperrors.ErrInvalidInput
+	}
+
+	comment, err := s.repo.GetComment(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if comment.Deleted {
+		return nil, apperrors.ErrCommentNotFound
+	}
+	if _, err := s.posts.GetPost(ctx, comment.PostID); err != nil {
+		if err == apperrors.ErrPostNotFound {
+			return nil, apperrors.ErrCommentNotFound
+		}
+		return nil, err
+	}
+	if etag != "" && etag != comment.ETag() {
+		return nil, apperrors.ErrVersionConflict
+	}
+	return comment, nil
+}
+
+// ListComments returns the replies below params.ParentID, or the whole
+// discussion of the post when it is empty, in thread order: every comment
+// is followed by its replies, oldest first. Pages resume after the thread
+// position of the last comment returned, so comments added or removed
+// between pages do not shift the results.
+func (s *commentService) ListComments(ctx context.Context, params ListCommentsParams) ([]*ThreadedComment, string, error) {
+	if params.PostID == "" || params.PageSize < 0 {
+		return nil, "", apperrors.ErrInvalidInput
+	}
+
+	pageSize := params.PageSize
+	if pageSize == 0 {
+		pageSize = defaultPageSize
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+
+	fp := commentsFingerprint(params.PostID, params.ParentID)
+	var after threadPath
+	if params.PageToken != "" {
+		token, err := decodePageToken(params.PageToken, fp)
+		if err != nil {
+			return nil, "", err
+		}
+		after, err = parseThreadPath(token.Key)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+
+	if _, err := s.posts.GetPost(ctx, params.PostID); err != nil {
+		return nil, "", err
+	}
+
+	all, err := s.repo.ListComments(ctx, params.PostID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// all is oldest first, so every reply list is as well.
+	children := make(map[string][]*domain.Comment)
+	found := params.ParentID == ""
+	for _, c := range all {
+		children[c.ParentID] = append(children[c.ParentID], c)
+		found = found || c.ID == params.ParentID
+	}
+	if !found {
+		return nil, "", apperrors.ErrCommentNotFound
+	}
+
+	var (
+		page []*ThreadedComment
+		last threadPath
+		more bool
+	)
+	var walk func(parentID string, path threadPath) bool
+	walk = func(parentID string, path threadPath) bool {
+		for _, c := range children[parentID] {
+			p := append(path[:len(path):len(path)], threadStep{at: c.CreatedAt, id: c.ID})
+			if after == nil || p.after(after) {
+				if len(page) == pageSize {
+					more = true
+					return false
+				}
+				page = append(page, &ThreadedComment{Comment: c, Depth: len(path), Replies: len(children[c.ID])})
+				last = p
+			}
+			if !walk(c.ID, p) {
+				return false
+			}
+		}
+		return true
+	}
+	walk(params.ParentID, nil)
+
+	nextPageToken := ""
+	if more {
+		nextPageToken = encodePageToken(pageToken{Key: last.String(), ID: last[len(last)-1].id, Query: fp})
+	}
+
+	return page, nextPageToken, nil
+}
+
+// threadPath locates a comment in thread order: the creation time and ID of
+// each comment from the top of the listing down to the comment itself.
+type threadPath []threadStep
+
+type threadStep struct {
+	at time.Time
+	id string
+}
+
+func (a threadStep) before(b threadStep) bool {
+	if !a.at.Equal(b.at) {
+		return a.at.Before(b.at)
+	}
+	return a.id < b.id
+}
+
+// after reports whether p comes after q in thread order. A reply comes after
+// the comment it answers.
+func (p threadPath) after(q threadPath) bool {
+	for i := 0; i < len(p) && i < len(q); i++ {
+		if q[i].before(p[i]) {
+			return true
+		}
+		if p[i].before(q[i]) {
+			return false
+		}
+	}
+	return len(p) > len(q)
+}
+
+func (p threadPath) String() string {
+	steps := make([]string, len(p))
+	for i, step := range p {
+		steps[i] = strconv.FormatInt(step.at.UnixNano(), 10) + "." + step.id
+	}
+	return strings.Join(steps, "/")
+}
+
+func parseThreadPath(raw string) (threadPath, error) {
+	var path threadPath
+	for _, step := range strings.Split(raw, "/") {
+		nanos, id, ok := strings.Cut(step, ".")
+		n, err := strconv.ParseInt(nanos, 10, 64)
+		if !ok || err != nil || id == "" {
+			return nil, apperrors.ErrInvalidInput
+		}
+		path = append(path, threadStep{at: time.Unix(0, n), id: id})
+	}
+	return path, nil
+}
@@ -0,0 +1,250 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/BhaveetKumar/gRPC-server-go/internal/clock"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/domain"
+	apperrors "github.com/BhaveetKumar/gRPC-server-go/internal/errors"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/repository/memory"
+)
+
+// newCommentFixture returns a post service and a comment service sharing a
+// comment store, whose clock moves forward by a second per comment so that
+// thread order is deterministic.
+func newCommentFixture(t *testing.T) (PostService, CommentService, *commentAdder) {
+	t.Helper()
+
+	comments := memory.NewCommentRepository()
+	posts := NewPostService(memory.NewPostRepository(), WithComments(comments))
+	clk := clock.NewFake(schedulerEpoch)
+	service := NewCommentService(comments, posts, WithCommentClock(clk))
+	return posts, service, &commentAdder{t: t, service: service, clock: clk}
+}
+
+type commentAdder struct {
+	t       *testing.T
+	service CommentService
+	clock   *clock.Fake
+}
+
+func (a *commentAdder) add(postID, parentID, content string) *domain.Comment {
+	a.t.Helper()
+
+	a.clock.Advance(time.Second)
+	comment, err := a.service.AddComment(context.Background(), postID, parentID, "reader", content)
+	if err != nil {
+		a.t.Fatalf("add comment %q failed: %v", content, err)
+	}
+	return comment
+}
+
+// threadOf renders a listing as "content@depth/replies" entries.
+func threadOf(list []*ThreadedComment) string {
+	entries := make([]string, 0, len(list))
+	for _, tc := range list {
+		content := tc.Comment.Content
+		if tc.Comment.Deleted {
+			content = "[deleted]"
+		}
+		entries = append(entries, fmt.Sprintf("%s@%d/%d", content, tc.Depth, tc.Replies))
+	}
+	return strings.Join(entries, " ")
+}
+
+func mustListComments(t *testing.T, service CommentService, params ListCommentsParams) ([]*ThreadedComment, string) {
+	t.Helper()
+
+	list, next, err := service.ListComments(context.Background(), params)
+	if err != nil {
+		t.Fatalf("list comments failed: %v", err)
+	}
+	return list, next
+}
+
+func TestCommentService_NestedThreads(t *testing.T) {
+	posts, service, comments := newCommentFixture(t)
+	post := mustCreatePublished(t, posts, "title", "author", "", nil)
+
+	a := comments.add(post.ID, "", "a")
+	b := comments.add(post.ID, "", "b")
+	a1 := comments.add(post.ID, a.ID, "a1")
+	comments.add(post.ID, b.ID, "b1")
+	comments.add(post.ID, a1.ID, "a1x")
+	comments.add(post.ID, a.ID, "a2")
+
+	list, next := mustListComments(t, service, ListCommentsParams{PostID: post.ID})
+	if got, want := threadOf(list), "a@0/2 a1@1/1 a1x@2/0 a2@1/0 b@0/1 b1@1/0"; got != want || next != "" {
+		t.Fatalf("unexpected thread:\n got %s\nwant %s (next %q)", got, want, next)
+	}
+
+	list, _ = mustListComments(t, service, ListCommentsParams{PostID: post.ID, ParentID: a.ID})
+	if got, want := threadOf(list), "a1@0/1 a1x@1/0 a2@0/0"; got != want {
+		t.Fatalf("unexpected replies:\n got %s\nwant %s", got, want)
+	}
+
+	if _, err := service.AddComment(context.Background(), post.ID, "missing", "reader", "x"); err != apperrors.ErrCommentNotFound {
+		t.Fatalf("expected reply to a missing comment to fail, got %v", err)
+	}
+	other := mustCreatePublished(t, posts, "other", "author", "", nil)
+	if _, err := service.AddComment(context.Background(), other.ID, a.ID, "reader", "x"); err != apperrors.ErrInvalidInput {
+		t.Fatalf("expected reply across posts to fail, got %v", err)
+	}
+	if _, err := service.AddComment(context.Background(), post.ID, "", "reader", ""); err != apperrors.ErrInvalidInput {
+		t.Fatalf("expected empty comment to fail, got %v", err)
+	}
+	if _, _, err := service.ListComments(context.Background(), ListCommentsParams{PostID: post.ID, ParentID: "missing"}); err != apperrors.ErrCommentNotFound {
+		t.Fatalf("expected listing replies of a missing comment to fail, got %v", err)
+	}
+}
+
+func TestCommentService_ListPagination(t *testing.T) {
+	posts, service, comments := newCommentFixture(t)
+	post := mustCreatePublished(t, posts, "title", "author", "", nil)
+
+	a := comments.add(post.ID, "", "a")
+	a1 := comments.add(post.ID, a.ID, "a1")
+	b := comments.add(post.ID, "", "b")
+	comments.add(post.ID, "", "c")
+
+	first, next := mustListComments(t, service, ListCommentsParams{PostID: post.ID, PageSize: 2})
+	if got := threadOf(first); got != "a@0/1 a1@1/0" || next == "" {
+		t.Fatalf("unexpected first page %s (next %q)", got, next)
+	}
+
+	// Removing the last comment of the page does not lose the next one, and
+	// comments added meanwhile show up in their place in the thread.
+	if err := service.DeleteComment(context.Background(), a1.ID, ""); err != nil {
+		t.Fatalf("delete failed: %v", err)
+	}
+	comments.add(post.ID, a.ID, "a2")
+	comments.add(post.ID, b.ID, "b1")
+
+	second, next := mustListComments(t, service, ListCommentsParams{PostID: post.ID, PageSize: 2, PageToken: next})
+	if got := threadOf(second); got != "a2@1/0 b@0/1" || next == "" {
+		t.Fatalf("unexpected second page %s (next %q)", got, next)
+	}
+	third, next := mustListComments(t, service, ListCommentsParams{PostID: post.ID, PageSize: 2, PageToken: next})
+	if got := threadOf(third); got != "b1@1/0 c@0/0" || next != "" {
+		t.Fatalf("unexpected third page %s (next %q)", got, next)
+	}
+
+	if _, _, err := service.ListComments(context.Background(), ListCommentsParams{PostID: post.ID, ParentID: a.ID, PageToken: first[0].Comment.ID}); err != apperrors.ErrInvalidInput {
+		t.Fatalf("expected garbage token to fail, got %v", err)
+	}
+	_, token := mustListComments(t, service, ListCommentsParams{PostID: post.ID, PageSize: 1})
+	if _, _, err := service.ListComments(context.Background(), ListCommentsParams{PostID: post.ID, ParentID: a.ID, PageToken: token}); err != apperrors.ErrInvalidInput {
+		t.Fatalf("expected token from another listing to fail, got %v", err)
+	}
+}
+
+func TestCommentService_EditAndDelete(t *testing.T) {
+	posts, service, comments := newCommentFixture(t)
+	post := mustCreatePublished(t, posts, "title", "author", "", nil)
+	ctx := context.Background()
+
+	a := comments.add(post.ID, "", "a")
+	a1 := comments.add(post.ID, a.ID, "a1")
+	a2 := comments.add(post.ID, a.ID, "a2")
+
+	edited, err := service.EditComment(ctx, a1.ID, "a1 edited", a1.ETag())
+	if err != nil {
+		t.Fatalf("edit failed: %v", err)
+	}
+	if edited.Content != "a1 edited" || edited.Version != 2 || !edited.UpdatedAt.After(edited.CreatedAt) {
+		t.Fatalf("unexpected edited comment: %+v", edited)
+	}
+	if _, err := service.EditComment(ctx, a1.ID, "again", a1.ETag()); err != apperrors.ErrVersionConflict {
+		t.Fatalf("expected version conflict for a stale etag, got %v", err)
+	}
+	if _, err := service.EditComment(ctx, a1.ID, "", ""); err != apperrors.ErrInvalidInput {
+		t.Fatalf("expected empty content to fail, got %v", err)
+	}
+
+	// A comment with replies becomes a tombstone.
+	if err := service.DeleteComment(ctx, a.ID, ""); err != nil {
+		t.Fatalf("delete failed: %v", err)
+	}
+	list, _ := mustListComments(t, service, ListCommentsParams{PostID: post.ID})
+	if got := threadOf(list); got != "[deleted]@0/2 a1 edited@1/0 a2@1/0" {
+		t.Fatalf("unexpected thread after tombstoning: %s", got)
+	}
+	if list[0].Comment.Author != "" || list[0].Comment.Content != "" {
+		t.Fatalf("expected tombstone to drop author and content, got %+v", list[0].Comment)
+	}
+	if _, err := service.EditComment(ctx, a.ID, "x", ""); err != apperrors.ErrCommentNotFound {
+		t.Fatalf("expected editing a tombstone to fail, got %v", err)
+	}
+	if err := service.DeleteComment(ctx, a.ID, ""); err != apperrors.ErrCommentNotFound {
+		t.Fatalf("expected deleting a tombstone to fail, got %v", err)
+	}
+	if _, err := service.AddComment(ctx, post.ID, a.ID, "reader", "x"); err != apperrors.ErrCommentNotFound {
+		t.Fatalf("expected replying to a tombstone to fail, got %v", err)
+	}
+
+	// Removing the last reply of a tombstone removes the tombstone too.
+	if err := service.DeleteComment(ctx, a1.ID, ""); err != nil {
+		t.Fatalf("delete failed: %v", err)
+	}
+	list, _ = mustListComments(t, service, ListCommentsParams{PostID: post.ID})
+	if got := threadOf(list); got != "[deleted]@0/1 a2@1/0" {
+		t.Fatalf("unexpected thread after deleting a reply: %s", got)
+	}
+	if err := service.DeleteComment(ctx, a2.ID, ""); err != nil {
+		t.Fatalf("delete failed: %v", err)
+	}
+	if list, _ = mustListComments(t, service, ListCommentsParams{PostID: post.ID}); len(list) != 0 {
+		t.Fatalf("expected the emptied thread to be gone, got %s", threadOf(list))
+	}
+}
+
+func TestCommentService_FollowsPostLifecycle(t *testing.T) {
+	posts, service, comments := newCommentFixture(t)
+	ctx := context.Background()
+	owner := WithCaller(ctx, "author")
+
+	draft, err := posts.CreatePost(ctx, "draft", "content", "author", "", nil)
+	if err != nil {
+		t.Fatalf("create failed: %v", err)
+	}
+	if _, err := service.AddComment(ctx, draft.ID, "", "reader", "x"); err != apperrors.ErrPostNotFound {
+		t.Fatalf("expected comments on hidden posts to fail, got %v", err)
+	}
+	if _, err := service.AddComment(owner, draft.ID, "", "author", "note to self"); err != nil {
+		t.Fatalf("expected the author to comment on their draft, got %v", err)
+	}
+
+	post := mustCreatePublished(t, posts, "title", "author", "", nil)
+	c := comments.add(post.ID, "", "c")
+
+	if err := posts.DeletePost(ctx, post.ID, ""); err != nil {
+		t.Fatalf("delete post failed: %v", err)
+	}
+	if _, _, err := service.ListComments(ctx, ListCommentsParams{PostID: post.ID}); err != apperrors.ErrPostNotFound {
+		t.Fatalf("expected comments of a trashed post to be hidden, got %v", err)
+	}
+	if _, err := service.EditComment(ctx, c.ID, "x", ""); err != apperrors.ErrCommentNotFound {
+		t.Fatalf("expected comments of a trashed post to be read-only, got %v", err)
+	}
+
+	if _, err := posts.RestorePost(ctx, post.ID, ""); err != nil {
+		t.Fatalf("restore failed: %v", err)
+	}
+	if list, _ := mustListComments(t, service, ListCommentsParams{PostID: post.ID}); len(list) != 1 {
+		t.Fatalf("expected restoring the post to bring its comments back, got %d", len(list))
+	}
+
+	if err := posts.DeletePost(ctx, post.ID, ""); err != nil {
+		t.Fatalf("delete post failed: %v", err)
+	}
+	if err := posts.PurgePost(ctx, post.ID, ""); err != nil {
+		t.Fatalf("purge failed: %v", err)
+	}
+	if _, err := service.EditComment(ctx, c.ID, "x", ""); err != apperrors.ErrCommentNotFound {
+		t.Fatalf("expected purging the post to remove its comments, got %v", err)
+	}
+}
@@ -0,0 +1,86 @@
+package service
+
+import (
+	"context"
+
+	"github.com/BhaveetKumar/gRPC-server-go/internal/diff"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/domain"
+	apperrors "github.com/BhaveetKumar/gRPC-server-go/internal/errors"
+)
+
+const (
+	defaultDiffContext = 3
+	maxDiffContext     = 1000
+)
+
+// DiffPostRevisions compares two revisions of a post: content line by line
+// with word level detail, title and author as whole values and tags as sets.
+func (s *postService) DiffPostRevisions(ctx context.Context, params DiffRevisionsParams) (*PostDiff, error) {
+	if params.From <= 0 || params.To < 0 || params.ContextLines < 0 || params.ContextLines > maxDiffContext {
+		return nil, apperrors.ErrInvalidInput
+	}
+
+	if _, err := s.GetPost(ctx, params.PostID); err != nil {
+		return nil, err
+	}
+
+	from, err := s.revisions.GetRevision(ctx, params.PostID, params.From)
+	if err != nil {
+		return nil, err
+	}
+
+	var to *domain.Revision
+	if params.To == 0 {
+		revs, err := s.revisions.ListRevisions(ctx, params.PostID)
+		if err != nil {
+			return nil, err
+		}
+		if len(revs) == 0 {
+			return nil, apperrors.ErrRevisionNotFound
+		}
+		to = revs[0]
+	} else {
+		to, err = s.revisions.GetRevision(ctx, params.PostID, params.To)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	contextLines := params.ContextLines
+	if contextLines == 0 {
+		contextLines = defaultDiffContext
+	}
+
+	content, err := diff.Lines(ctx, from.Post.Content, to.Post.Content, contextLines)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &PostDiff{
+		PostID:      params.PostID,
+		From:        from.Post.Version,
+		To:          to.Post.Version,
+		AddedTags:   missingFrom(to.Post.Tags, from.Post.Tags),
+		RemovedTags: missingFrom(from.Post.Tags, to.Post.Tags),
+		Content:     content,
+	}
+	if from.Post.Title != to.Post.Title {
+		result.Fields = append(result.Fields, FieldChange{Field: FieldTitle, Old: from.Post.Title, New: to.Post.Title})
+	}
+	if from.Post.Author != to.Post.Author {
+		result.Fields = append(result.Fields, FieldChange{Field: FieldAuthor, Old: from.Post.Author, New: to.Post.Author})
+	}
+
+	return result, nil
+}
+
+// missingFrom returns the tags in tags that are not in other, in order.
+func missingFrom(tags, other []string) []string {
+	var result []string
+	for _, tag := range tags {
+		if !hasTag(other, tag) && !hasTag(result, tag) {
+			result = append(result, tag)
+		}
+	}
+	return result
+}
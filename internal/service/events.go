@@ -0,0 +1,145 @@
+package service
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/BhaveetKumar/gRPC-server-go/internal/domain"
+	apperrors "github.com/BhaveetKumar/gRPC-server-go/internal/errors"
+)
+
+const (
+	eventHistorySize     = 1024
+	subscriberBufferSize = 64
+)
+
+type subscriber struct {
+	events  chan domain.PostEvent
+	dropped chan struct{}
+}
+
+// eventBroker fans post changes out to watchers. It keeps a bounded history so
+// that a reconnecting watcher can resume from the last sequence it saw, and it
+// drops any subscriber whose buffer fills up instead of blocking writers.
+// Sequences start over with every broker, so each has a random epoch that
+// tells its resume tokens apart from those of an earlier process.
+type eventBroker struct {
+	mu          sync.Mutex
+	epoch       string
+	seq         uint64
+	history     []domain.PostEvent
+	subscribers map[*subscriber]struct{}
+	now         func() time.Time
+}
+
+func newEventBroker() *eventBroker {
+	return &eventBroker{
+		epoch:       newEpoch(),
+		subscribers: make(map[*subscriber]struct{}),
+		now:         time.Now,
+	}
+}
+
+func (b *eventBroker) publish(eventType domain.PostEventType, post *domain.Post) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.seq++
+	event := domain.PostEvent{
+		Epoch:      b.epoch,
+		Sequence:   b.seq,
+		Type:       eventType,
+		Post:       post.Clone(),
+		OccurredAt: b.now(),
+	}
+
+	b.history = append(b.history, event)
+	if len(b.history) > eventHistorySize {
+		b.history = b.history[len(b.history)-eventHistorySize:]
+	}
+
+	for sub := range b.subscribers {
+		select {
+		case sub.events <- event:
+		default:
+			close(sub.dropped)
+			delete(b.subscribers, sub)
+		}
+	}
+}
+
+// subscribe registers a new subscriber. When resume is set, every retained
+// event after the given sequence of epoch is returned as a backlog;
+// registration and backlog capture happen under the same lock so no event is
+// missed or repeated. Sequence 0 without an epoch resumes from the oldest
+// retained event; tokens of any other epoch have expired with the broker
+// that issued them.
+func (b *eventBroker) subscribe(epoch string, after uint64, resume bool) (*subscriber, []domain.PostEvent, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var backlog []domain.PostEvent
+	if resume {
+		if epoch != b.epoch && (epoch != "" || after != 0) {
+			return nil, nil, apperrors.ErrResumeTokenExpired
+		}
+		if after > b.seq {
+			return nil, nil, apperrors.ErrInvalidInput
+		}
+		if after < b.seq && (len(b.history) == 0 || b.history[0].Sequence > after+1) {
+			return nil, nil, apperrors.ErrResumeTokenExpired
+		}
+		for _, event := range b.history {
+			if event.Sequence > after {
+				backlog = append(backlog, event)
+			}
+		}
+	}
+
+	sub := &subscriber{
+		events:  make(chan domain.PostEvent, subscriberBufferSize),
+		dropped: make(chan struct{}),
+	}
+	b.subscribers[sub] = struct{}{}
+
+	return sub, backlog, nil
+}
+
+func (b *eventBroker) unsubscribe(sub *subscriber) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	delete(b.subscribers, sub)
+}
+
+func newEpoch() string {
+	var buf [8]byte
+	_, _ = rand.Read(buf[:])
+	return hex.EncodeToString(buf[:])
+}
+
+// ResumeToken returns the token that resumes watching after event.
+func ResumeToken(event *domain.PostEvent) string {
+	return event.Epoch + "." + strconv.FormatUint(event.Sequence, 10)
+}
+
+// parseResumeToken splits a token into its epoch and sequence. Tokens from
+// before epochs were added are a bare sequence and parse with no epoch.
+func parseResumeToken(token string) (string, uint64, error) {
+	epoch, seq, ok := strings.Cut(token, ".")
+	if !ok {
+		epoch, seq = "", token
+	} else if epoch == "" {
+		return "", 0, apperrors.ErrInvalidInput
+	}
+
+	after, err := strconv.ParseUint(seq, 10, 64)
+	if err != nil {
+		return "", 0, apperrors.ErrInvalidInput
+	}
+	return epoch, after, nil
+}
@@ -2,13 +2,279 @@ package service
 
 import (
 	"context"
+	"time"
 
+	"github.com/BhaveetKumar/gRPC-server-go/internal/diff"
 	"github.com/BhaveetKumar/gRPC-server-go/internal/domain"
 )
 
+type PostOrder int
+
+const (
+	OrderPublicationDateDesc PostOrder = iota
+	OrderPublicationDateAsc
+	OrderTitleAsc
+	OrderTitleDesc
+	// OrderIDAsc and OrderIDDesc list posts in creation order and are only
+	// available with a time-ordered ID generator.
+	OrderIDAsc
+	OrderIDDesc
+)
+
+type ListPostsParams struct {
+	PageSize        int
+	PageToken       string
+	Author          string
+	AuthorID        string
+	Tag             string
+	PublishedAfter  string
+	PublishedBefore string
+	OrderBy         PostOrder
+	Status          domain.PostStatus
+	// ShowDeleted also returns the caller's own posts that are in the trash.
+	ShowDeleted bool
+}
+
+const (
+	FieldTitle           = "title"
+	FieldContent         = "content"
+	FieldAuthor          = "author"
+	FieldPublicationDate = "publication_date"
+	FieldTags            = "tags"
+	FieldStatus          = "status"
+	// FieldModeration is reported in revisions that changed a post's
+	// moderation state; it cannot be updated directly.
+	FieldModeration = "moderation"
+	// FieldDeleteTime is reported in revisions that moved a post into or
+	// out of the trash; it cannot be updated directly.
+	FieldDeleteTime = "delete_time"
+)
+
+type PostUpdate struct {
+	Title           string
+	Content         string
+	Author          string
+	PublicationDate string
+	Tags            []string
+	Status          domain.PostStatus
+}
+
+type WatchPostsParams struct {
+	Author      string
+	Tag         string
+	ResumeToken string
+}
+
+type SearchPostsParams struct {
+	Query     string
+	PageSize  int
+	PageToken string
+}
+
+type SearchResult struct {
+	Post    *domain.Post
+	Score   float64
+	Snippet string
+}
+
+type TagOrder int
+
+const (
+	// TagOrderCount lists the most used tags first.
+	TagOrderCount TagOrder = iota
+	TagOrderName
+)
+
+type ListTagsParams struct {
+	PageSize  int
+	PageToken string
+	OrderBy   TagOrder
+}
+
+// TagCount is a tag and the number of posts every reader can see that carry
+// it.
+type TagCount struct {
+	Tag   string
+	Posts int
+}
+
+type ListPendingPostsParams struct {
+	PageSize  int
+	PageToken string
+}
+
+type ListRevisionsParams struct {
+	PostID    string
+	PageSize  int
+	PageToken string
+}
+
+type DiffRevisionsParams struct {
+	PostID string
+	From   int64
+	// To of 0 diffs against the newest revision.
+	To int64
+	// ContextLines of 0 uses defaultDiffContext.
+	ContextLines int
+}
+
+// FieldChange is a single-valued field whose value differs between two
+// revisions.
+type FieldChange struct {
+	Field string
+	Old   string
+	New   string
+}
+
+type PostDiff struct {
+	PostID      string
+	From        int64
+	To          int64
+	Fields      []FieldChange
+	AddedTags   []string
+	RemovedTags []string
+	Content     []diff.Hunk
+}
+
+type ListCommentsParams struct {
+	PostID string
+	// ParentID limits the listing to the replies below one comment.
+	ParentID  string
+	PageSize  int
+	PageToken string
+}
+
+// ThreadedComment is a comment at its place in a listed thread. Depth counts
+// the comments between it and the top of the listing and Replies is its
+// number of direct replies.
+type ThreadedComment struct {
+	Comment *domain.Comment
+	Depth   int
+	Replies int
+}
+
 type PostService interface {
 	CreatePost(ctx context.Context, title, content, author, publicationDate string, tags []string) (*domain.Post, error)
 	GetPost(ctx context.Context, id string) (*domain.Post, error)
-	UpdatePost(ctx context.Context, id, title, content, author string, tags []string) (*domain.Post, error)
-	DeletePost(ctx context.Context, id string) error
+	// PostAuthor returns the byline of any stored post, trashed or not, for
+	// deciding whether the caller may change it.
+	PostAuthor(ctx context.Context, id string) (string, error)
+	// GetPostBySlug finds a post by its current slug or one it held before,
+	// in which case redirect is true and the post's Slug is where the old
+	// one now leads.
+	GetPostBySlug(ctx context.Context, slug string) (post *domain.Post, redirect bool, err error)
+	UpdatePost(ctx context.Context, id string, update PostUpdate, mask []string, etag string) (*domain.Post, error)
+	DeletePost(ctx context.Context, id, etag string) error
+	RestorePost(ctx context.Context, id, etag string) (*domain.Post, error)
+	PurgePost(ctx context.Context, id, etag string) error
+	PublishPost(ctx context.Context, id, etag string) (*domain.Post, error)
+	UnpublishPost(ctx context.Context, id, etag string) (*domain.Post, error)
+	ArchivePost(ctx context.Context, id, etag string) (*domain.Post, error)
+	SchedulePost(ctx context.Context, id string, publishAt time.Time, etag string) (*domain.Post, error)
+	ListPosts(ctx context.Context, params ListPostsParams) ([]*domain.Post, string, error)
+	WatchPosts(ctx context.Context, params WatchPostsParams, send func(*domain.PostEvent) error) error
+	SearchPosts(ctx context.Context, params SearchPostsParams) ([]*SearchResult, string, error)
+	// RebuildIndexes replaces the search and tag indexes with the current
+	// contents of the repository. It is called once at startup.
+	RebuildIndexes(ctx context.Context) error
+	RunScheduler(ctx context.Context)
+	RunPurger(ctx context.Context)
+	ListPostRevisions(ctx context.Context, params ListRevisionsParams) ([]*domain.Revision, string, error)
+	GetPostRevision(ctx context.Context, postID string, revision int64) (*domain.Revision, error)
+	RestorePostRevision(ctx context.Context, postID string, revision int64, etag string) (*domain.Post, error)
+	DiffPostRevisions(ctx context.Context, params DiffRevisionsParams) (*PostDiff, error)
+	// ListPendingPosts, ApprovePost and RejectPost are only available to
+	// moderators.
+	ListPendingPosts(ctx context.Context, params ListPendingPostsParams) ([]*domain.Post, string, error)
+	ApprovePost(ctx context.Context, id, etag string) (*domain.Post, error)
+	RejectPost(ctx context.Context, id, reason, etag string) (*domain.Post, error)
+	// MigrateAuthors links the posts stored before authors existed to
+	// Author records, creating them from the bylines. It is called once at
+	// startup and returns the number of posts it linked.
+	MigrateAuthors(ctx context.Context) (int, error)
+	// RenameAuthor rewrites the byline of every post by author to its
+	// current name, and AuthorHasPosts reports whether any post, trashed or
+	// not, is by the author. Both serve AuthorService.
+	RenameAuthor(ctx context.Context, author *domain.Author) error
+	AuthorHasPosts(ctx context.Context, authorID string) (bool, error)
+	ListTags(ctx context.Context, params ListTagsParams) ([]*TagCount, string, error)
+	// RenameTag and MergeTags replace tags on every post, trashed ones
+	// included, in one atomic write and return the number of posts changed.
+	// RenameTag fails with ErrDuplicateTag if a post already has the new
+	// tag; merging into a tag in use is what MergeTags is for. Both are only
+	// available to moderators.
+	RenameTag(ctx context.Context, from, to string) (int, error)
+	MergeTags(ctx context.Context, sources []string, target string) (int, error)
+	// MigrateSlugs gives the posts stored before slugs existed a slug. It is
+	// called once at startup and returns the number of posts it rewrote.
+	MigrateSlugs(ctx context.Context) (int, error)
+	// MigrateTags normalizes the tags of posts stored before tags were
+	// normalized. It is called once at startup and returns the number of
+	// posts it rewrote.
+	MigrateTags(ctx context.Context) (int, error)
+}
+
+// Author fields that can be named in an UpdateAuthor mask.
+const (
+	FieldName      = "name"
+	FieldBio       = "bio"
+	FieldAvatarURL = "avatar_url"
+)
+
+type AuthorUpdate struct {
+	Name      string
+	Bio       string
+	AvatarURL string
+}
+
+type ListAuthorsParams struct {
+	PageSize  int
+	PageToken string
+}
+
+type CommentService interface {
+	// AddComment adds a comment to a post, or a reply to another comment on
+	// it when parentID is set.
+	AddComment(ctx context.Context, postID, parentID, author, content string) (*domain.Comment, error)
+	ListComments(ctx context.Context, params ListCommentsParams) ([]*ThreadedComment, string, error)
+	EditComment(ctx context.Context, id, content, etag string) (*domain.Comment, error)
+	DeleteComment(ctx context.Context, id, etag string) error
+	// CommentAuthor returns the author of a comment, for deciding whether
+	// the caller may change it.
+	CommentAuthor(ctx context.Context, id string) (string, error)
+}
+
+// AuthorService manages author profiles. Authors are also created
+// implicitly by PostService the first time a byline names them.
+type AuthorService interface {
+	CreateAuthor(ctx context.Context, name, bio, avatarURL string) (*domain.Author, error)
+	GetAuthor(ctx context.Context, id string) (*domain.Author, error)
+	// UpdateAuthor renames the bylines of the author's posts along with
+	// the author.
+	UpdateAuthor(ctx context.Context, id string, update AuthorUpdate, mask []string, etag string) (*domain.Author, error)
+	// DeleteAuthor fails with ErrAuthorHasPosts while any post is by the
+	// author.
+	DeleteAuthor(ctx context.Context, id, etag string) error
+	ListAuthors(ctx context.Context, params ListAuthorsParams) ([]*domain.Author, string, error)
+}
+
+type ListAPIKeysParams struct {
+	PageSize  int
+	PageToken string
+}
+
+// APIKeyService issues and checks the API keys that callers can present
+// instead of a token. Only a hash of each key is stored, so the raw key is
+// returned once, by IssueAPIKey.
+type APIKeyService interface {
+	// IssueAPIKey creates a key acting as owner with the given scopes. A zero
+	// ttl makes a key that does not expire.
+	IssueAPIKey(ctx context.Context, name, owner string, scopes []string, ttl time.Duration) (*domain.APIKey, string, error)
+	// ListAPIKeys returns keys oldest first, revoked and expired ones
+	// included.
+	ListAPIKeys(ctx context.Context, params ListAPIKeysParams) ([]*domain.APIKey, string, error)
+	RevokeAPIKey(ctx context.Context, id string) (*domain.APIKey, error)
+	// VerifyAPIKey returns the key a raw key belongs to, or
+	// ErrUnauthenticated if it is malformed, unknown, expired or revoked.
+	VerifyAPIKey(ctx context.Context, raw string) (*domain.APIKey, error)
 }
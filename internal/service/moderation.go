@@ -0,0 +1,198 @@
+package service
+
+import (
+	"context"
+	"sort"
+
+	"github.com/BhaveetKumar/gRPC-server-go/internal/domain"
+	apperrors "github.com/BhaveetKumar/gRPC-server-go/internal/errors"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/moderation"
+)
+
+// WithModeration runs pipeline over every new post and every write that
+// changes a post's title, content, author or tags. Without a pipeline every
+// post is approved.
+func WithModeration(pipeline *moderation.Pipeline) Option {
+	return func(s *postService) {
+		s.moderation = pipeline
+	}
+}
+
+// WithModerators names the callers allowed to list, approve and reject
+// pending posts.
+func WithModerators(names ...string) Option {
+	return func(s *postService) {
+		for _, name := range names {
+			if name != "" {
+				s.moderators[name] = true
+			}
+		}
+	}
+}
+
+// moderate decides the moderation state of post, which previous was before
+// the write, or of a new post when previous is nil. Writes that leave the
+// moderated fields alone keep the previous state.
+func (s *postService) moderate(ctx context.Context, previous, post *domain.Post) error {
+	if previous != nil && !moderatedChange(previous, post) {
+		return nil
+	}
+
+	var verdict moderation.Verdict
+	if s.moderation != nil {
+		var err error
+		verdict, err = s.moderation.Evaluate(ctx, &moderation.Submission{Post: post, Previous: previous, History: postHistory{s}})
+		if err != nil {
+			return err
+		}
+	}
+
+	// A moderator's rejection cannot be undone by editing the post; the new
+	// content goes back to the queue instead.
+	if verdict.Decision == moderation.Approve && previous != nil &&
+		previous.Moderation.State == domain.ModerationRejected && previous.Moderation.Moderator != "" {
+		verdict = moderation.Verdict{Decision: moderation.Hold, Reason: "resubmitted after being rejected by " + previous.Moderation.Moderator}
+	}
+
+	state := domain.ModerationApproved
+	switch verdict.Decision {
+	case moderation.Hold:
+		state = domain.ModerationPending
+	case moderation.Reject:
+		state = domain.ModerationRejected
+	}
+
+	decidedAt := s.clock.Now()
+	if previous != nil && previous.Moderation.Current() == state {
+		// Keep the post's place in the review queue.
+		decidedAt = previous.Moderation.DecidedAt
+	}
+	post.Moderation = domain.Moderation{State: state, Reason: verdict.Reason, DecidedAt: decidedAt}
+	return nil
+}
+
+func moderatedChange(before, after *domain.Post) bool {
+	return before.Title != after.Title || before.Content != after.Content ||
+		before.Author != after.Author || !equalTags(before.Tags, after.Tags)
+}
+
+// postHistory answers moderation rules' questions from the repository.
+type postHistory struct {
+	s *postService
+}
+
+func (h postHistory) HasApprovedPost(ctx context.Context, author string) (bool, error) {
+	posts, err := h.s.repo.List(ctx)
+	if err != nil {
+		return false, err
+	}
+	for _, post := range posts {
+		if domain.SameAuthor(author, post.Author) && !post.Deleted() && post.Moderation.Approved() {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (s *postService) requireModerator(ctx context.Context) error {
+	if !s.moderators[CallerFromContext(ctx)] && !hasModeratorRights(ctx) {
+		return apperrors.ErrPermissionDenied
+	}
+	return nil
+}
+
+// ListPendingPosts returns the posts waiting for a moderator, longest
+// waiting first.
+func (s *postService) ListPendingPosts(ctx context.Context, params ListPendingPostsParams) ([]*domain.Post, string, error) {
+	if err := s.requireModerator(ctx); err != nil {
+		return nil, "", err
+	}
+	if params.PageSize < 0 {
+		return nil, "", apperrors.ErrInvalidInput
+	}
+
+	pageSize := params.PageSize
+	if pageSize == 0 {
+		pageSize = defaultPageSize
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+
+	var cursor *pageToken
+	if params.PageToken != "" {
+		token, err := decodePageToken(params.PageToken, pendingFingerprint)
+		if err != nil {
+			return nil, "", err
+		}
+		cursor = token
+	}
+
+	all, err := s.repo.List(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	pending := make([]*domain.Post, 0)
+	for _, post := range all {
+		if !post.Deleted() && post.Moderation.State == domain.ModerationPending {
+			pending = append(pending, post)
+		}
+	}
+
+	key := func(post *domain.Post) string {
+		return post.Moderation.DecidedAt.UTC().Format(sortableTime)
+	}
+	sort.Slice(pending, func(i, j int) bool {
+		return compareKeys(key(pending[i]), pending[i].ID, key(pending[j]), pending[j].ID, OrderPublicationDateAsc) < 0
+	})
+
+	start := 0
+	if cursor != nil {
+		start = sort.Search(len(pending), func(i int) bool {
+			return compareKeys(cursor.Key, cursor.ID, key(pending[i]), pending[i].ID, OrderPublicationDateAsc) < 0
+		})
+	}
+
+	end := min(start+pageSize, len(pending))
+	page := pending[start:end]
+
+	nextToken := ""
+	if end < len(pending) {
+		last := page[len(page)-1]
+		nextToken = encodePageToken(pageToken{Key: key(last), ID: last.ID, Query: pendingFingerprint})
+	}
+
+	return page, nextToken, nil
+}
+
+// ApprovePost makes a pending or rejected post visible to readers once it
+// is published.
+func (s *postService) ApprovePost(ctx context.Context, id, etag string) (*domain.Post, error) {
+	return s.review(ctx, id, etag, domain.ModerationApproved, "")
+}
+
+// RejectPost hides a post from readers until its author edits it and a
+// moderator approves the new content.
+func (s *postService) RejectPost(ctx context.Context, id, reason, etag string) (*domain.Post, error) {
+	return s.review(ctx, id, etag, domain.ModerationRejected, reason)
+}
+
+func (s *postService) review(ctx context.Context, id, etag string, state domain.ModerationState, reason string) (*domain.Post, error) {
+	if err := s.requireModerator(ctx); err != nil {
+		return nil, err
+	}
+
+	return s.modify(ctx, id, etag, func(post *domain.Post) error {
+		if post.Moderation.Current() == state {
+			return apperrors.ErrInvalidTransition
+		}
+		post.Moderation = domain.Moderation{
+			State:     state,
+			Reason:    reason,
+			Moderator: CallerFromContext(ctx),
+			DecidedAt: s.clock.Now(),
+		}
+		return nil
+	})
+}
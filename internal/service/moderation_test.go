@@ -0,0 +1,140 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/BhaveetKumar/gRPC-server-go/internal/domain"
+	apperrors "github.com/BhaveetKumar/gRPC-server-go/internal/errors"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/moderation"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/repository/memory"
+)
+
+func newModeratedService() PostService {
+	pipeline := moderation.NewPipeline(moderation.BannedWords("spam"), moderation.FirstPostApproval())
+	return NewPostService(memory.NewPostRepository(), WithModeration(pipeline), WithModerators("mod"))
+}
+
+func TestPostService_ModerationStates(t *testing.T) {
+	service := newModeratedService()
+	ctx := context.Background()
+	mod := WithCaller(ctx, "mod")
+
+	first := mustCreatePublished(t, service, "hello", "alice", "", nil)
+	if first.Moderation.State != domain.ModerationPending || first.Moderation.Reason != "first_post: first post by alice needs approval" {
+		t.Fatalf("expected a first post to be held, got %+v", first.Moderation)
+	}
+	if _, err := service.GetPost(ctx, first.ID); err != apperrors.ErrPostNotFound {
+		t.Fatalf("expected a pending post to be hidden from readers, got %v", err)
+	}
+	if _, err := service.GetPost(WithCaller(ctx, "alice"), first.ID); err != nil {
+		t.Fatalf("expected the author to see their pending post, got %v", err)
+	}
+
+	approved, err := service.ApprovePost(mod, first.ID, first.ETag())
+	if err != nil {
+		t.Fatalf("approve failed: %v", err)
+	}
+	if approved.Moderation.State != domain.ModerationApproved || approved.Moderation.Moderator != "mod" {
+		t.Fatalf("unexpected moderation after approval: %+v", approved.Moderation)
+	}
+	if _, err := service.GetPost(ctx, first.ID); err != nil {
+		t.Fatalf("expected an approved post to be public, got %v", err)
+	}
+	if _, err := service.ApprovePost(mod, first.ID, ""); err != apperrors.ErrInvalidTransition {
+		t.Fatalf("expected approving twice to fail, got %v", err)
+	}
+
+	second := mustCreatePublished(t, service, "again", "alice", "", nil)
+	if second.Moderation.State != domain.ModerationApproved {
+		t.Fatalf("expected a known author's post to be approved, got %+v", second.Moderation)
+	}
+
+	spam, err := service.UpdatePost(ctx, second.ID, PostUpdate{Content: "buy SPAM"}, []string{FieldContent}, "")
+	if err != nil {
+		t.Fatalf("update failed: %v", err)
+	}
+	if spam.Moderation.State != domain.ModerationRejected {
+		t.Fatalf("expected banned words to reject the edit, got %+v", spam.Moderation)
+	}
+	fixed, err := service.UpdatePost(ctx, second.ID, PostUpdate{Content: "fine"}, []string{FieldContent}, "")
+	if err != nil || fixed.Moderation.State != domain.ModerationApproved {
+		t.Fatalf("expected fixing the content to approve it again, got %+v, %v", fixed.Moderation, err)
+	}
+
+	// Status changes leave the moderation state alone.
+	rejected, err := service.RejectPost(mod, first.ID, "off topic", "")
+	if err != nil {
+		t.Fatalf("reject failed: %v", err)
+	}
+	if rejected.Moderation.Reason != "off topic" {
+		t.Fatalf("unexpected moderation after rejection: %+v", rejected.Moderation)
+	}
+	if unpublished, _ := service.UnpublishPost(ctx, first.ID, ""); unpublished.Moderation.State != domain.ModerationRejected {
+		t.Fatalf("expected unpublishing to keep the rejection, got %+v", unpublished.Moderation)
+	}
+
+	// Editing a post a moderator rejected sends it back to the queue.
+	resubmitted, err := service.UpdatePost(ctx, first.ID, PostUpdate{Title: "hello again"}, []string{FieldTitle}, "")
+	if err != nil {
+		t.Fatalf("update failed: %v", err)
+	}
+	if resubmitted.Moderation.State != domain.ModerationPending || resubmitted.Moderation.Reason != "resubmitted after being rejected by mod" {
+		t.Fatalf("expected the resubmission to be held, got %+v", resubmitted.Moderation)
+	}
+}
+
+func TestPostService_ModerationQueue(t *testing.T) {
+	service := newModeratedService()
+	ctx := context.Background()
+	mod := WithCaller(ctx, "mod")
+
+	if _, _, err := service.ListPendingPosts(WithCaller(ctx, "alice"), ListPendingPostsParams{}); err != apperrors.ErrPermissionDenied {
+		t.Fatalf("expected non-moderators to be denied, got %v", err)
+	}
+	if _, err := service.ApprovePost(ctx, "id", ""); err != apperrors.ErrPermissionDenied {
+		t.Fatalf("expected anonymous callers to be denied, got %v", err)
+	}
+	if _, _, err := service.ListPendingPosts(WithModeratorRights(WithCaller(ctx, "editor")), ListPendingPostsParams{}); err != nil {
+		t.Fatalf("expected callers with moderator rights to be allowed, got %v", err)
+	}
+
+	var ids []string
+	for _, author := range []string{"a", "b", "c"} {
+		post, err := service.CreatePost(ctx, "title", "content", author, "", nil)
+		if err != nil {
+			t.Fatalf("create failed: %v", err)
+		}
+		ids = append(ids, post.ID)
+	}
+	trashed, _ := service.CreatePost(ctx, "title", "content", "d", "", nil)
+	if err := service.DeletePost(ctx, trashed.ID, ""); err != nil {
+		t.Fatalf("delete failed: %v", err)
+	}
+
+	var queued []string
+	token := ""
+	for {
+		page, next, err := service.ListPendingPosts(mod, ListPendingPostsParams{PageSize: 2, PageToken: token})
+		if err != nil {
+			t.Fatalf("list pending failed: %v", err)
+		}
+		for _, post := range page {
+			queued = append(queued, post.ID)
+		}
+		if token = next; token == "" {
+			break
+		}
+	}
+	if len(queued) != 3 {
+		t.Fatalf("expected the three live pending posts, got %v", queued)
+	}
+
+	if _, err := service.RejectPost(mod, ids[1], "no", ""); err != nil {
+		t.Fatalf("reject failed: %v", err)
+	}
+	page, _, _ := service.ListPendingPosts(mod, ListPendingPostsParams{})
+	if len(page) != 2 {
+		t.Fatalf("expected rejected posts to leave the queue, got %d", len(page))
+	}
+}
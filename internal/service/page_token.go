@@ -0,0 +1,72 @@
+package service
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	apperrors "github.com/BhaveetKumar/gRPC-server-go/internal/errors"
+)
+
+type pageToken struct {
+	Key   string `json:"k"`
+	ID    string `json:"i"`
+	Query string `json:"q"`
+}
+
+func queryFingerprint(params ListPostsParams) string {
+	return fingerprint(fmt.Sprintf("%s\x00%s\x00%s\x00%s\x00%d\x00%s\x00%t\x00%s", params.Author, params.Tag, params.PublishedAfter, params.PublishedBefore, params.OrderBy, params.Status, params.ShowDeleted, params.AuthorID))
+}
+
+func searchFingerprint(query string) string {
+	return fingerprint("search\x00" + query)
+}
+
+func revisionsFingerprint(postID string) string {
+	return fingerprint("revisions\x00" + postID)
+}
+
+var pendingFingerprint = fingerprint("pending")
+
+var authorsFingerprint = fingerprint("authors")
+
+var apiKeysFingerprint = fingerprint("api_keys")
+
+func tagsFingerprint(order TagOrder) string {
+	return fingerprint(fmt.Sprintf("tags\x00%d", order))
+}
+
+func commentsFingerprint(postID, parentID string) string {
+	return fingerprint("comments\x00" + postID + "\x00" + parentID)
+}
+
+func fingerprint(raw string) string {
+	sum := sha256.Sum256([]byte(raw))
+	return hex.EncodeToString(sum[:8])
+}
+
+func encodePageToken(token pageToken) string {
+	data, _ := json.Marshal(token)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodePageToken(raw, query string) (*pageToken, error) {
+	data, err := base64.RawURLEncoding.DecodeString(raw)
+	if err != nil {
+		return nil, apperrors.ErrInvalidInput
+	}
+
+	var token pageToken
+	if err := json.Unmarshal(data, &token); err != nil {
+		return nil, apperrors.ErrInvalidInput
+	}
+
+	// A token is only valid for the query that produced it.
+	if token.ID == "" || token.Query != query {
+		return nil, apperrors.ErrInvalidInput
+	}
+
+	return &token, nil
+}
@@ -2,82 +2,894 @@ package service
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
 
+	"github.com/BhaveetKumar/gRPC-server-go/internal/clock"
 	"github.com/BhaveetKumar/gRPC-server-go/internal/domain"
 	apperrors "github.com/BhaveetKumar/gRPC-server-go/internal/errors"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/idgen"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/logger"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/moderation"
 	"github.com/BhaveetKumar/gRPC-server-go/internal/repository"
-	"github.com/google/uuid"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/repository/memory"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/search"
 )
 
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+// defaultUpdateMask is applied when a caller sends no mask and matches what
+// UpdatePost replaced before masks existed.
+var defaultUpdateMask = []string{FieldTitle, FieldContent, FieldAuthor, FieldTags}
+
 type postService struct {
-	repo repository.PostRepository
+	repo   repository.PostRepository
+	events *eventBroker
+	index  *search.Index
+	tags   *tagIndex
+	clock  clock.Clock
+	ids    idgen.Generator
+
+	revisions repository.RevisionRepository
+	retention RevisionRetention
+
+	moderation *moderation.Pipeline
+	moderators map[string]bool
+
+	// authors holds the Author records post bylines are linked to.
+	authors repository.AuthorRepository
+
+	// slugs maps every slug a post has held to the post.
+	slugs repository.SlugRepository
+
+	// comments, when set, holds the comments of a CommentService, which
+	// are removed together with their post when it is purged.
+	comments repository.CommentRepository
+
+	// scheduleChanged wakes RunScheduler when a post is scheduled or
+	// rescheduled so it can recompute when to wake up next.
+	scheduleChanged chan struct{}
+
+	// trashRetention is how long RunPurger leaves posts in the trash; zero
+	// keeps them until they are purged by hand. trashChanged wakes it when
+	// a post is trashed.
+	trashRetention time.Duration
+	trashChanged   chan struct{}
+
+	// retagMu serializes RenameTag and MergeTags.
+	retagMu sync.Mutex
+
+	// quota, when set, caps the posts each author writes per day.
+	quota *writeQuota
+
+	// logger reports failures that do not fail the call, such as a
+	// revision that could not be recorded after its write was stored.
+	logger *logger.Logger
 }
 
 var _ PostService = (*postService)(nil)
 
-func NewPostService(repo repository.PostRepository) PostService {
-	return &postService{repo: repo}
+type Option func(*postService)
+
+func WithClock(c clock.Clock) Option {
+	return func(s *postService) {
+		s.clock = c
+	}
+}
+
+// WithIDGenerator sets how new posts get their IDs. ListPosts can only order
+// by ID when gen is time ordered.
+func WithIDGenerator(gen idgen.Generator) Option {
+	return func(s *postService) {
+		s.ids = gen
+	}
+}
+
+// WithTrashRetention makes RunPurger permanently remove posts that have been
+// in the trash for longer than retention.
+func WithTrashRetention(retention time.Duration) Option {
+	return func(s *postService) {
+		s.trashRetention = retention
+	}
+}
+
+// WithLogger sets where failures that do not fail the call are reported.
+func WithLogger(l *logger.Logger) Option {
+	return func(s *postService) {
+		s.logger = l
+	}
+}
+
+// WithComments lets PurgePost and the purger remove the comments a
+// CommentService stored in store for the purged post.
+func WithComments(store repository.CommentRepository) Option {
+	return func(s *postService) {
+		s.comments = store
+	}
+}
+
+func NewPostService(repo repository.PostRepository, opts ...Option) PostService {
+	s := &postService{
+		repo:            repo,
+		events:          newEventBroker(),
+		index:           search.NewIndex(),
+		tags:            newTagIndex(),
+		clock:           clock.Real(),
+		ids:             idgen.NewUUIDv4(),
+		revisions:       memory.NewRevisionRepository(),
+		authors:         memory.NewAuthorRepository(),
+		slugs:           memory.NewSlugRepository(),
+		scheduleChanged: make(chan struct{}, 1),
+		trashChanged:    make(chan struct{}, 1),
+		moderators:      make(map[string]bool),
+		logger:          logger.New(),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
 
 func (s *postService) CreatePost(ctx context.Context, title, content, author, publicationDate string, tags []string) (*domain.Post, error) {
 	post := &domain.Post{
-		ID:              uuid.NewString(),
+		ID:              s.ids.NewID(),
 		Title:           title,
 		Content:         content,
 		Author:          author,
 		PublicationDate: publicationDate,
-		Tags:            tags,
+		Tags:            domain.NormalizeTags(tags),
+		Status:          domain.StatusDraft,
 	}
 
 	if err := post.Validate(); err != nil {
 		return nil, err
 	}
+	refund, err := s.chargeWrite(ctx, post.Author)
+	if err != nil {
+		return nil, err
+	}
+	stored := false
+	defer func() {
+		if !stored {
+			refund()
+		}
+	}()
+	if err := s.resolveAuthor(ctx, post); err != nil {
+		return nil, err
+	}
+	if err := s.moderate(ctx, nil, post); err != nil {
+		return nil, err
+	}
+	if err := s.assignSlug(ctx, post); err != nil {
+		return nil, err
+	}
 
-	if err := s.repo.Create(post); err != nil {
+	if err := s.repo.Create(ctx, post); err != nil {
+		// Release the slug so a post that was never stored does not
+		// hold it.
+		_ = s.slugs.DeleteSlugs(ctx, post.ID)
 		return nil, err
 	}
+	stored = true
+
+	s.indexPost(post)
+	s.events.publish(domain.PostCreated, post)
+
+	s.recordRevision(ctx, nil, post)
 
 	return post, nil
 }
 
+func (s *postService) PostAuthor(ctx context.Context, id string) (string, error) {
+	if id == "" {
+		return "", apperrors.ErrInvalidInput
+	}
+
+	post, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return "", err
+	}
+	return post.Author, nil
+}
+
 func (s *postService) GetPost(ctx context.Context, id string) (*domain.Post, error) {
 	if id == "" {
 		return nil, apperrors.ErrInvalidInput
 	}
 
-	return s.repo.GetByID(id)
+	post, err := s.getLive(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Hidden posts are reported as missing so their existence is not leaked.
+	if !post.VisibleTo(CallerFromContext(ctx)) {
+		return nil, apperrors.ErrPostNotFound
+	}
+
+	return post, nil
 }
 
-func (s *postService) UpdatePost(ctx context.Context, id, title, content, author string, tags []string) (*domain.Post, error) {
+func (s *postService) UpdatePost(ctx context.Context, id string, update PostUpdate, mask []string, etag string) (*domain.Post, error) {
 	if id == "" {
 		return nil, apperrors.ErrInvalidInput
 	}
 
-	existing, err := s.repo.GetByID(id)
+	if len(mask) == 0 {
+		mask = defaultUpdateMask
+	}
+	for _, path := range mask {
+		if !isUpdatablePath(path) {
+			return nil, apperrors.ErrInvalidInput
+		}
+	}
+
+	existing, err := s.getLive(ctx, id)
 	if err != nil {
 		return nil, err
 	}
+	if etag != "" && etag != existing.ETag() {
+		return nil, apperrors.ErrVersionConflict
+	}
+
+	for _, path := range mask {
+		if path != FieldStatus || update.Status == existing.Status {
+			continue
+		}
+		if !update.Status.Valid() {
+			return nil, apperrors.ErrInvalidInput
+		}
+		if !existing.Status.CanTransitionTo(update.Status) {
+			return nil, apperrors.ErrInvalidTransition
+		}
+	}
 
-	existing.Title = title
-	existing.Content = content
-	existing.Author = author
-	existing.Tags = tags
+	previous := existing.Clone()
+	expectedVersion := existing.Version
+	applyUpdate(existing, update, mask)
 
 	if err := existing.Validate(); err != nil {
 		return nil, err
 	}
+	refund, err := s.chargeWrite(ctx, previous.Author)
+	if err != nil {
+		return nil, err
+	}
+	stored := false
+	defer func() {
+		if !stored {
+			refund()
+		}
+	}()
+	if containsPath(mask, FieldAuthor) {
+		existing.AuthorID = ""
+		if err := s.resolveAuthor(ctx, existing); err != nil {
+			return nil, err
+		}
+	}
+	if containsPath(mask, FieldTitle) {
+		if err := s.updateSlug(ctx, previous.Title, existing); err != nil {
+			return nil, err
+		}
+	}
+	if existing.Status == domain.StatusScheduled && (containsPath(mask, FieldStatus) || containsPath(mask, FieldPublicationDate)) {
+		if at, _ := existing.PublishAt(); !at.After(s.clock.Now()) {
+			return nil, apperrors.ErrInvalidInput
+		}
+	}
+	if err := s.moderate(ctx, previous, existing); err != nil {
+		return nil, err
+	}
 
-	if err := s.repo.Update(existing); err != nil {
+	if err := s.repo.Update(ctx, existing, expectedVersion); err != nil {
 		return nil, err
 	}
+	stored = true
+
+	s.indexPost(existing)
+	s.events.publish(domain.PostUpdated, existing)
+	if existing.Status == domain.StatusScheduled {
+		s.wakeScheduler()
+	}
+
+	s.recordRevision(ctx, previous, existing)
 
 	return existing, nil
 }
 
-func (s *postService) DeletePost(ctx context.Context, id string) error {
+func containsPath(mask []string, path string) bool {
+	for _, p := range mask {
+		if p == path {
+			return true
+		}
+	}
+	return false
+}
+
+func isUpdatablePath(path string) bool {
+	switch path {
+	case FieldTitle, FieldContent, FieldAuthor, FieldPublicationDate, FieldTags, FieldStatus:
+		return true
+	default:
+		return false
+	}
+}
+
+func applyUpdate(post *domain.Post, update PostUpdate, mask []string) {
+	for _, path := range mask {
+		switch path {
+		case FieldTitle:
+			post.Title = update.Title
+		case FieldContent:
+			post.Content = update.Content
+		case FieldAuthor:
+			post.Author = update.Author
+		case FieldPublicationDate:
+			post.PublicationDate = update.PublicationDate
+		case FieldTags:
+			post.Tags = domain.NormalizeTags(update.Tags)
+		case FieldStatus:
+			post.Status = update.Status
+		}
+	}
+}
+
+// getLive loads a post that is not in the trash; trashed posts are reported
+// as missing.
+func (s *postService) getLive(ctx context.Context, id string) (*domain.Post, error) {
+	post, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if post.Deleted() {
+		return nil, apperrors.ErrPostNotFound
+	}
+	return post, nil
+}
+
+// DeletePost moves a post to the trash, where it is hidden from reads until
+// RestorePost brings it back or it is purged. Trashing and restoring each
+// record a revision so the post's history has no gaps.
+func (s *postService) DeletePost(ctx context.Context, id, etag string) error {
 	if id == "" {
 		return apperrors.ErrInvalidInput
 	}
 
-	return s.repo.Delete(id)
+	existing, err := s.getLive(ctx, id)
+	if err != nil {
+		return err
+	}
+	if etag != "" && etag != existing.ETag() {
+		return apperrors.ErrVersionConflict
+	}
+
+	previous := existing.Clone()
+	expectedVersion := existing.Version
+	existing.DeletedAt = s.clock.Now()
+	if err := s.repo.Update(ctx, existing, expectedVersion); err != nil {
+		return err
+	}
+
+	s.indexPost(existing)
+	s.events.publish(domain.PostDeleted, existing)
+	s.wakePurger()
+	s.recordRevision(ctx, previous, existing)
+	return nil
+}
+
+// RestorePost takes a post back out of the trash.
+func (s *postService) RestorePost(ctx context.Context, id, etag string) (*domain.Post, error) {
+	existing, err := s.getTrashed(ctx, id, etag)
+	if err != nil {
+		return nil, err
+	}
+
+	previous := existing.Clone()
+	expectedVersion := existing.Version
+	existing.DeletedAt = time.Time{}
+	if err := s.repo.Update(ctx, existing, expectedVersion); err != nil {
+		return nil, err
+	}
+
+	s.indexPost(existing)
+	s.events.publish(domain.PostUpdated, existing)
+	if existing.Status == domain.StatusScheduled {
+		s.wakeScheduler()
+	}
+
+	s.recordRevision(ctx, previous, existing)
+	return existing, nil
+}
+
+// PurgePost permanently removes a post from the trash together with its
+// revision history and comments.
+func (s *postService) PurgePost(ctx context.Context, id, etag string) error {
+	existing, err := s.getTrashed(ctx, id, etag)
+	if err != nil {
+		return err
+	}
+	return s.purge(ctx, existing)
+}
+
+func (s *postService) getTrashed(ctx context.Context, id, etag string) (*domain.Post, error) {
+	if id == "" {
+		return nil, apperrors.ErrInvalidInput
+	}
+
+	post, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !post.Deleted() {
+		return nil, apperrors.ErrNotInTrash
+	}
+	if etag != "" && etag != post.ETag() {
+		return nil, apperrors.ErrVersionConflict
+	}
+	return post, nil
+}
+
+func (s *postService) purge(ctx context.Context, post *domain.Post) error {
+	if err := s.repo.Delete(ctx, post.ID, post.Version); err != nil {
+		return err
+	}
+	s.unindexPost(post.ID)
+	if err := s.deleteRevisions(ctx, post.ID); err != nil {
+		return err
+	}
+	if err := s.slugs.DeleteSlugs(ctx, post.ID); err != nil {
+		return err
+	}
+	if s.comments != nil {
+		return s.comments.DeleteComments(ctx, post.ID)
+	}
+	return nil
+}
+
+func (s *postService) PublishPost(ctx context.Context, id, etag string) (*domain.Post, error) {
+	return s.transition(ctx, id, etag, "", domain.StatusPublished)
+}
+
+func (s *postService) UnpublishPost(ctx context.Context, id, etag string) (*domain.Post, error) {
+	return s.transition(ctx, id, etag, domain.StatusPublished, domain.StatusDraft)
+}
+
+func (s *postService) ArchivePost(ctx context.Context, id, etag string) (*domain.Post, error) {
+	return s.transition(ctx, id, etag, "", domain.StatusArchived)
+}
+
+// SchedulePost sets the post's publication date to publishAt and leaves it to
+// RunScheduler to publish it then. A scheduled post can be rescheduled.
+func (s *postService) SchedulePost(ctx context.Context, id string, publishAt time.Time, etag string) (*domain.Post, error) {
+	if !publishAt.After(s.clock.Now()) {
+		return nil, apperrors.ErrInvalidInput
+	}
+
+	post, err := s.modify(ctx, id, etag, func(post *domain.Post) error {
+		if post.Status != domain.StatusScheduled && !post.Status.CanTransitionTo(domain.StatusScheduled) {
+			return apperrors.ErrInvalidTransition
+		}
+		post.Status = domain.StatusScheduled
+		post.PublicationDate = publishAt.UTC().Format(time.RFC3339)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.wakeScheduler()
+	return post, nil
+}
+
+// transition moves a post to next. When from is set the post must currently
+// be in that status, on top of the transition itself being allowed.
+func (s *postService) transition(ctx context.Context, id, etag string, from, next domain.PostStatus) (*domain.Post, error) {
+	return s.modify(ctx, id, etag, func(post *domain.Post) error {
+		if (from != "" && post.Status != from) || !post.Status.CanTransitionTo(next) {
+			return apperrors.ErrInvalidTransition
+		}
+		post.Status = next
+		return nil
+	})
+}
+
+// modify applies change to the current version of a post and stores it with
+// a compare-and-swap on that version, recording the result as a revision.
+func (s *postService) modify(ctx context.Context, id, etag string, change func(*domain.Post) error) (*domain.Post, error) {
+	if id == "" {
+		return nil, apperrors.ErrInvalidInput
+	}
+
+	existing, err := s.getLive(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if etag != "" && etag != existing.ETag() {
+		return nil, apperrors.ErrVersionConflict
+	}
+
+	previous := existing.Clone()
+	expectedVersion := existing.Version
+	if err := change(existing); err != nil {
+		return nil, err
+	}
+	if err := s.moderate(ctx, previous, existing); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Update(ctx, existing, expectedVersion); err != nil {
+		return nil, err
+	}
+
+	s.indexPost(existing)
+	s.events.publish(domain.PostUpdated, existing)
+
+	s.recordRevision(ctx, previous, existing)
+
+	return existing, nil
+}
+
+func (s *postService) ListPosts(ctx context.Context, params ListPostsParams) ([]*domain.Post, string, error) {
+	if params.PageSize < 0 || params.OrderBy < OrderPublicationDateDesc || params.OrderBy > OrderIDDesc {
+		return nil, "", apperrors.ErrInvalidInput
+	}
+	if (params.OrderBy == OrderIDAsc || params.OrderBy == OrderIDDesc) && !s.ids.TimeOrdered() {
+		return nil, "", apperrors.ErrInvalidInput
+	}
+	if params.Status != "" && !params.Status.Valid() {
+		return nil, "", apperrors.ErrInvalidInput
+	}
+	if params.Tag != "" {
+		if params.Tag = domain.NormalizeTag(params.Tag); params.Tag == "" {
+			return nil, "", apperrors.ErrInvalidInput
+		}
+	}
+
+	var filter dateRange
+	if params.PublishedAfter != "" {
+		after, err := domain.ParsePublicationDate(params.PublishedAfter)
+		if err != nil {
+			return nil, "", apperrors.ErrInvalidInput
+		}
+		filter.after = after
+	}
+	if params.PublishedBefore != "" {
+		before, err := domain.ParsePublicationDate(params.PublishedBefore)
+		if err != nil {
+			return nil, "", apperrors.ErrInvalidInput
+		}
+		filter.before = before
+	}
+
+	pageSize := params.PageSize
+	if pageSize == 0 {
+		pageSize = defaultPageSize
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+
+	var cursor *pageToken
+	if params.PageToken != "" {
+		token, err := decodePageToken(params.PageToken, queryFingerprint(params))
+		if err != nil {
+			return nil, "", err
+		}
+		cursor = token
+	}
+
+	all, err := s.repo.List(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	caller := CallerFromContext(ctx)
+	matched := make([]*domain.Post, 0, len(all))
+	for _, post := range all {
+		if post.Deleted() && !(params.ShowDeleted && post.AuthoredBy(caller)) {
+			continue
+		}
+		if post.VisibleTo(caller) && matchesFilter(post, params) && filter.contains(post) {
+			matched = append(matched, post)
+		}
+	}
+
+	sort.Slice(matched, func(i, j int) bool {
+		return postLess(matched[i], matched[j], params.OrderBy)
+	})
+
+	start := 0
+	if cursor != nil {
+		start = sort.Search(len(matched), func(i int) bool {
+			return cursorLess(cursor, matched[i], params.OrderBy)
+		})
+	}
+
+	end := start + pageSize
+	if end > len(matched) {
+		end = len(matched)
+	}
+	page := matched[start:end]
+
+	nextToken := ""
+	if end < len(matched) {
+		last := page[len(page)-1]
+		nextToken = encodePageToken(pageToken{
+			Key:   sortKey(last, params.OrderBy),
+			ID:    last.ID,
+			Query: queryFingerprint(params),
+		})
+	}
+
+	return page, nextToken, nil
+}
+
+func (s *postService) WatchPosts(ctx context.Context, params WatchPostsParams, send func(*domain.PostEvent) error) error {
+	if params.Tag != "" {
+		if params.Tag = domain.NormalizeTag(params.Tag); params.Tag == "" {
+			return apperrors.ErrInvalidInput
+		}
+	}
+
+	var epoch string
+	var after uint64
+	resume := params.ResumeToken != ""
+	if resume {
+		var err error
+		if epoch, after, err = parseResumeToken(params.ResumeToken); err != nil {
+			return err
+		}
+	}
+
+	sub, backlog, err := s.events.subscribe(epoch, after, resume)
+	if err != nil {
+		return err
+	}
+	defer s.events.unsubscribe(sub)
+
+	caller := CallerFromContext(ctx)
+	deliver := func(event domain.PostEvent) error {
+		if !event.Post.VisibleTo(caller) || !matchesWatch(event.Post, params) {
+			return nil
+		}
+		return send(&event)
+	}
+
+	for _, event := range backlog {
+		if err := deliver(event); err != nil {
+			return err
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-sub.dropped:
+			return apperrors.ErrSlowConsumer
+		case event := <-sub.events:
+			if err := deliver(event); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (s *postService) SearchPosts(ctx context.Context, params SearchPostsParams) ([]*SearchResult, string, error) {
+	if params.Query == "" || params.PageSize < 0 {
+		return nil, "", apperrors.ErrInvalidInput
+	}
+
+	pageSize := params.PageSize
+	if pageSize == 0 {
+		pageSize = defaultPageSize
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+
+	offset := 0
+	if params.PageToken != "" {
+		token, err := decodePageToken(params.PageToken, searchFingerprint(params.Query))
+		if err != nil {
+			return nil, "", err
+		}
+		offset, err = strconv.Atoi(token.Key)
+		if err != nil || offset < 0 {
+			return nil, "", apperrors.ErrInvalidInput
+		}
+	}
+
+	hits, err := s.index.Search(params.Query)
+	if errors.Is(err, search.ErrInvalidQuery) {
+		return nil, "", apperrors.ErrInvalidInput
+	}
+	if err != nil {
+		return nil, "", err
+	}
+
+	caller := CallerFromContext(ctx)
+	results := make([]*SearchResult, 0, pageSize)
+	next := offset
+	for ; next < len(hits) && len(results) < pageSize; next++ {
+		hit := hits[next]
+
+		// The index can briefly lag behind a concurrent delete.
+		post, err := s.getLive(ctx, hit.ID)
+		if errors.Is(err, apperrors.ErrPostNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, "", err
+		}
+		if !post.VisibleTo(caller) {
+			continue
+		}
+
+		snippet := search.Snippet(post.Content, hit.Terms)
+		if snippet == "" {
+			snippet = search.Snippet(post.Title, hit.Terms)
+		}
+		results = append(results, &SearchResult{Post: post, Score: hit.Score, Snippet: snippet})
+	}
+
+	nextToken := ""
+	if next < len(hits) {
+		nextToken = encodePageToken(pageToken{
+			Key:   strconv.Itoa(next),
+			ID:    hits[next-1].ID,
+			Query: searchFingerprint(params.Query),
+		})
+	}
+
+	return results, nextToken, nil
+}
+
+func (s *postService) RebuildIndexes(ctx context.Context) error {
+	posts, err := s.repo.List(ctx)
+	if err != nil {
+		return err
+	}
+
+	s.index.Reset()
+	s.tags.reset()
+	for _, post := range posts {
+		s.indexPost(post)
+	}
+	return nil
+}
+
+// indexPost brings the search and tag indexes up to date with post. Trashed
+// posts are left out of search and counted under no tag.
+func (s *postService) indexPost(post *domain.Post) {
+	if post.Deleted() {
+		s.index.Remove(post.ID)
+	} else {
+		s.index.Add(post.ID, post.Version, post.Title, post.Content)
+	}
+	s.tags.set(post)
+}
+
+func (s *postService) unindexPost(id string) {
+	s.index.Remove(id)
+	s.tags.remove(id)
+}
+
+func matchesWatch(post *domain.Post, params WatchPostsParams) bool {
+	if params.Author != "" && !domain.SameAuthor(params.Author, post.Author) {
+		return false
+	}
+	if params.Tag != "" && !hasTag(post.Tags, params.Tag) {
+		return false
+	}
+	return true
+}
+
+func matchesFilter(post *domain.Post, params ListPostsParams) bool {
+	if params.Author != "" && !domain.SameAuthor(params.Author, post.Author) {
+		return false
+	}
+	if params.AuthorID != "" && post.AuthorID != params.AuthorID {
+		return false
+	}
+	if params.Tag != "" && !hasTag(post.Tags, params.Tag) {
+		return false
+	}
+	if params.Status != "" && post.Status != params.Status {
+		return false
+	}
+	return true
+}
+
+// dateRange is an inclusive publication date range; zero bounds are open.
+type dateRange struct {
+	after  time.Time
+	before time.Time
+}
+
+func (r dateRange) contains(post *domain.Post) bool {
+	if r.after.IsZero() && r.before.IsZero() {
+		return true
+	}
+
+	at, ok := post.PublishAt()
+	if !ok {
+		return false
+	}
+	if !r.after.IsZero() && at.Before(r.after) {
+		return false
+	}
+	if !r.before.IsZero() && at.After(r.before) {
+		return false
+	}
+	return true
+}
+
+func hasTag(tags []string, tag string) bool {
+	for _, t := range tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
+
+func sortKey(post *domain.Post, order PostOrder) string {
+	switch order {
+	case OrderTitleAsc, OrderTitleDesc:
+		return post.Title
+	case OrderIDAsc, OrderIDDesc:
+		// Snowflake IDs are decimal numbers that gain digits over time.
+		return fmt.Sprintf("%*s", sortableIDWidth, post.ID)
+	default:
+		// Normalise to a fixed-width UTC form so that dates and timestamps
+		// in different zones compare correctly as strings.
+		if at, ok := post.PublishAt(); ok {
+			return at.UTC().Format(sortableTime)
+		}
+		return post.PublicationDate
+	}
+}
+
+const (
+	sortableTime    = "2006-01-02T15:04:05.000000000Z"
+	sortableIDWidth = 40
+)
+
+func descending(order PostOrder) bool {
+	return order == OrderPublicationDateDesc || order == OrderTitleDesc || order == OrderIDDesc
+}
+
+// compareKeys orders by sort key in the requested direction and breaks ties by
+// ascending ID so that every post has a stable position for page tokens.
+func compareKeys(keyA, idA, keyB, idB string, order PostOrder) int {
+	if keyA != keyB {
+		if (keyA < keyB) != descending(order) {
+			return -1
+		}
+		return 1
+	}
+	switch {
+	case idA < idB:
+		return -1
+	case idA > idB:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func postLess(a, b *domain.Post, order PostOrder) bool {
+	return compareKeys(sortKey(a, order), a.ID, sortKey(b, order), b.ID, order) < 0
+}
+
+func cursorLess(cursor *pageToken, post *domain.Post, order PostOrder) bool {
+	return compareKeys(cursor.Key, cursor.ID, sortKey(post, order), post.ID, order) < 0
 }
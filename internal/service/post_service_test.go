@@ -3,11 +3,30 @@ package service
 import (
 	"context"
 	"testing"
+	"time"
 
+	"github.com/BhaveetKumar/gRPC-server-go/internal/clock"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/domain"
 	apperrors "github.com/BhaveetKumar/gRPC-server-go/internal/errors"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/idgen"
 	"github.com/BhaveetKumar/gRPC-server-go/internal/repository/memory"
 )
 
+func mustCreatePublished(t *testing.T, service PostService, title, author, date string, tags []string) *domain.Post {
+	t.Helper()
+
+	ctx := context.Background()
+	post, err := service.CreatePost(ctx, title, "content", author, date, tags)
+	if err != nil {
+		t.Fatalf("create failed: %v", err)
+	}
+	post, err = service.PublishPost(ctx, post.ID, "")
+	if err != nil {
+		t.Fatalf("publish failed: %v", err)
+	}
+	return post
+}
+
 func TestPostService_CreateValidate(t *testing.T) {
 	repo := memory.NewPostRepository()
 	service := NewPostService(repo)
@@ -82,7 +101,7 @@ func TestPostService_GetPost(t *testing.T) {
 	repo := memory.NewPostRepository()
 	service := NewPostService(repo)
 
-	ctx := context.Background()
+	ctx := WithCaller(context.Background(), "author")
 	created, _ := service.CreatePost(ctx, "title", "content", "author", "", nil)
 
 	retrieved, err := service.GetPost(ctx, created.ID)
@@ -127,7 +146,7 @@ func TestPostService_UpdatePost(t *testing.T) {
 	ctx := context.Background()
 	created, _ := service.CreatePost(ctx, "original", "original content", "author1", "", nil)
 
-	updated, err := service.UpdatePost(ctx, created.ID, "updated", "updated content", "author2", []string{"tag1"})
+	updated, err := service.UpdatePost(ctx, created.ID, PostUpdate{Title: "updated", Content: "updated content", Author: "author2", Tags: []string{"tag1"}}, nil, "")
 	if err != nil {
 		t.Fatalf("update failed: %v", err)
 	}
@@ -151,7 +170,7 @@ func TestPostService_UpdateNotFound(t *testing.T) {
 	service := NewPostService(repo)
 
 	ctx := context.Background()
-	_, err := service.UpdatePost(ctx, "missing", "title", "content", "author", nil)
+	_, err := service.UpdatePost(ctx, "missing", PostUpdate{Title: "title", Content: "content", Author: "author"}, nil, "")
 	if err != apperrors.ErrPostNotFound {
 		t.Fatalf("expected not found, got %v", err)
 	}
@@ -164,7 +183,7 @@ func TestPostService_UpdateInvalidTitle(t *testing.T) {
 	ctx := context.Background()
 	created, _ := service.CreatePost(ctx, "original", "original content", "author1", "", nil)
 
-	_, err := service.UpdatePost(ctx, created.ID, "", "content", "author", nil)
+	_, err := service.UpdatePost(ctx, created.ID, PostUpdate{Title: "", Content: "content", Author: "author"}, nil, "")
 	if err != apperrors.ErrInvalidInput {
 		t.Fatalf("expected invalid input, got %v", err)
 	}
@@ -177,7 +196,7 @@ func TestPostService_DeletePost(t *testing.T) {
 	ctx := context.Background()
 	created, _ := service.CreatePost(ctx, "title", "content", "author", "", nil)
 
-	err := service.DeletePost(ctx, created.ID)
+	err := service.DeletePost(ctx, created.ID, "")
 	if err != nil {
 		t.Fatalf("delete failed: %v", err)
 	}
@@ -193,7 +212,7 @@ func TestPostService_DeleteInvalidEmptyID(t *testing.T) {
 	service := NewPostService(repo)
 
 	ctx := context.Background()
-	if err := service.DeletePost(ctx, ""); err != apperrors.ErrInvalidInput {
+	if err := service.DeletePost(ctx, "", ""); err != apperrors.ErrInvalidInput {
 		t.Fatalf("expected invalid input, got %v", err)
 	}
 }
@@ -203,7 +222,638 @@ func TestPostService_DeleteNotFound(t *testing.T) {
 	service := NewPostService(repo)
 
 	ctx := context.Background()
-	if err := service.DeletePost(ctx, "nonexistent"); err != apperrors.ErrPostNotFound {
+	if err := service.DeletePost(ctx, "nonexistent", ""); err != apperrors.ErrPostNotFound {
 		t.Fatalf("expected not found, got %v", err)
 	}
 }
+
+func TestPostService_ListPostsPagination(t *testing.T) {
+	repo := memory.NewPostRepository()
+	service := NewPostService(repo)
+
+	ctx := WithCaller(context.Background(), "author")
+	dates := []string{"2026-01-01", "2026-01-02", "2026-01-03", "2026-01-04", "2026-01-05"}
+	for _, date := range dates {
+		_, _ = service.CreatePost(ctx, "title "+date, "content", "author", date, nil)
+	}
+
+	var seen []string
+	token := ""
+	for {
+		posts, next, err := service.ListPosts(ctx, ListPostsParams{PageSize: 2, PageToken: token, OrderBy: OrderPublicationDateAsc})
+		if err != nil {
+			t.Fatalf("list failed: %v", err)
+		}
+		for _, post := range posts {
+			seen = append(seen, post.PublicationDate)
+		}
+		if next == "" {
+			break
+		}
+		token = next
+	}
+
+	if len(seen) != len(dates) {
+		t.Fatalf("expected %d posts, got %d", len(dates), len(seen))
+	}
+	for i := range dates {
+		if seen[i] != dates[i] {
+			t.Fatalf("unexpected order: %v", seen)
+		}
+	}
+}
+
+func TestPostService_ListPostsFilters(t *testing.T) {
+	repo := memory.NewPostRepository()
+	service := NewPostService(repo)
+
+	ctx := context.Background()
+	mustCreatePublished(t, service, "a", "alice", "2026-01-01", []string{"go"})
+	mustCreatePublished(t, service, "b", "alice", "2026-02-01", []string{"rust"})
+	mustCreatePublished(t, service, "c", "bob", "2026-03-01", []string{"go"})
+
+	posts, _, err := service.ListPosts(ctx, ListPostsParams{Author: "alice"})
+	if err != nil {
+		t.Fatalf("list failed: %v", err)
+	}
+	if len(posts) != 2 {
+		t.Fatalf("expected 2 posts by alice, got %d", len(posts))
+	}
+
+	posts, _, _ = service.ListPosts(ctx, ListPostsParams{Tag: "go", OrderBy: OrderTitleDesc})
+	if len(posts) != 2 || posts[0].Title != "c" || posts[1].Title != "a" {
+		t.Fatalf("unexpected tag filter result: %v", posts)
+	}
+
+	posts, _, _ = service.ListPosts(ctx, ListPostsParams{PublishedAfter: "2026-01-15", PublishedBefore: "2026-02-15"})
+	if len(posts) != 1 || posts[0].Title != "b" {
+		t.Fatalf("unexpected date range result: %v", posts)
+	}
+}
+
+func TestPostService_ListPostsInvalidToken(t *testing.T) {
+	repo := memory.NewPostRepository()
+	service := NewPostService(repo)
+
+	ctx := WithCaller(context.Background(), "author")
+	for i := 0; i < 3; i++ {
+		_, _ = service.CreatePost(ctx, "title", "content", "author", "", nil)
+	}
+
+	if _, _, err := service.ListPosts(ctx, ListPostsParams{PageToken: "not-a-token"}); err != apperrors.ErrInvalidInput {
+		t.Fatalf("expected invalid input for garbage token, got %v", err)
+	}
+
+	_, next, _ := service.ListPosts(ctx, ListPostsParams{PageSize: 1})
+	if _, _, err := service.ListPosts(ctx, ListPostsParams{PageSize: 1, PageToken: next, Author: "other"}); err != apperrors.ErrInvalidInput {
+		t.Fatalf("expected invalid input for token reused with different filter, got %v", err)
+	}
+}
+
+func TestPostService_ListPostsInvalidPageSize(t *testing.T) {
+	repo := memory.NewPostRepository()
+	service := NewPostService(repo)
+
+	ctx := context.Background()
+	if _, _, err := service.ListPosts(ctx, ListPostsParams{PageSize: -1}); err != apperrors.ErrInvalidInput {
+		t.Fatalf("expected invalid input, got %v", err)
+	}
+}
+
+func TestPostService_WatchPostsResumeAndLive(t *testing.T) {
+	repo := memory.NewPostRepository()
+	service := NewPostService(repo)
+
+	ctx, cancel := context.WithCancel(WithCaller(context.Background(), "alice"))
+	defer cancel()
+
+	created, _ := service.CreatePost(ctx, "title", "content", "alice", "", []string{"go"})
+	_, _ = service.CreatePost(ctx, "other", "content", "bob", "", nil)
+
+	events := make(chan *domain.PostEvent, 10)
+	done := make(chan error, 1)
+	go func() {
+		done <- service.WatchPosts(ctx, WatchPostsParams{Author: "alice", ResumeToken: "0"}, func(event *domain.PostEvent) error {
+			events <- event
+			return nil
+		})
+	}()
+
+	first := <-events
+	if first.Type != domain.PostCreated || first.Post.ID != created.ID {
+		t.Fatalf("unexpected backlog event: %+v", first)
+	}
+
+	_, _ = service.UpdatePost(ctx, created.ID, PostUpdate{Title: "new", Content: "content", Author: "alice", Tags: []string{"go"}}, nil, "")
+	_ = service.DeletePost(ctx, created.ID, "")
+
+	for _, want := range []domain.PostEventType{domain.PostUpdated, domain.PostDeleted} {
+		select {
+		case event := <-events:
+			if event.Type != want || event.Post.ID != created.ID {
+				t.Fatalf("unexpected event: %+v", event)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("timed out waiting for event %v", want)
+		}
+	}
+
+	cancel()
+	if err := <-done; err != nil {
+		t.Fatalf("watch returned error: %v", err)
+	}
+}
+
+func TestPostService_WatchPostsInvalidResumeToken(t *testing.T) {
+	repo := memory.NewPostRepository()
+	service := NewPostService(repo)
+
+	ctx := context.Background()
+	noop := func(*domain.PostEvent) error { return nil }
+
+	for _, token := range []string{"abc", ".1", "epoch.abc", "epoch.-1"} {
+		if err := service.WatchPosts(ctx, WatchPostsParams{ResumeToken: token}, noop); err != apperrors.ErrInvalidInput {
+			t.Fatalf("expected invalid input for %q, got %v", token, err)
+		}
+	}
+
+	future := service.(*postService).events.epoch + ".42"
+	if err := service.WatchPosts(ctx, WatchPostsParams{ResumeToken: future}, noop); err != apperrors.ErrInvalidInput {
+		t.Fatalf("expected invalid input for future token, got %v", err)
+	}
+}
+
+func TestPostService_WatchPostsTokenFromEarlierServer(t *testing.T) {
+	ctx := WithCaller(context.Background(), "author")
+	noop := func(*domain.PostEvent) error { return nil }
+
+	// The token of an event seen before a restart...
+	before := NewPostService(memory.NewPostRepository())
+	_, _ = before.CreatePost(ctx, "title", "content", "author", "", nil)
+	token := ResumeToken(&domain.PostEvent{Epoch: before.(*postService).events.epoch, Sequence: 1})
+
+	// ...is refused by the restarted server, even once its own sequence
+	// has caught up, rather than resuming at the wrong event.
+	after := NewPostService(memory.NewPostRepository())
+	for i := 0; i < 3; i++ {
+		_, _ = after.CreatePost(ctx, "title", "content", "author", "", nil)
+	}
+	for _, stale := range []string{token, "1"} {
+		if err := after.WatchPosts(ctx, WatchPostsParams{ResumeToken: stale}, noop); err != apperrors.ErrResumeTokenExpired {
+			t.Fatalf("expected %q to have expired, got %v", stale, err)
+		}
+	}
+}
+
+func TestPostService_WatchPostsSlowConsumer(t *testing.T) {
+	repo := memory.NewPostRepository()
+	service := NewPostService(repo)
+
+	ctx := WithCaller(context.Background(), "author")
+	_, _ = service.CreatePost(ctx, "title", "content", "author", "", nil)
+
+	subscribed := make(chan struct{})
+	release := make(chan struct{})
+	done := make(chan error, 1)
+	go func() {
+		first := true
+		done <- service.WatchPosts(ctx, WatchPostsParams{ResumeToken: "0"}, func(*domain.PostEvent) error {
+			if first {
+				first = false
+				close(subscribed)
+				<-release
+			}
+			return nil
+		})
+	}()
+
+	<-subscribed
+	for i := 0; i < subscriberBufferSize+1; i++ {
+		_, _ = service.CreatePost(ctx, "title", "content", "author", "", nil)
+	}
+	close(release)
+
+	select {
+	case err := <-done:
+		if err != apperrors.ErrSlowConsumer {
+			t.Fatalf("expected slow consumer error, got %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected slow watcher to be dropped")
+	}
+}
+
+func TestPostService_UpdatePostWithMask(t *testing.T) {
+	repo := memory.NewPostRepository()
+	service := NewPostService(repo)
+
+	ctx := context.Background()
+	created, _ := service.CreatePost(ctx, "title", "content", "author", "2026-01-01", []string{"old"})
+
+	updated, err := service.UpdatePost(ctx, created.ID, PostUpdate{Tags: []string{"new"}}, []string{FieldTags}, "")
+	if err != nil {
+		t.Fatalf("update failed: %v", err)
+	}
+	if updated.Title != "title" || updated.Content != "content" || updated.Author != "author" {
+		t.Fatalf("fields outside the mask changed: %+v", updated)
+	}
+	if len(updated.Tags) != 1 || updated.Tags[0] != "new" {
+		t.Fatalf("tags not updated: %v", updated.Tags)
+	}
+
+	updated, err = service.UpdatePost(ctx, created.ID, PostUpdate{PublicationDate: "2026-03-01"}, []string{FieldPublicationDate}, "")
+	if err != nil {
+		t.Fatalf("update failed: %v", err)
+	}
+	if updated.PublicationDate != "2026-03-01" {
+		t.Fatalf("publication date not updated: %s", updated.PublicationDate)
+	}
+}
+
+func TestPostService_UpdatePostUnknownMaskPath(t *testing.T) {
+	repo := memory.NewPostRepository()
+	service := NewPostService(repo)
+
+	ctx := context.Background()
+	created, _ := service.CreatePost(ctx, "title", "content", "author", "", nil)
+
+	_, err := service.UpdatePost(ctx, created.ID, PostUpdate{}, []string{"post_id"}, "")
+	if err != apperrors.ErrInvalidInput {
+		t.Fatalf("expected invalid input, got %v", err)
+	}
+}
+
+func TestPostService_UpdatePostMaskStillValidates(t *testing.T) {
+	repo := memory.NewPostRepository()
+	service := NewPostService(repo)
+
+	ctx := context.Background()
+	created, _ := service.CreatePost(ctx, "title", "content", "author", "", nil)
+
+	_, err := service.UpdatePost(ctx, created.ID, PostUpdate{}, []string{FieldTitle}, "")
+	if err != apperrors.ErrInvalidInput {
+		t.Fatalf("expected invalid input when clearing title, got %v", err)
+	}
+}
+
+func TestPostService_UpdatePostEtag(t *testing.T) {
+	repo := memory.NewPostRepository()
+	service := NewPostService(repo)
+
+	ctx := WithCaller(context.Background(), "author")
+	created, _ := service.CreatePost(ctx, "title", "content", "author", "", nil)
+	if created.Version != 1 {
+		t.Fatalf("expected version 1, got %d", created.Version)
+	}
+
+	updated, err := service.UpdatePost(ctx, created.ID, PostUpdate{Title: "first"}, []string{FieldTitle}, created.ETag())
+	if err != nil {
+		t.Fatalf("update with current etag failed: %v", err)
+	}
+	if updated.Version != 2 {
+		t.Fatalf("expected version 2, got %d", updated.Version)
+	}
+
+	_, err = service.UpdatePost(ctx, created.ID, PostUpdate{Title: "second"}, []string{FieldTitle}, created.ETag())
+	if err != apperrors.ErrVersionConflict {
+		t.Fatalf("expected version conflict for stale etag, got %v", err)
+	}
+
+	loaded, _ := service.GetPost(ctx, created.ID)
+	if loaded.Title != "first" {
+		t.Fatalf("stale update should not be applied, got title %s", loaded.Title)
+	}
+}
+
+func TestPostService_DeletePostEtag(t *testing.T) {
+	repo := memory.NewPostRepository()
+	service := NewPostService(repo)
+
+	ctx := context.Background()
+	created, _ := service.CreatePost(ctx, "title", "content", "author", "", nil)
+	updated, _ := service.UpdatePost(ctx, created.ID, PostUpdate{Title: "new"}, []string{FieldTitle}, "")
+
+	if err := service.DeletePost(ctx, created.ID, created.ETag()); err != apperrors.ErrVersionConflict {
+		t.Fatalf("expected version conflict for stale etag, got %v", err)
+	}
+	if err := service.DeletePost(ctx, created.ID, updated.ETag()); err != nil {
+		t.Fatalf("delete with current etag failed: %v", err)
+	}
+}
+
+func TestPostService_SearchPosts(t *testing.T) {
+	repo := memory.NewPostRepository()
+	service := NewPostService(repo)
+
+	ctx := WithCaller(context.Background(), "alice")
+	grpcPost, _ := service.CreatePost(ctx, "Streaming with gRPC", "Server streaming pushes messages.", "alice", "", nil)
+	_, _ = service.CreatePost(ctx, "Cooking pasta", "Boil water first.", "bob", "", nil)
+
+	results, next, err := service.SearchPosts(ctx, SearchPostsParams{Query: "stream*"})
+	if err != nil {
+		t.Fatalf("search failed: %v", err)
+	}
+	if next != "" {
+		t.Fatalf("expected no next page token, got %q", next)
+	}
+	if len(results) != 1 || results[0].Post.ID != grpcPost.ID {
+		t.Fatalf("unexpected results: %+v", results)
+	}
+	if results[0].Score <= 0 {
+		t.Fatalf("expected positive score, got %v", results[0].Score)
+	}
+	if results[0].Snippet != "Server <em>streaming</em> pushes messages." {
+		t.Fatalf("unexpected snippet: %q", results[0].Snippet)
+	}
+
+	_, err = service.UpdatePost(ctx, grpcPost.ID, PostUpdate{Content: "Unary calls only."}, []string{FieldContent}, "")
+	if err != nil {
+		t.Fatalf("update failed: %v", err)
+	}
+	results, _, _ = service.SearchPosts(ctx, SearchPostsParams{Query: "pushes"})
+	if len(results) != 0 {
+		t.Fatalf("expected updated content to be reindexed, got %+v", results)
+	}
+
+	// Title-only matches fall back to a title snippet.
+	results, _, _ = service.SearchPosts(ctx, SearchPostsParams{Query: "grpc"})
+	if len(results) != 1 || results[0].Snippet != "Streaming with <em>gRPC</em>" {
+		t.Fatalf("unexpected results: %+v", results)
+	}
+
+	if err := service.DeletePost(ctx, grpcPost.ID, ""); err != nil {
+		t.Fatalf("delete failed: %v", err)
+	}
+	results, _, _ = service.SearchPosts(ctx, SearchPostsParams{Query: "grpc"})
+	if len(results) != 0 {
+		t.Fatalf("expected deleted post to be gone, got %+v", results)
+	}
+}
+
+func TestPostService_SearchPostsPagination(t *testing.T) {
+	repo := memory.NewPostRepository()
+	service := NewPostService(repo)
+
+	ctx := WithCaller(context.Background(), "author")
+	for i := 0; i < 5; i++ {
+		_, _ = service.CreatePost(ctx, "grpc", "content", "author", "", nil)
+	}
+
+	seen := make(map[string]bool)
+	token := ""
+	for page := 0; ; page++ {
+		results, next, err := service.SearchPosts(ctx, SearchPostsParams{Query: "grpc", PageSize: 2, PageToken: token})
+		if err != nil {
+			t.Fatalf("search failed: %v", err)
+		}
+		for _, result := range results {
+			if seen[result.Post.ID] {
+				t.Fatalf("post %s returned twice", result.Post.ID)
+			}
+			seen[result.Post.ID] = true
+		}
+		if next == "" {
+			break
+		}
+		if page > 5 {
+			t.Fatalf("pagination did not terminate")
+		}
+		token = next
+	}
+	if len(seen) != 5 {
+		t.Fatalf("expected 5 posts, got %d", len(seen))
+	}
+
+	_, next, _ := service.SearchPosts(ctx, SearchPostsParams{Query: "grpc", PageSize: 2})
+	if _, _, err := service.SearchPosts(ctx, SearchPostsParams{Query: "content", PageToken: next}); err != apperrors.ErrInvalidInput {
+		t.Fatalf("expected invalid input for token from another query, got %v", err)
+	}
+}
+
+func TestPostService_SearchPostsInvalidQuery(t *testing.T) {
+	repo := memory.NewPostRepository()
+	service := NewPostService(repo)
+
+	ctx := context.Background()
+	for _, query := range []string{"", `"unterminated`, "grpc OR"} {
+		if _, _, err := service.SearchPosts(ctx, SearchPostsParams{Query: query}); err != apperrors.ErrInvalidInput {
+			t.Fatalf("%q: expected invalid input, got %v", query, err)
+		}
+	}
+}
+
+func TestPostService_RebuildIndexes(t *testing.T) {
+	repo := memory.NewPostRepository()
+	ctx := context.Background()
+	if err := repo.Create(ctx, &domain.Post{ID: "existing", Title: "Stored before startup", Content: "content", Author: "author", Status: domain.StatusPublished}); err != nil {
+		t.Fatalf("create failed: %v", err)
+	}
+
+	service := NewPostService(repo)
+	if results, _, _ := service.SearchPosts(ctx, SearchPostsParams{Query: "startup"}); len(results) != 0 {
+		t.Fatalf("expected empty index before rebuild, got %+v", results)
+	}
+
+	if err := service.RebuildIndexes(ctx); err != nil {
+		t.Fatalf("rebuild failed: %v", err)
+	}
+	results, _, err := service.SearchPosts(ctx, SearchPostsParams{Query: "startup"})
+	if err != nil {
+		t.Fatalf("search failed: %v", err)
+	}
+	if len(results) != 1 || results[0].Post.ID != "existing" {
+		t.Fatalf("unexpected results: %+v", results)
+	}
+}
+
+func TestPostService_CreatePostStartsAsDraft(t *testing.T) {
+	repo := memory.NewPostRepository()
+	service := NewPostService(repo)
+
+	ctx := context.Background()
+	created, _ := service.CreatePost(ctx, "title", "content", "author", "", nil)
+	if created.Status != domain.StatusDraft {
+		t.Fatalf("expected draft, got %q", created.Status)
+	}
+}
+
+func TestPostService_DraftVisibility(t *testing.T) {
+	repo := memory.NewPostRepository()
+	service := NewPostService(repo)
+
+	anonymous := context.Background()
+	author := WithCaller(anonymous, "alice")
+	other := WithCaller(anonymous, "bob")
+
+	draft, _ := service.CreatePost(author, "draft", "content", "alice", "", nil)
+	published := mustCreatePublished(t, service, "published", "alice", "", nil)
+
+	if _, err := service.GetPost(anonymous, draft.ID); err != apperrors.ErrPostNotFound {
+		t.Fatalf("expected anonymous caller not to see draft, got %v", err)
+	}
+	if _, err := service.GetPost(other, draft.ID); err != apperrors.ErrPostNotFound {
+		t.Fatalf("expected other author not to see draft, got %v", err)
+	}
+	if _, err := service.GetPost(author, draft.ID); err != nil {
+		t.Fatalf("expected author to see own draft, got %v", err)
+	}
+	if _, err := service.GetPost(anonymous, published.ID); err != nil {
+		t.Fatalf("expected anonymous caller to see published post, got %v", err)
+	}
+
+	posts, _, _ := service.ListPosts(anonymous, ListPostsParams{})
+	if len(posts) != 1 || posts[0].ID != published.ID {
+		t.Fatalf("expected only published post for anonymous caller, got %v", posts)
+	}
+	posts, _, _ = service.ListPosts(author, ListPostsParams{})
+	if len(posts) != 2 {
+		t.Fatalf("expected author to list both posts, got %v", posts)
+	}
+	posts, _, _ = service.ListPosts(author, ListPostsParams{Status: domain.StatusDraft})
+	if len(posts) != 1 || posts[0].ID != draft.ID {
+		t.Fatalf("expected status filter to return the draft, got %v", posts)
+	}
+
+	results, _, _ := service.SearchPosts(anonymous, SearchPostsParams{Query: "draft"})
+	if len(results) != 0 {
+		t.Fatalf("expected search to hide draft from anonymous caller, got %v", results)
+	}
+}
+
+func TestPostService_StatusTransitions(t *testing.T) {
+	repo := memory.NewPostRepository()
+	service := NewPostService(repo)
+
+	ctx := context.Background()
+	created, _ := service.CreatePost(ctx, "title", "content", "author", "", nil)
+
+	if _, err := service.UnpublishPost(ctx, created.ID, ""); err != apperrors.ErrInvalidTransition {
+		t.Fatalf("expected unpublishing a draft to fail, got %v", err)
+	}
+
+	published, err := service.PublishPost(ctx, created.ID, created.ETag())
+	if err != nil {
+		t.Fatalf("publish failed: %v", err)
+	}
+	if published.Status != domain.StatusPublished || published.Version != 2 {
+		t.Fatalf("unexpected published post: %+v", published)
+	}
+	if _, err := service.PublishPost(ctx, created.ID, ""); err != apperrors.ErrInvalidTransition {
+		t.Fatalf("expected publishing twice to fail, got %v", err)
+	}
+	if _, err := service.ArchivePost(ctx, created.ID, created.ETag()); err != apperrors.ErrVersionConflict {
+		t.Fatalf("expected stale etag to be rejected, got %v", err)
+	}
+
+	unpublished, err := service.UnpublishPost(ctx, created.ID, "")
+	if err != nil || unpublished.Status != domain.StatusDraft {
+		t.Fatalf("unpublish failed: %v %+v", err, unpublished)
+	}
+
+	archived, err := service.ArchivePost(ctx, created.ID, "")
+	if err != nil || archived.Status != domain.StatusArchived {
+		t.Fatalf("archive failed: %v %+v", err, archived)
+	}
+	if _, err := service.PublishPost(ctx, created.ID, ""); err != apperrors.ErrInvalidTransition {
+		t.Fatalf("expected publishing an archived post to fail, got %v", err)
+	}
+}
+
+func TestPostService_UpdatePostStatus(t *testing.T) {
+	repo := memory.NewPostRepository()
+	service := NewPostService(repo)
+
+	ctx := context.Background()
+	created, _ := service.CreatePost(ctx, "title", "content", "author", "", nil)
+
+	updated, err := service.UpdatePost(ctx, created.ID, PostUpdate{Status: domain.StatusInReview}, []string{FieldStatus}, "")
+	if err != nil || updated.Status != domain.StatusInReview {
+		t.Fatalf("moving to review failed: %v %+v", err, updated)
+	}
+
+	archived, _ := service.ArchivePost(ctx, created.ID, "")
+	if _, err := service.UpdatePost(ctx, created.ID, PostUpdate{Status: domain.StatusPublished}, []string{FieldStatus}, archived.ETag()); err != apperrors.ErrInvalidTransition {
+		t.Fatalf("expected invalid transition, got %v", err)
+	}
+	if _, err := service.UpdatePost(ctx, created.ID, PostUpdate{Status: "bogus"}, []string{FieldStatus}, ""); err != apperrors.ErrInvalidInput {
+		t.Fatalf("expected invalid input for unknown status, got %v", err)
+	}
+}
+
+func TestPostService_PublicationDateParsing(t *testing.T) {
+	repo := memory.NewPostRepository()
+	service := NewPostService(repo)
+
+	ctx := WithCaller(context.Background(), "author")
+	if _, err := service.CreatePost(ctx, "title", "content", "author", "next tuesday", nil); err != apperrors.ErrInvalidInput {
+		t.Fatalf("expected invalid input for unparsable date, got %v", err)
+	}
+
+	_, _ = service.CreatePost(ctx, "utc", "content", "author", "2026-01-01T10:00:00Z", nil)
+	_, _ = service.CreatePost(ctx, "plus-two", "content", "author", "2026-01-01T11:00:00+02:00", nil)
+	_, _ = service.CreatePost(ctx, "date", "content", "author", "2026-01-02", nil)
+
+	posts, _, err := service.ListPosts(ctx, ListPostsParams{OrderBy: OrderPublicationDateAsc})
+	if err != nil {
+		t.Fatalf("list failed: %v", err)
+	}
+	if len(posts) != 3 || posts[0].Title != "plus-two" || posts[1].Title != "utc" || posts[2].Title != "date" {
+		t.Fatalf("expected posts ordered by instant, got %v", posts)
+	}
+
+	posts, _, _ = service.ListPosts(ctx, ListPostsParams{PublishedAfter: "2026-01-01T09:30:00Z", PublishedBefore: "2026-01-01T23:59:59Z"})
+	if len(posts) != 1 || posts[0].Title != "utc" {
+		t.Fatalf("unexpected date range result: %v", posts)
+	}
+
+	if _, _, err := service.ListPosts(ctx, ListPostsParams{PublishedAfter: "yesterday"}); err != apperrors.ErrInvalidInput {
+		t.Fatalf("expected invalid input for unparsable filter, got %v", err)
+	}
+}
+
+func TestPostService_ListPostsByID(t *testing.T) {
+	ctx := context.Background()
+
+	if _, _, err := NewPostService(memory.NewPostRepository()).ListPosts(ctx, ListPostsParams{OrderBy: OrderIDAsc}); err != apperrors.ErrInvalidInput {
+		t.Fatalf("expected invalid input for ID order with random IDs, got %v", err)
+	}
+
+	// Starting at the epoch, later snowflake IDs have more digits than the
+	// first one.
+	clk := clock.NewFake(idgen.SnowflakeEpoch)
+	ids, err := idgen.NewSnowflake(1, clk)
+	if err != nil {
+		t.Fatalf("new generator failed: %v", err)
+	}
+	service := NewPostService(memory.NewPostRepository(), WithIDGenerator(ids))
+
+	var created []string
+	for _, title := range []string{"c", "a", "b", "d"} {
+		created = append(created, mustCreatePublished(t, service, title, "alice", "", nil).ID)
+		clk.Advance(time.Hour)
+	}
+
+	var listed []string
+	token := ""
+	for {
+		page, next, err := service.ListPosts(ctx, ListPostsParams{PageSize: 3, PageToken: token, OrderBy: OrderIDDesc})
+		if err != nil {
+			t.Fatalf("list failed: %v", err)
+		}
+		for _, post := range page {
+			listed = append(listed, post.ID)
+		}
+		if next == "" {
+			break
+		}
+		token = next
+	}
+	if len(listed) != 4 || listed[0] != created[3] || listed[1] != created[2] || listed[2] != created[1] || listed[3] != created[0] {
+		t.Fatalf("expected newest first, got %q for posts created as %q", listed, created)
+	}
+
+	page, _, err := service.ListPosts(ctx, ListPostsParams{PageSize: 1, OrderBy: OrderIDAsc})
+	if err != nil || len(page) != 1 || page[0].ID != created[0] {
+		t.Fatalf("expected the oldest post first, got %+v, %v", page, err)
+	}
+}
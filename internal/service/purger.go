@@ -0,0 +1,61 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	apperrors "github.com/BhaveetKumar/gRPC-server-go/internal/errors"
+)
+
+// RunPurger permanently removes posts that have been in the trash for longer
+// than the trash retention and blocks until ctx is done. It returns at once
+// when no retention is configured.
+func (s *postService) RunPurger(ctx context.Context) {
+	if s.trashRetention <= 0 {
+		return
+	}
+	s.runPasses(ctx, s.purgeExpired, s.trashChanged)
+}
+
+// purgeExpired purges every trashed post whose retention has run out and
+// returns when the next one will, or the zero time if the trash is empty.
+func (s *postService) purgeExpired(ctx context.Context) (time.Time, error) {
+	posts, err := s.repo.List(ctx)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	now := s.clock.Now()
+	var next time.Time
+	for _, post := range posts {
+		if !post.Deleted() {
+			continue
+		}
+		expires := post.DeletedAt.Add(s.trashRetention)
+		if expires.After(now) {
+			if next.IsZero() || expires.Before(next) {
+				next = expires
+			}
+			continue
+		}
+
+		err := s.purge(ctx, post)
+		switch {
+		case err == nil:
+		case errors.Is(err, apperrors.ErrVersionConflict), errors.Is(err, apperrors.ErrPostNotFound):
+			// Restored or purged since it was listed.
+		default:
+			return next, err
+		}
+	}
+
+	return next, nil
+}
+
+func (s *postService) wakePurger() {
+	select {
+	case s.trashChanged <- struct{}{}:
+	default:
+	}
+}
@@ -0,0 +1,159 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/BhaveetKumar/gRPC-server-go/internal/clock"
+	apperrors "github.com/BhaveetKumar/gRPC-server-go/internal/errors"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/repository/memory"
+)
+
+func TestPostService_TrashRestoreAndPurge(t *testing.T) {
+	repo := memory.NewPostRepository()
+	service := NewPostService(repo)
+	ctx := WithCaller(context.Background(), "author")
+
+	post := mustCreatePublished(t, service, "title", "author", "2026-01-01", nil)
+	if err := service.DeletePost(ctx, post.ID, post.ETag()); err != nil {
+		t.Fatalf("delete failed: %v", err)
+	}
+
+	if _, err := service.GetPost(ctx, post.ID); err != apperrors.ErrPostNotFound {
+		t.Fatalf("expected trashed post to be hidden from get, got %v", err)
+	}
+	if _, err := service.UpdatePost(ctx, post.ID, PostUpdate{Title: "new"}, []string{FieldTitle}, ""); err != apperrors.ErrPostNotFound {
+		t.Fatalf("expected trashed post to reject updates, got %v", err)
+	}
+	if err := service.DeletePost(ctx, post.ID, ""); err != apperrors.ErrPostNotFound {
+		t.Fatalf("expected a second delete to report not found, got %v", err)
+	}
+	if results, _, _ := service.SearchPosts(ctx, SearchPostsParams{Query: "title"}); len(results) != 0 {
+		t.Fatalf("expected trashed post to be dropped from search, got %d results", len(results))
+	}
+
+	stored, err := repo.GetByID(context.Background(), post.ID)
+	if err != nil || !stored.Deleted() {
+		t.Fatalf("expected the post to be kept in the trash, got %+v, %v", stored, err)
+	}
+
+	restored, err := service.RestorePost(ctx, post.ID, stored.ETag())
+	if err != nil {
+		t.Fatalf("restore failed: %v", err)
+	}
+	if restored.Deleted() || restored.Title != "title" {
+		t.Fatalf("unexpected restored post: %+v", restored)
+	}
+	if _, err := service.GetPost(context.Background(), post.ID); err != nil {
+		t.Fatalf("expected restored post to be readable again, got %v", err)
+	}
+	if results, _, _ := service.SearchPosts(ctx, SearchPostsParams{Query: "title"}); len(results) != 1 {
+		t.Fatalf("expected restored post to be searchable, got %d results", len(results))
+	}
+
+	if _, err := service.RestorePost(ctx, post.ID, ""); err != apperrors.ErrNotInTrash {
+		t.Fatalf("expected restoring a live post to fail, got %v", err)
+	}
+	if err := service.PurgePost(ctx, post.ID, ""); err != apperrors.ErrNotInTrash {
+		t.Fatalf("expected purging a live post to fail, got %v", err)
+	}
+
+	if err := service.DeletePost(ctx, post.ID, ""); err != nil {
+		t.Fatalf("delete failed: %v", err)
+	}
+	if err := service.PurgePost(ctx, post.ID, "stale"); err != apperrors.ErrVersionConflict {
+		t.Fatalf("expected version conflict for a stale etag, got %v", err)
+	}
+	if err := service.PurgePost(ctx, post.ID, ""); err != nil {
+		t.Fatalf("purge failed: %v", err)
+	}
+	if _, err := repo.GetByID(context.Background(), post.ID); err != apperrors.ErrPostNotFound {
+		t.Fatalf("expected purged post to be gone, got %v", err)
+	}
+	if _, err := service.RestorePost(ctx, post.ID, ""); err != apperrors.ErrPostNotFound {
+		t.Fatalf("expected restoring a purged post to report not found, got %v", err)
+	}
+}
+
+func TestPostService_ListPostsShowDeleted(t *testing.T) {
+	service := NewPostService(memory.NewPostRepository())
+
+	live := mustCreatePublished(t, service, "live", "author", "2026-01-01", nil)
+	trashed := mustCreatePublished(t, service, "trashed", "author", "2026-01-02", nil)
+	if err := service.DeletePost(context.Background(), trashed.ID, ""); err != nil {
+		t.Fatalf("delete failed: %v", err)
+	}
+
+	owner := WithCaller(context.Background(), "author")
+	posts, _, _ := service.ListPosts(owner, ListPostsParams{})
+	if len(posts) != 1 || posts[0].ID != live.ID {
+		t.Fatalf("expected only the live post by default, got %d posts", len(posts))
+	}
+
+	posts, _, _ = service.ListPosts(owner, ListPostsParams{ShowDeleted: true})
+	if len(posts) != 2 || posts[0].ID != trashed.ID || !posts[0].Deleted() {
+		t.Fatalf("expected the author to see their trash, got %+v", posts)
+	}
+
+	// The trash is private, even for posts that were published.
+	posts, _, _ = service.ListPosts(context.Background(), ListPostsParams{ShowDeleted: true})
+	if len(posts) != 1 {
+		t.Fatalf("expected anonymous callers not to see the trash, got %d posts", len(posts))
+	}
+}
+
+func TestPurger_RemovesExpiredTrash(t *testing.T) {
+	clk := clock.NewFake(schedulerEpoch)
+	repo := memory.NewPostRepository()
+	service := NewPostService(repo, WithClock(clk), WithTrashRetention(24*time.Hour))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		service.RunPurger(ctx)
+	}()
+	t.Cleanup(func() {
+		cancel()
+		<-done
+	})
+
+	kept := mustCreatePublished(t, service, "kept", "author", "", nil)
+	expiring := mustCreatePublished(t, service, "expiring", "author", "", nil)
+	if err := service.DeletePost(context.Background(), expiring.ID, ""); err != nil {
+		t.Fatalf("delete failed: %v", err)
+	}
+
+	waitFor(t, "purger to wait on the clock", func() bool { return clk.Waiters() > 0 })
+
+	clk.Advance(23 * time.Hour)
+	if _, err := repo.GetByID(context.Background(), expiring.ID); err != nil {
+		t.Fatalf("purged too early: %v", err)
+	}
+
+	clk.Advance(time.Hour)
+	waitFor(t, "post to be purged", func() bool {
+		_, err := repo.GetByID(context.Background(), expiring.ID)
+		return err == apperrors.ErrPostNotFound
+	})
+	if _, err := repo.GetByID(context.Background(), kept.ID); err != nil {
+		t.Fatalf("live post must not be purged: %v", err)
+	}
+}
+
+func TestPurger_DisabledWithoutRetention(t *testing.T) {
+	service := NewPostService(memory.NewPostRepository())
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		service.RunPurger(context.Background())
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("expected RunPurger to return when no retention is configured")
+	}
+}
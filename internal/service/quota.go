@@ -0,0 +1,73 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/BhaveetKumar/gRPC-server-go/internal/domain"
+	apperrors "github.com/BhaveetKumar/gRPC-server-go/internal/errors"
+)
+
+// WithDailyWriteQuota lets each author create or update at most limit posts
+// per UTC day; moderators are not counted. Zero means no quota. The counts
+// are kept in memory and start over when the server restarts.
+func WithDailyWriteQuota(limit int) Option {
+	return func(s *postService) {
+		if limit > 0 {
+			s.quota = &writeQuota{limit: limit, used: make(map[string]int)}
+		}
+	}
+}
+
+// writeQuota counts the writes of every author on the current day.
+type writeQuota struct {
+	limit int
+
+	mu   sync.Mutex
+	day  time.Time
+	used map[string]int
+}
+
+// take counts a write by author at now. When the author has used up the
+// day's quota it returns false and how long until the next day starts.
+func (q *writeQuota) take(author string, now time.Time) (bool, time.Duration) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	day := now.UTC().Truncate(24 * time.Hour)
+	if !day.Equal(q.day) {
+		q.day = day
+		q.used = make(map[string]int)
+	}
+	if q.used[author] >= q.limit {
+		return false, day.Add(24 * time.Hour).Sub(now)
+	}
+	q.used[author]++
+	return true, 0
+}
+
+// give returns a write taken at now that was not made.
+func (q *writeQuota) give(author string, now time.Time) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if now.UTC().Truncate(24*time.Hour).Equal(q.day) && q.used[author] > 0 {
+		q.used[author]--
+	}
+}
+
+// chargeWrite counts a write to a post by author against the author's
+// quota. The returned func gives the write back, for when it fails.
+func (s *postService) chargeWrite(ctx context.Context, author string) (func(), error) {
+	if s.quota == nil || s.requireModerator(ctx) == nil {
+		return func() {}, nil
+	}
+
+	key := domain.NormalizeAuthorName(author)
+	ok, wait := s.quota.take(key, s.clock.Now())
+	if !ok {
+		return nil, &apperrors.RetryError{Err: apperrors.ErrQuotaExceeded, RetryAfter: wait}
+	}
+	return func() { s.quota.give(key, s.clock.Now()) }, nil
+}
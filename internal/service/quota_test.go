@@ -0,0 +1,77 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/BhaveetKumar/gRPC-server-go/internal/clock"
+	apperrors "github.com/BhaveetKumar/gRPC-server-go/internal/errors"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/repository/memory"
+)
+
+// fixedID hands out the same ID every time, so that every post after the
+// first fails to be stored.
+type fixedID string
+
+func (id fixedID) NewID() string     { return string(id) }
+func (id fixedID) TimeOrdered() bool { return false }
+
+func TestPostService_DailyWriteQuota(t *testing.T) {
+	clk := clock.NewFake(schedulerEpoch)
+	service := NewPostService(memory.NewPostRepository(), WithClock(clk), WithDailyWriteQuota(2), WithModerators("mod"))
+	ctx := context.Background()
+
+	created, err := service.CreatePost(ctx, "first", "content", "alice", "", nil)
+	if err != nil {
+		t.Fatalf("create failed: %v", err)
+	}
+	if _, err := service.UpdatePost(ctx, created.ID, PostUpdate{Title: "second"}, []string{FieldTitle}, ""); err != nil {
+		t.Fatalf("update failed: %v", err)
+	}
+
+	_, err = service.CreatePost(ctx, "third", "content", "Alice", "", nil)
+	var retry *apperrors.RetryError
+	if !errors.As(err, &retry) || retry.Err != apperrors.ErrQuotaExceeded {
+		t.Fatalf("expected the quota to be used up, got %v", err)
+	}
+	if retry.RetryAfter != 12*time.Hour {
+		t.Fatalf("expected to retry at midnight UTC, got %v", retry.RetryAfter)
+	}
+	if _, err := service.UpdatePost(ctx, created.ID, PostUpdate{Title: "third"}, []string{FieldTitle}, ""); !errors.Is(err, apperrors.ErrQuotaExceeded) {
+		t.Fatalf("expected updates to count against the quota, got %v", err)
+	}
+
+	if _, err := service.CreatePost(ctx, "other", "content", "bob", "", nil); err != nil {
+		t.Fatalf("expected other authors to have their own quota, got %v", err)
+	}
+	if _, err := service.UpdatePost(WithCaller(ctx, "mod"), created.ID, PostUpdate{Title: "moderated"}, []string{FieldTitle}, ""); err != nil {
+		t.Fatalf("expected moderators not to be counted, got %v", err)
+	}
+
+	clk.Advance(12 * time.Hour)
+	if _, err := service.CreatePost(ctx, "next day", "content", "alice", "", nil); err != nil {
+		t.Fatalf("expected the quota to start over the next day, got %v", err)
+	}
+}
+
+func TestPostService_FailedWritesNotCounted(t *testing.T) {
+	service := NewPostService(memory.NewPostRepository(), WithDailyWriteQuota(2), WithIDGenerator(fixedID("p1")))
+	ctx := context.Background()
+
+	if _, err := service.CreatePost(ctx, "", "content", "alice", "", nil); err != apperrors.ErrInvalidInput {
+		t.Fatalf("expected invalid input, got %v", err)
+	}
+	if _, err := service.CreatePost(ctx, "first", "content", "alice", "", nil); err != nil {
+		t.Fatalf("create failed: %v", err)
+	}
+	for i := 0; i < 3; i++ {
+		if _, err := service.CreatePost(ctx, "again", "content", "alice", "", nil); err != apperrors.ErrDuplicatePost {
+			t.Fatalf("expected the duplicate to be refused, got %v", err)
+		}
+	}
+	if _, err := service.UpdatePost(ctx, "p1", PostUpdate{Title: "second"}, []string{FieldTitle}, ""); err != nil {
+		t.Fatalf("expected writes that failed to be given back, got %v", err)
+	}
+}
@@ -0,0 +1,259 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/BhaveetKumar/gRPC-server-go/internal/domain"
+	apperrors "github.com/BhaveetKumar/gRPC-server-go/internal/errors"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/repository"
+)
+
+// RevisionRetention bounds how many revisions are kept per post. Zero values
+// mean no limit. The newest revision is always kept so a post's current
+// state can be restored to after later edits.
+type RevisionRetention struct {
+	MaxCount int
+	MaxAge   time.Duration
+}
+
+// WithRevisions stores revisions in store instead of in memory and prunes
+// them according to retention after every write.
+func WithRevisions(store repository.RevisionRepository, retention RevisionRetention) Option {
+	return func(s *postService) {
+		s.revisions = store
+		s.retention = retention
+	}
+}
+
+// recordRevision stores post as the revision its write produced. previous is
+// the post before the write, or nil when it was created. The write has
+// already been stored and announced by then, so a failure is logged rather
+// than returned: failing the call would make clients retry a write that
+// succeeded.
+func (s *postService) recordRevision(ctx context.Context, previous, post *domain.Post) {
+	if previous == nil {
+		previous = &domain.Post{}
+	}
+
+	rev := &domain.Revision{
+		Post:          post.Clone(),
+		Editor:        CallerFromContext(ctx),
+		CreatedAt:     s.clock.Now(),
+		ChangedFields: changedFields(previous, post),
+	}
+	if err := s.revisions.AddRevision(ctx, rev); err != nil {
+		s.logger.Error(fmt.Sprintf("record revision %d of post %s: %v", post.Version, post.ID, err))
+		return
+	}
+
+	// Pruning is retried on the next write, so a failure here does not fail
+	// the one that was just recorded.
+	_ = s.pruneRevisions(ctx, post.ID)
+}
+
+func (s *postService) pruneRevisions(ctx context.Context, postID string) error {
+	if s.retention.MaxCount <= 0 && s.retention.MaxAge <= 0 {
+		return nil
+	}
+
+	revs, err := s.revisions.ListRevisions(ctx, postID)
+	if err != nil {
+		return err
+	}
+
+	cutoff := s.clock.Now().Add(-s.retention.MaxAge)
+	var expired []int64
+	for i, rev := range revs {
+		if i == 0 {
+			continue
+		}
+		tooMany := s.retention.MaxCount > 0 && i >= s.retention.MaxCount
+		tooOld := s.retention.MaxAge > 0 && rev.CreatedAt.Before(cutoff)
+		if tooMany || tooOld {
+			expired = append(expired, rev.Post.Version)
+		}
+	}
+	if len(expired) == 0 {
+		return nil
+	}
+
+	return s.revisions.DeleteRevisions(ctx, postID, expired)
+}
+
+func (s *postService) deleteRevisions(ctx context.Context, postID string) error {
+	revs, err := s.revisions.ListRevisions(ctx, postID)
+	if err != nil {
+		return err
+	}
+	if len(revs) == 0 {
+		return nil
+	}
+
+	versions := make([]int64, 0, len(revs))
+	for _, rev := range revs {
+		versions = append(versions, rev.Post.Version)
+	}
+	return s.revisions.DeleteRevisions(ctx, postID, versions)
+}
+
+func changedFields(before, after *domain.Post) []string {
+	var changed []string
+	if before.Title != after.Title {
+		changed = append(changed, FieldTitle)
+	}
+	if before.Content != after.Content {
+		changed = append(changed, FieldContent)
+	}
+	if before.Author != after.Author {
+		changed = append(changed, FieldAuthor)
+	}
+	if before.PublicationDate != after.PublicationDate {
+		changed = append(changed, FieldPublicationDate)
+	}
+	if !equalTags(before.Tags, after.Tags) {
+		changed = append(changed, FieldTags)
+	}
+	if before.Status != after.Status {
+		changed = append(changed, FieldStatus)
+	}
+	if before.Moderation.Current() != after.Moderation.Current() {
+		changed = append(changed, FieldModeration)
+	}
+	if !before.DeletedAt.Equal(after.DeletedAt) {
+		changed = append(changed, FieldDeleteTime)
+	}
+	return changed
+}
+
+func equalTags(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// ListPostRevisions returns a post's revisions newest first. The history is
+// visible to whoever can see the post itself.
+func (s *postService) ListPostRevisions(ctx context.Context, params ListRevisionsParams) ([]*domain.Revision, string, error) {
+	if params.PageSize < 0 {
+		return nil, "", apperrors.ErrInvalidInput
+	}
+
+	pageSize := params.PageSize
+	if pageSize == 0 {
+		pageSize = defaultPageSize
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+
+	var before int64
+	if params.PageToken != "" {
+		token, err := decodePageToken(params.PageToken, revisionsFingerprint(params.PostID))
+		if err != nil {
+			return nil, "", err
+		}
+		before, err = strconv.ParseInt(token.Key, 10, 64)
+		if err != nil || before <= 0 {
+			return nil, "", apperrors.ErrInvalidInput
+		}
+	}
+
+	if _, err := s.GetPost(ctx, params.PostID); err != nil {
+		return nil, "", err
+	}
+
+	revs, err := s.revisions.ListRevisions(ctx, params.PostID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	start := 0
+	if before > 0 {
+		for start < len(revs) && revs[start].Post.Version >= before {
+			start++
+		}
+	}
+
+	end := start + pageSize
+	if end > len(revs) {
+		end = len(revs)
+	}
+	page := revs[start:end]
+
+	nextToken := ""
+	if end < len(revs) {
+		last := page[len(page)-1]
+		nextToken = encodePageToken(pageToken{
+			Key:   strconv.FormatInt(last.Post.Version, 10),
+			ID:    params.PostID,
+			Query: revisionsFingerprint(params.PostID),
+		})
+	}
+
+	return page, nextToken, nil
+}
+
+func (s *postService) GetPostRevision(ctx context.Context, postID string, revision int64) (*domain.Revision, error) {
+	if revision <= 0 {
+		return nil, apperrors.ErrInvalidInput
+	}
+
+	if _, err := s.GetPost(ctx, postID); err != nil {
+		return nil, err
+	}
+
+	return s.revisions.GetRevision(ctx, postID, revision)
+}
+
+// RestorePostRevision writes the content of an earlier revision back as a new
+// version of the post. The status is left alone: restoring text is an edit,
+// not a publish or unpublish. So is the byline, which only UpdatePost
+// changes, so that restoring cannot hand the post to an earlier author.
+func (s *postService) RestorePostRevision(ctx context.Context, postID string, revision int64, etag string) (*domain.Post, error) {
+	if postID == "" || revision <= 0 {
+		return nil, apperrors.ErrInvalidInput
+	}
+
+	rev, err := s.revisions.GetRevision(ctx, postID, revision)
+	if err != nil {
+		return nil, err
+	}
+
+	post, err := s.modify(ctx, postID, etag, func(post *domain.Post) error {
+		previousTitle := post.Title
+		post.Title = rev.Post.Title
+		post.Content = rev.Post.Content
+		post.PublicationDate = rev.Post.PublicationDate
+		post.Tags = domain.NormalizeTags(rev.Post.Tags)
+
+		if err := post.Validate(); err != nil {
+			return err
+		}
+		if err := s.updateSlug(ctx, previousTitle, post); err != nil {
+			return err
+		}
+		if post.Status == domain.StatusScheduled {
+			if at, _ := post.PublishAt(); !at.After(s.clock.Now()) {
+				return apperrors.ErrInvalidInput
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if post.Status == domain.StatusScheduled {
+		s.wakeScheduler()
+	}
+	return post, nil
+}
@@ -0,0 +1,354 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/BhaveetKumar/gRPC-server-go/internal/clock"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/domain"
+	apperrors "github.com/BhaveetKumar/gRPC-server-go/internal/errors"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/repository/memory"
+)
+
+func revisionVersions(t *testing.T, ctx context.Context, service PostService, id string) []int64 {
+	t.Helper()
+
+	revs, _, err := service.ListPostRevisions(ctx, ListRevisionsParams{PostID: id, PageSize: maxPageSize})
+	if err != nil {
+		t.Fatalf("list revisions failed: %v", err)
+	}
+	versions := make([]int64, 0, len(revs))
+	for _, rev := range revs {
+		versions = append(versions, rev.Post.Version)
+	}
+	return versions
+}
+
+func assertRevisionVersions(t *testing.T, got, want []int64) {
+	t.Helper()
+
+	if len(got) != len(want) {
+		t.Fatalf("expected revisions %v, got %v", want, got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("expected revisions %v, got %v", want, got)
+		}
+	}
+}
+
+func assertFields(t *testing.T, got, want []string) {
+	t.Helper()
+
+	if len(got) != len(want) {
+		t.Fatalf("expected changed fields %v, got %v", want, got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("expected changed fields %v, got %v", want, got)
+		}
+	}
+}
+
+func TestPostService_RevisionsRecordEveryWrite(t *testing.T) {
+	fake := clock.NewFake(time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC))
+	service := NewPostService(memory.NewPostRepository(), WithClock(fake))
+	ctx := WithCaller(context.Background(), "author")
+
+	post, err := service.CreatePost(ctx, "title", "content", "author", "", []string{"go"})
+	if err != nil {
+		t.Fatalf("create failed: %v", err)
+	}
+
+	fake.Advance(time.Minute)
+	editor := WithCaller(context.Background(), "editor")
+	if _, err := service.UpdatePost(editor, post.ID, PostUpdate{Title: "new title", Tags: []string{"go", "grpc"}}, []string{FieldTitle, FieldTags}, ""); err != nil {
+		t.Fatalf("update failed: %v", err)
+	}
+	if _, err := service.PublishPost(ctx, post.ID, ""); err != nil {
+		t.Fatalf("publish failed: %v", err)
+	}
+
+	assertRevisionVersions(t, revisionVersions(t, ctx, service, post.ID), []int64{3, 2, 1})
+
+	first, err := service.GetPostRevision(ctx, post.ID, 1)
+	if err != nil {
+		t.Fatalf("get revision 1 failed: %v", err)
+	}
+	if first.Post.Title != "title" || first.Editor != "author" || !first.CreatedAt.Equal(time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)) {
+		t.Fatalf("unexpected first revision: %+v", first)
+	}
+	assertFields(t, first.ChangedFields, []string{FieldTitle, FieldContent, FieldAuthor, FieldTags, FieldStatus})
+
+	second, _ := service.GetPostRevision(ctx, post.ID, 2)
+	if second.Post.Title != "new title" || second.Editor != "editor" || !second.CreatedAt.Equal(time.Date(2026, 3, 1, 9, 1, 0, 0, time.UTC)) {
+		t.Fatalf("unexpected second revision: %+v", second)
+	}
+	assertFields(t, second.ChangedFields, []string{FieldTitle, FieldTags})
+
+	third, _ := service.GetPostRevision(ctx, post.ID, 3)
+	assertFields(t, third.ChangedFields, []string{FieldStatus})
+
+	if _, err := service.GetPostRevision(ctx, post.ID, 4); err != apperrors.ErrRevisionNotFound {
+		t.Fatalf("expected revision not found, got %v", err)
+	}
+	if _, err := service.GetPostRevision(ctx, post.ID, 0); err != apperrors.ErrInvalidInput {
+		t.Fatalf("expected invalid input for revision 0, got %v", err)
+	}
+}
+
+func TestPostService_RevisionsRecordTrashAndRestore(t *testing.T) {
+	service := NewPostService(memory.NewPostRepository())
+	ctx := WithCaller(context.Background(), "author")
+
+	post, _ := service.CreatePost(ctx, "title", "content", "author", "", nil)
+	if err := service.DeletePost(ctx, post.ID, ""); err != nil {
+		t.Fatalf("delete failed: %v", err)
+	}
+	if _, err := service.RestorePost(ctx, post.ID, ""); err != nil {
+		t.Fatalf("restore failed: %v", err)
+	}
+
+	assertRevisionVersions(t, revisionVersions(t, ctx, service, post.ID), []int64{3, 2, 1})
+	for _, version := range []int64{2, 3} {
+		rev, err := service.GetPostRevision(ctx, post.ID, version)
+		if err != nil {
+			t.Fatalf("get revision %d failed: %v", version, err)
+		}
+		assertFields(t, rev.ChangedFields, []string{FieldDeleteTime})
+	}
+}
+
+// failingRevisions refuses to store revisions.
+type failingRevisions struct {
+	*memory.RevisionRepository
+}
+
+func (failingRevisions) AddRevision(context.Context, *domain.Revision) error {
+	return errors.New("disk full")
+}
+
+func TestPostService_RevisionFailureKeepsWrite(t *testing.T) {
+	service := NewPostService(memory.NewPostRepository(),
+		WithRevisions(failingRevisions{memory.NewRevisionRepository()}, RevisionRetention{}))
+	ctx := WithCaller(context.Background(), "author")
+
+	post, err := service.CreatePost(ctx, "title", "content", "author", "", nil)
+	if err != nil {
+		t.Fatalf("expected the stored post despite the revision failure, got %v", err)
+	}
+	updated, err := service.UpdatePost(ctx, post.ID, PostUpdate{Title: "new title"}, []string{FieldTitle}, "")
+	if err != nil {
+		t.Fatalf("expected the stored update despite the revision failure, got %v", err)
+	}
+	if updated.Version != 2 {
+		t.Fatalf("expected version 2, got %d", updated.Version)
+	}
+}
+
+func TestPostService_RevisionsFollowPostVisibility(t *testing.T) {
+	service := NewPostService(memory.NewPostRepository())
+	owner := WithCaller(context.Background(), "author")
+
+	post, err := service.CreatePost(owner, "title", "content", "author", "", nil)
+	if err != nil {
+		t.Fatalf("create failed: %v", err)
+	}
+
+	anonymous := context.Background()
+	if _, _, err := service.ListPostRevisions(anonymous, ListRevisionsParams{PostID: post.ID}); err != apperrors.ErrPostNotFound {
+		t.Fatalf("expected draft history to be hidden, got %v", err)
+	}
+	if _, err := service.GetPostRevision(anonymous, post.ID, 1); err != apperrors.ErrPostNotFound {
+		t.Fatalf("expected draft revision to be hidden, got %v", err)
+	}
+
+	if _, err := service.PublishPost(owner, post.ID, ""); err != nil {
+		t.Fatalf("publish failed: %v", err)
+	}
+	assertRevisionVersions(t, revisionVersions(t, anonymous, service, post.ID), []int64{2, 1})
+}
+
+func TestPostService_ListPostRevisionsPagination(t *testing.T) {
+	service := NewPostService(memory.NewPostRepository())
+	ctx := WithCaller(context.Background(), "author")
+
+	post, _ := service.CreatePost(ctx, "v1", "content", "author", "", nil)
+	for i := 2; i <= 5; i++ {
+		if _, err := service.UpdatePost(ctx, post.ID, PostUpdate{Title: fmt.Sprintf("v%d", i)}, []string{FieldTitle}, ""); err != nil {
+			t.Fatalf("update failed: %v", err)
+		}
+	}
+
+	var got []int64
+	token := ""
+	for {
+		revs, next, err := service.ListPostRevisions(ctx, ListRevisionsParams{PostID: post.ID, PageSize: 2, PageToken: token})
+		if err != nil {
+			t.Fatalf("list failed: %v", err)
+		}
+		for _, rev := range revs {
+			got = append(got, rev.Post.Version)
+		}
+		if next == "" {
+			break
+		}
+		token = next
+	}
+	assertRevisionVersions(t, got, []int64{5, 4, 3, 2, 1})
+
+	_, next, _ := service.ListPostRevisions(ctx, ListRevisionsParams{PostID: post.ID, PageSize: 2})
+	other, _ := service.CreatePost(ctx, "other", "content", "author", "", nil)
+	if _, _, err := service.ListPostRevisions(ctx, ListRevisionsParams{PostID: other.ID, PageToken: next}); err != apperrors.ErrInvalidInput {
+		t.Fatalf("expected a token from another post to be rejected, got %v", err)
+	}
+}
+
+func TestPostService_RestorePostRevision(t *testing.T) {
+	service := NewPostService(memory.NewPostRepository())
+	ctx := WithCaller(context.Background(), "author")
+
+	post, _ := service.CreatePost(ctx, "original", "original content", "author", "2026-01-01", []string{"go"})
+	if _, err := service.UpdatePost(ctx, post.ID, PostUpdate{Title: "edited", Content: "edited content", Author: "author"}, nil, ""); err != nil {
+		t.Fatalf("update failed: %v", err)
+	}
+	published, err := service.PublishPost(ctx, post.ID, "")
+	if err != nil {
+		t.Fatalf("publish failed: %v", err)
+	}
+
+	if _, err := service.RestorePostRevision(ctx, post.ID, 1, "stale"); err != apperrors.ErrVersionConflict {
+		t.Fatalf("expected version conflict for stale etag, got %v", err)
+	}
+
+	restored, err := service.RestorePostRevision(ctx, post.ID, 1, published.ETag())
+	if err != nil {
+		t.Fatalf("restore failed: %v", err)
+	}
+	if restored.Title != "original" || restored.Content != "original content" || len(restored.Tags) != 1 {
+		t.Fatalf("unexpected restored post: %+v", restored)
+	}
+	if restored.Status != domain.StatusPublished {
+		t.Fatalf("restore must not change the status, got %q", restored.Status)
+	}
+	if restored.Version != 4 {
+		t.Fatalf("expected restore to write version 4, got %d", restored.Version)
+	}
+
+	rev, _ := service.GetPostRevision(ctx, post.ID, 4)
+	assertFields(t, rev.ChangedFields, []string{FieldTitle, FieldContent, FieldTags})
+
+	if _, err := service.RestorePostRevision(ctx, post.ID, 9, ""); err != apperrors.ErrRevisionNotFound {
+		t.Fatalf("expected revision not found, got %v", err)
+	}
+}
+
+func TestPostService_RestorePostRevisionKeepsByline(t *testing.T) {
+	service := NewPostService(memory.NewPostRepository())
+	ctx := WithCaller(context.Background(), "bob")
+
+	post, _ := service.CreatePost(ctx, "original", "content", "alice", "", nil)
+	handed, err := service.UpdatePost(ctx, post.ID, PostUpdate{Author: "bob"}, []string{FieldAuthor}, "")
+	if err != nil {
+		t.Fatalf("update failed: %v", err)
+	}
+
+	restored, err := service.RestorePostRevision(ctx, post.ID, 1, "")
+	if err != nil {
+		t.Fatalf("restore failed: %v", err)
+	}
+	if restored.Author != "bob" || restored.AuthorID != handed.AuthorID {
+		t.Fatalf("expected the byline to stay with bob, got %q (%s)", restored.Author, restored.AuthorID)
+	}
+}
+
+func TestPostService_RevisionRetention(t *testing.T) {
+	fake := clock.NewFake(time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC))
+	revisions := memory.NewRevisionRepository()
+	service := NewPostService(memory.NewPostRepository(), WithClock(fake),
+		WithRevisions(revisions, RevisionRetention{MaxCount: 3, MaxAge: 24 * time.Hour}))
+	ctx := WithCaller(context.Background(), "author")
+
+	post, _ := service.CreatePost(ctx, "v1", "content", "author", "", nil)
+	for i := 2; i <= 5; i++ {
+		if _, err := service.UpdatePost(ctx, post.ID, PostUpdate{Title: fmt.Sprintf("v%d", i)}, []string{FieldTitle}, ""); err != nil {
+			t.Fatalf("update failed: %v", err)
+		}
+	}
+	assertRevisionVersions(t, revisionVersions(t, ctx, service, post.ID), []int64{5, 4, 3})
+
+	fake.Advance(48 * time.Hour)
+	if _, err := service.UpdatePost(ctx, post.ID, PostUpdate{Title: "v6"}, []string{FieldTitle}, ""); err != nil {
+		t.Fatalf("update failed: %v", err)
+	}
+	assertRevisionVersions(t, revisionVersions(t, ctx, service, post.ID), []int64{6})
+
+	// The newest revision survives even once it is older than MaxAge.
+	fake.Advance(48 * time.Hour)
+	if _, err := service.UpdatePost(ctx, post.ID, PostUpdate{Title: "v7"}, []string{FieldTitle}, ""); err != nil {
+		t.Fatalf("update failed: %v", err)
+	}
+	assertRevisionVersions(t, revisionVersions(t, ctx, service, post.ID), []int64{7})
+
+	if err := service.DeletePost(ctx, post.ID, ""); err != nil {
+		t.Fatalf("delete failed: %v", err)
+	}
+	// Trashing records revision 8 next to the kept revision 7.
+	if revs, _ := revisions.ListRevisions(context.Background(), post.ID); len(revs) != 2 {
+		t.Fatalf("expected revisions to be kept while the post is in the trash, got %d", len(revs))
+	}
+	if err := service.PurgePost(ctx, post.ID, ""); err != nil {
+		t.Fatalf("purge failed: %v", err)
+	}
+	if revs, _ := revisions.ListRevisions(context.Background(), post.ID); len(revs) != 0 {
+		t.Fatalf("expected revisions to be purged with the post, got %d", len(revs))
+	}
+}
+
+func TestPostService_DiffPostRevisions(t *testing.T) {
+	service := NewPostService(memory.NewPostRepository())
+	ctx := WithCaller(context.Background(), "author")
+
+	post, _ := service.CreatePost(ctx, "Title", "one\ntwo\nthree\n", "author", "", []string{"go", "grpc"})
+	update := PostUpdate{Title: "New title", Content: "one\n2\nthree\n", Author: "author", Tags: []string{"grpc", "proto"}}
+	if _, err := service.UpdatePost(ctx, post.ID, update, nil, ""); err != nil {
+		t.Fatalf("update failed: %v", err)
+	}
+	if _, err := service.PublishPost(ctx, post.ID, ""); err != nil {
+		t.Fatalf("publish failed: %v", err)
+	}
+
+	d, err := service.DiffPostRevisions(ctx, DiffRevisionsParams{PostID: post.ID, From: 1})
+	if err != nil {
+		t.Fatalf("diff failed: %v", err)
+	}
+	if d.From != 1 || d.To != 3 {
+		t.Fatalf("expected diff from 1 to the newest revision 3, got %d..%d", d.From, d.To)
+	}
+	if len(d.Fields) != 1 || d.Fields[0] != (FieldChange{Field: FieldTitle, Old: "Title", New: "New title"}) {
+		t.Fatalf("unexpected field changes: %+v", d.Fields)
+	}
+	assertFields(t, d.AddedTags, []string{"proto"})
+	assertFields(t, d.RemovedTags, []string{"go"})
+	if len(d.Content) != 1 || d.Content[0].OldStart != 1 || len(d.Content[0].Lines) != 4 {
+		t.Fatalf("unexpected content hunks: %+v", d.Content)
+	}
+
+	same, err := service.DiffPostRevisions(ctx, DiffRevisionsParams{PostID: post.ID, From: 2, To: 3})
+	if err != nil {
+		t.Fatalf("diff failed: %v", err)
+	}
+	if len(same.Fields) != 0 || len(same.Content) != 0 || len(same.AddedTags) != 0 {
+		t.Fatalf("a status-only change should have an empty diff: %+v", same)
+	}
+
+	if _, err := service.DiffPostRevisions(ctx, DiffRevisionsParams{PostID: post.ID, From: 1, To: 9}); err != apperrors.ErrRevisionNotFound {
+		t.Fatalf("expected revision not found, got %v", err)
+	}
+	if _, err := service.DiffPostRevisions(ctx, DiffRevisionsParams{PostID: post.ID, From: 0}); err != apperrors.ErrInvalidInput {
+		t.Fatalf("expected invalid input without a from revision, got %v", err)
+	}
+}
@@ -0,0 +1,96 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/BhaveetKumar/gRPC-server-go/internal/domain"
+	apperrors "github.com/BhaveetKumar/gRPC-server-go/internal/errors"
+)
+
+// schedulerRetryDelay is how long RunScheduler and RunPurger wait before
+// scanning again after the repository returned an error.
+const schedulerRetryDelay = 5 * time.Second
+
+// RunScheduler publishes scheduled posts once their publication date has
+// passed and blocks until ctx is done. Every pass scans the repository, so
+// posts that fell due while the server was down are published as soon as it
+// starts again.
+func (s *postService) RunScheduler(ctx context.Context) {
+	s.runPasses(ctx, s.publishDue, s.scheduleChanged)
+}
+
+// runPasses calls pass until ctx is done. pass returns when it next has work
+// to do, or the zero time if it does not know; it is then called again at
+// that time, when wake fires, or after schedulerRetryDelay if it failed.
+func (s *postService) runPasses(ctx context.Context, pass func(context.Context) (time.Time, error), wake <-chan struct{}) {
+	for {
+		next, err := pass(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+
+		var wait <-chan time.Time
+		switch {
+		case err != nil:
+			wait = s.clock.After(schedulerRetryDelay)
+		case !next.IsZero():
+			wait = s.clock.After(next.Sub(s.clock.Now()))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-wake:
+		case <-wait:
+		}
+	}
+}
+
+// publishDue publishes every scheduled post that is due and returns the
+// earliest publication date still in the future, or the zero time if there
+// is none.
+func (s *postService) publishDue(ctx context.Context) (time.Time, error) {
+	posts, err := s.repo.List(ctx)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	now := s.clock.Now()
+	var next time.Time
+	for _, post := range posts {
+		if post.Status != domain.StatusScheduled || post.Deleted() {
+			continue
+		}
+		at, ok := post.PublishAt()
+		if !ok {
+			continue
+		}
+		if at.After(now) {
+			if next.IsZero() || at.Before(next) {
+				next = at
+			}
+			continue
+		}
+
+		_, err := s.transition(ctx, post.ID, post.ETag(), domain.StatusScheduled, domain.StatusPublished)
+		switch {
+		case err == nil:
+		case errors.Is(err, apperrors.ErrVersionConflict), errors.Is(err, apperrors.ErrPostNotFound), errors.Is(err, apperrors.ErrInvalidTransition):
+			// The post changed since it was listed; a reschedule wakes the
+			// scheduler again, anything else means it is no longer due.
+		default:
+			return next, err
+		}
+	}
+
+	return next, nil
+}
+
+func (s *postService) wakeScheduler() {
+	select {
+	case s.scheduleChanged <- struct{}{}:
+	default:
+	}
+}
@@ -0,0 +1,160 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/BhaveetKumar/gRPC-server-go/internal/clock"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/domain"
+	apperrors "github.com/BhaveetKumar/gRPC-server-go/internal/errors"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/repository/memory"
+)
+
+var schedulerEpoch = time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+func startScheduler(t *testing.T, service PostService) {
+	t.Helper()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		service.RunScheduler(ctx)
+	}()
+	t.Cleanup(func() {
+		cancel()
+		<-done
+	})
+}
+
+// waitFor polls cond in real time; the scheduler itself only moves when the
+// fake clock is advanced.
+func waitFor(t *testing.T, what string, cond func() bool) {
+	t.Helper()
+
+	deadline := time.Now().Add(2 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %s", what)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func postStatus(t *testing.T, service PostService, id string) domain.PostStatus {
+	t.Helper()
+
+	post, err := service.GetPost(WithCaller(context.Background(), "author"), id)
+	if err != nil {
+		t.Fatalf("get failed: %v", err)
+	}
+	return post.Status
+}
+
+func TestScheduler_PublishesAtScheduledTime(t *testing.T) {
+	clk := clock.NewFake(schedulerEpoch)
+	service := NewPostService(memory.NewPostRepository(), WithClock(clk))
+	startScheduler(t, service)
+
+	ctx := context.Background()
+	created, _ := service.CreatePost(ctx, "title", "content", "author", "", nil)
+
+	scheduled, err := service.SchedulePost(ctx, created.ID, schedulerEpoch.Add(time.Hour), "")
+	if err != nil {
+		t.Fatalf("schedule failed: %v", err)
+	}
+	if scheduled.Status != domain.StatusScheduled || scheduled.PublicationDate != "2026-03-01T13:00:00Z" {
+		t.Fatalf("unexpected scheduled post: %+v", scheduled)
+	}
+
+	waitFor(t, "scheduler to wait on the clock", func() bool { return clk.Waiters() > 0 })
+
+	clk.Advance(59 * time.Minute)
+	if got := postStatus(t, service, created.ID); got != domain.StatusScheduled {
+		t.Fatalf("published too early: %q", got)
+	}
+
+	clk.Advance(time.Minute)
+	waitFor(t, "post to be published", func() bool {
+		return postStatus(t, service, created.ID) == domain.StatusPublished
+	})
+}
+
+func TestScheduler_RescheduleEarlier(t *testing.T) {
+	clk := clock.NewFake(schedulerEpoch)
+	service := NewPostService(memory.NewPostRepository(), WithClock(clk))
+	startScheduler(t, service)
+
+	ctx := context.Background()
+	late, _ := service.CreatePost(ctx, "late", "content", "author", "", nil)
+	early, _ := service.CreatePost(ctx, "early", "content", "author", "", nil)
+
+	_, _ = service.SchedulePost(ctx, late.ID, schedulerEpoch.Add(24*time.Hour), "")
+	waitFor(t, "first schedule to be picked up", func() bool { return clk.Waiters() > 0 })
+
+	_, _ = service.SchedulePost(ctx, early.ID, schedulerEpoch.Add(time.Minute), "")
+	waitFor(t, "second schedule to be picked up", func() bool { return clk.Waiters() > 1 })
+
+	clk.Advance(time.Minute)
+	waitFor(t, "early post to be published", func() bool {
+		return postStatus(t, service, early.ID) == domain.StatusPublished
+	})
+	if got := postStatus(t, service, late.ID); got != domain.StatusScheduled {
+		t.Fatalf("late post should still be scheduled, got %q", got)
+	}
+}
+
+func TestScheduler_PublishesPostsThatFellDueWhileStopped(t *testing.T) {
+	repo := memory.NewPostRepository()
+	ctx := context.Background()
+	due := &domain.Post{ID: "due", Title: "t", Content: "c", Author: "author", PublicationDate: "2026-03-01T11:00:00Z", Status: domain.StatusScheduled}
+	future := &domain.Post{ID: "future", Title: "t", Content: "c", Author: "author", PublicationDate: "2026-03-02", Status: domain.StatusScheduled}
+	_ = repo.Create(ctx, due)
+	_ = repo.Create(ctx, future)
+
+	clk := clock.NewFake(schedulerEpoch)
+	service := NewPostService(repo, WithClock(clk))
+	startScheduler(t, service)
+
+	waitFor(t, "overdue post to be published", func() bool {
+		return postStatus(t, service, "due") == domain.StatusPublished
+	})
+	if got := postStatus(t, service, "future"); got != domain.StatusScheduled {
+		t.Fatalf("future post should still be scheduled, got %q", got)
+	}
+}
+
+func TestPostService_SchedulePostValidation(t *testing.T) {
+	clk := clock.NewFake(schedulerEpoch)
+	service := NewPostService(memory.NewPostRepository(), WithClock(clk))
+
+	ctx := context.Background()
+	created, _ := service.CreatePost(ctx, "title", "content", "author", "", nil)
+
+	if _, err := service.SchedulePost(ctx, created.ID, schedulerEpoch, ""); err != apperrors.ErrInvalidInput {
+		t.Fatalf("expected invalid input for a time that is not in the future, got %v", err)
+	}
+
+	if _, err := service.SchedulePost(ctx, created.ID, schedulerEpoch.Add(time.Hour), ""); err != nil {
+		t.Fatalf("schedule failed: %v", err)
+	}
+	rescheduled, err := service.SchedulePost(ctx, created.ID, schedulerEpoch.Add(2*time.Hour), "")
+	if err != nil {
+		t.Fatalf("reschedule failed: %v", err)
+	}
+	if rescheduled.PublicationDate != "2026-03-01T14:00:00Z" {
+		t.Fatalf("unexpected publication date: %s", rescheduled.PublicationDate)
+	}
+
+	_, _ = service.ArchivePost(ctx, created.ID, "")
+	if _, err := service.SchedulePost(ctx, created.ID, schedulerEpoch.Add(time.Hour), ""); err != apperrors.ErrInvalidTransition {
+		t.Fatalf("expected invalid transition for archived post, got %v", err)
+	}
+
+	other, _ := service.CreatePost(ctx, "title", "content", "author", "2026-01-01", nil)
+	_, err = service.UpdatePost(ctx, other.ID, PostUpdate{Status: domain.StatusScheduled}, []string{FieldStatus}, "")
+	if err != apperrors.ErrInvalidInput {
+		t.Fatalf("expected invalid input when scheduling for a past date, got %v", err)
+	}
+}
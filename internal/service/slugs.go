@@ -0,0 +1,111 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strconv"
+
+	"github.com/BhaveetKumar/gRPC-server-go/internal/domain"
+	apperrors "github.com/BhaveetKumar/gRPC-server-go/internal/errors"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/repository"
+)
+
+// maxSlugSuffix is the highest collision suffix assignSlug tries before it
+// falls back to the post ID.
+const maxSlugSuffix = 100
+
+// WithSlugs keeps post slugs in store. Without it the service keeps its own
+// in-memory slugs.
+func WithSlugs(store repository.SlugRepository) Option {
+	return func(s *postService) {
+		s.slugs = store
+	}
+}
+
+// assignSlug gives post a slug made from its title, suffixed with -2, -3 and
+// so on while the plain one belongs to another post. The slugs a post held
+// before stay claimed by it, so a post whose title goes back to an earlier
+// one gets its earlier slug back.
+func (s *postService) assignSlug(ctx context.Context, post *domain.Post) error {
+	base := domain.Slugify(post.Title)
+	if base == "" {
+		base = "post"
+	}
+
+	for n := 1; n <= maxSlugSuffix; n++ {
+		slug := base
+		if n > 1 {
+			slug += "-" + strconv.Itoa(n)
+		}
+		err := s.slugs.ClaimSlug(ctx, slug, post.ID)
+		if errors.Is(err, apperrors.ErrDuplicateSlug) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		post.Slug = slug
+		return nil
+	}
+
+	slug := base + "-" + post.ID
+	if err := s.slugs.ClaimSlug(ctx, slug, post.ID); err != nil {
+		return err
+	}
+	post.Slug = slug
+	return nil
+}
+
+// updateSlug moves post to a new slug when a change of title changed what
+// its slug is made from.
+func (s *postService) updateSlug(ctx context.Context, previousTitle string, post *domain.Post) error {
+	if post.Slug != "" && domain.Slugify(previousTitle) == domain.Slugify(post.Title) {
+		return nil
+	}
+	return s.assignSlug(ctx, post)
+}
+
+// GetPostBySlug finds a post by any slug it has held. The slug is matched as
+// given and, failing that, in slug form, so "Hello World" finds
+// "hello-world"; redirect reports that the post's current slug is another.
+func (s *postService) GetPostBySlug(ctx context.Context, slug string) (*domain.Post, bool, error) {
+	if slug == "" {
+		return nil, false, apperrors.ErrInvalidInput
+	}
+
+	id, err := s.slugs.ResolveSlug(ctx, slug)
+	if errors.Is(err, apperrors.ErrPostNotFound) {
+		if normalized := domain.Slugify(slug); normalized != "" && normalized != slug {
+			id, err = s.slugs.ResolveSlug(ctx, normalized)
+		}
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	post, err := s.GetPost(ctx, id)
+	if err != nil {
+		return nil, false, err
+	}
+	return post, slug != post.Slug, nil
+}
+
+func (s *postService) MigrateSlugs(ctx context.Context) (int, error) {
+	posts, err := s.repo.List(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	// The oldest post with a title gets the slug without a suffix.
+	sort.Slice(posts, func(i, j int) bool {
+		return postLess(posts[i], posts[j], OrderPublicationDateAsc)
+	})
+
+	return s.rewriteEach(ctx, posts, func(post *domain.Post) (bool, error) {
+		if post.Slug != "" {
+			return false, nil
+		}
+		return true, s.assignSlug(ctx, post)
+	})
+}
@@ -0,0 +1,128 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/BhaveetKumar/gRPC-server-go/internal/domain"
+	apperrors "github.com/BhaveetKumar/gRPC-server-go/internal/errors"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/repository/memory"
+)
+
+func TestPostService_Slugs(t *testing.T) {
+	service := NewPostService(memory.NewPostRepository())
+	ctx := context.Background()
+
+	first := mustCreatePublished(t, service, "Crème Brûlée: Привет!", "alice", "", nil)
+	second := mustCreatePublished(t, service, "Creme brulee privet", "bob", "", nil)
+	untitled := mustCreatePublished(t, service, "日本語", "bob", "", nil)
+	if first.Slug != "creme-brulee-privet" || second.Slug != "creme-brulee-privet-2" || untitled.Slug != "post" {
+		t.Fatalf("unexpected slugs: %q, %q, %q", first.Slug, second.Slug, untitled.Slug)
+	}
+
+	post, redirect, err := service.GetPostBySlug(ctx, "creme-brulee-privet-2")
+	if err != nil || post.ID != second.ID || redirect {
+		t.Fatalf("expected the current slug to resolve without a redirect, got %v, %t, %v", post, redirect, err)
+	}
+
+	renamed, err := service.UpdatePost(ctx, first.ID, PostUpdate{Title: "Tarte Tatin"}, []string{FieldTitle}, "")
+	if err != nil {
+		t.Fatalf("update failed: %v", err)
+	}
+	if renamed.Slug != "tarte-tatin" {
+		t.Fatalf("expected a new slug after a title change, got %q", renamed.Slug)
+	}
+	post, redirect, err = service.GetPostBySlug(ctx, "creme-brulee-privet")
+	if err != nil || post.ID != first.ID || !redirect || post.Slug != "tarte-tatin" {
+		t.Fatalf("expected the old slug to redirect, got %v, %t, %v", post, redirect, err)
+	}
+	if _, redirect, err := service.GetPostBySlug(ctx, "Tarte Tatin"); err != nil || !redirect {
+		t.Fatalf("expected a title-like slug to resolve as a redirect, got %t, %v", redirect, err)
+	}
+
+	// A new post cannot take a slug that still leads to another post.
+	third := mustCreatePublished(t, service, "Crème brûlée, privet", "carol", "", nil)
+	if third.Slug != "creme-brulee-privet-3" {
+		t.Fatalf("expected a former slug to stay taken, got %q", third.Slug)
+	}
+
+	// Changing the title back returns the post to its own former slug.
+	restored, err := service.UpdatePost(ctx, first.ID, PostUpdate{Title: "Creme Brulee -- Privet"}, []string{FieldTitle}, "")
+	if err != nil || restored.Slug != "creme-brulee-privet" {
+		t.Fatalf("expected the post to get its old slug back, got %q, %v", restored.Slug, err)
+	}
+	unchanged, err := service.UpdatePost(ctx, first.ID, PostUpdate{Content: "new content"}, []string{FieldContent}, "")
+	if err != nil || unchanged.Slug != "creme-brulee-privet" {
+		t.Fatalf("expected edits that keep the title to keep the slug, got %q, %v", unchanged.Slug, err)
+	}
+
+	if _, _, err := service.GetPostBySlug(ctx, "missing"); err != apperrors.ErrPostNotFound {
+		t.Fatalf("expected not found for an unknown slug, got %v", err)
+	}
+	if _, _, err := service.GetPostBySlug(ctx, ""); err != apperrors.ErrInvalidInput {
+		t.Fatalf("expected invalid input for an empty slug, got %v", err)
+	}
+}
+
+func TestPostService_SlugVisibilityAndPurge(t *testing.T) {
+	service := NewPostService(memory.NewPostRepository())
+	ctx := context.Background()
+
+	draft, err := service.CreatePost(ctx, "Secret plans", "content", "alice", "", nil)
+	if err != nil {
+		t.Fatalf("create failed: %v", err)
+	}
+	if _, _, err := service.GetPostBySlug(ctx, draft.Slug); err != apperrors.ErrPostNotFound {
+		t.Fatalf("expected a draft to be hidden from readers, got %v", err)
+	}
+	if _, _, err := service.GetPostBySlug(WithCaller(ctx, "alice"), draft.Slug); err != nil {
+		t.Fatalf("expected the author to find their draft, got %v", err)
+	}
+
+	if err := service.DeletePost(ctx, draft.ID, ""); err != nil {
+		t.Fatalf("delete failed: %v", err)
+	}
+	if err := service.PurgePost(ctx, draft.ID, ""); err != nil {
+		t.Fatalf("purge failed: %v", err)
+	}
+	again, err := service.CreatePost(ctx, "Secret plans", "content", "bob", "", nil)
+	if err != nil || again.Slug != "secret-plans" {
+		t.Fatalf("expected a purged post's slug to be released, got %q, %v", again.Slug, err)
+	}
+
+	long := mustCreatePublished(t, service, strings.Repeat("word ", 40), "bob", "", nil)
+	if len(long.Slug) > domain.MaxSlugLength || strings.HasSuffix(long.Slug, "-") {
+		t.Fatalf("unexpected slug for a long title: %q", long.Slug)
+	}
+}
+
+func TestPostService_MigrateSlugs(t *testing.T) {
+	repo := memory.NewPostRepository()
+	ctx := context.Background()
+
+	// Posts stored before slugs existed have none.
+	for _, post := range []*domain.Post{
+		{ID: "p1", Title: "Hello", Content: "c", Author: "alice", PublicationDate: "2026-02-01", Status: domain.StatusPublished},
+		{ID: "p2", Title: "hello", Content: "c", Author: "alice", PublicationDate: "2026-01-01", Status: domain.StatusPublished},
+	} {
+		if err := repo.Create(ctx, post); err != nil {
+			t.Fatalf("seed %s failed: %v", post.ID, err)
+		}
+	}
+
+	service := NewPostService(repo)
+	migrated, err := service.MigrateSlugs(ctx)
+	if err != nil || migrated != 2 {
+		t.Fatalf("expected 2 posts to get slugs, got %d, %v", migrated, err)
+	}
+
+	p1, _ := repo.GetByID(ctx, "p1")
+	p2, _ := repo.GetByID(ctx, "p2")
+	if p2.Slug != "hello" || p1.Slug != "hello-2" {
+		t.Fatalf("expected the older post to get the plain slug, got %q and %q", p2.Slug, p1.Slug)
+	}
+	if migrated, err := service.MigrateSlugs(ctx); err != nil || migrated != 0 {
+		t.Fatalf("expected a second migration to do nothing, got %d, %v", migrated, err)
+	}
+}
@@ -0,0 +1,299 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/BhaveetKumar/gRPC-server-go/internal/domain"
+	apperrors "github.com/BhaveetKumar/gRPC-server-go/internal/errors"
+)
+
+// maxRetagAttempts bounds how often RenameTag and MergeTags start over after
+// a concurrent write to one of the posts they rewrite.
+const maxRetagAttempts = 5
+
+// tagIndex counts, for every tag, the posts carrying it that every reader can
+// see. Like the search index it is updated by each write and ignores versions
+// older than the one it already has, so racing writers cannot roll it back.
+type tagIndex struct {
+	mu     sync.RWMutex
+	posts  map[string]taggedPost
+	counts map[string]int
+}
+
+type taggedPost struct {
+	version int64
+	tags    []string
+}
+
+func newTagIndex() *tagIndex {
+	return &tagIndex{
+		posts:  make(map[string]taggedPost),
+		counts: make(map[string]int),
+	}
+}
+
+func (ix *tagIndex) set(post *domain.Post) {
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+
+	if existing, ok := ix.posts[post.ID]; ok {
+		if existing.version > post.Version {
+			return
+		}
+		ix.removeLocked(post.ID)
+	}
+
+	var tags []string
+	if !post.Deleted() && post.VisibleTo("") {
+		tags = append(tags, post.Tags...)
+	}
+	for _, tag := range tags {
+		ix.counts[tag]++
+	}
+	ix.posts[post.ID] = taggedPost{version: post.Version, tags: tags}
+}
+
+func (ix *tagIndex) remove(id string) {
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+
+	ix.removeLocked(id)
+}
+
+func (ix *tagIndex) removeLocked(id string) {
+	for _, tag := range ix.posts[id].tags {
+		if ix.counts[tag]--; ix.counts[tag] == 0 {
+			delete(ix.counts, tag)
+		}
+	}
+	delete(ix.posts, id)
+}
+
+func (ix *tagIndex) reset() {
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+
+	ix.posts = make(map[string]taggedPost)
+	ix.counts = make(map[string]int)
+}
+
+func (ix *tagIndex) list() []*TagCount {
+	ix.mu.RLock()
+	defer ix.mu.RUnlock()
+
+	tags := make([]*TagCount, 0, len(ix.counts))
+	for tag, posts := range ix.counts {
+		tags = append(tags, &TagCount{Tag: tag, Posts: posts})
+	}
+	return tags
+}
+
+// ListTags returns the tags of the posts every reader can see, most used
+// first unless params asks for name order.
+func (s *postService) ListTags(ctx context.Context, params ListTagsParams) ([]*TagCount, string, error) {
+	if params.PageSize < 0 || params.OrderBy < TagOrderCount || params.OrderBy > TagOrderName {
+		return nil, "", apperrors.ErrInvalidInput
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, "", err
+	}
+
+	pageSize := params.PageSize
+	if pageSize == 0 {
+		pageSize = defaultPageSize
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+
+	var cursor *pageToken
+	if params.PageToken != "" {
+		token, err := decodePageToken(params.PageToken, tagsFingerprint(params.OrderBy))
+		if err != nil {
+			return nil, "", err
+		}
+		cursor = token
+	}
+
+	tags := s.tags.list()
+	sort.Slice(tags, func(i, j int) bool {
+		return compareTags(tagSortKey(tags[i], params.OrderBy), tags[i].Tag, tagSortKey(tags[j], params.OrderBy), tags[j].Tag, params.OrderBy) < 0
+	})
+
+	start := 0
+	if cursor != nil {
+		start = sort.Search(len(tags), func(i int) bool {
+			return compareTags(cursor.Key, cursor.ID, tagSortKey(tags[i], params.OrderBy), tags[i].Tag, params.OrderBy) < 0
+		})
+	}
+
+	end := start + pageSize
+	if end > len(tags) {
+		end = len(tags)
+	}
+	page := tags[start:end]
+
+	nextToken := ""
+	if end < len(tags) {
+		last := page[len(page)-1]
+		nextToken = encodePageToken(pageToken{
+			Key:   tagSortKey(last, params.OrderBy),
+			ID:    last.Tag,
+			Query: tagsFingerprint(params.OrderBy),
+		})
+	}
+
+	return page, nextToken, nil
+}
+
+func tagSortKey(tag *TagCount, order TagOrder) string {
+	if order == TagOrderName {
+		return tag.Tag
+	}
+	return fmt.Sprintf("%010d", tag.Posts)
+}
+
+// compareTags orders by descending count or by name, breaking ties by name.
+func compareTags(keyA, tagA, keyB, tagB string, order TagOrder) int {
+	if order == TagOrderName {
+		return compareKeys(keyA, tagA, keyB, tagB, OrderTitleAsc)
+	}
+	return compareKeys(keyA, tagA, keyB, tagB, OrderTitleDesc)
+}
+
+func (s *postService) RenameTag(ctx context.Context, from, to string) (int, error) {
+	if err := s.requireModerator(ctx); err != nil {
+		return 0, err
+	}
+
+	from, to = domain.NormalizeTag(from), domain.NormalizeTag(to)
+	if from == "" || to == "" || from == to {
+		return 0, apperrors.ErrInvalidInput
+	}
+
+	return s.retag(ctx, []string{from}, to, func(posts []*domain.Post) error {
+		for _, post := range posts {
+			if hasTag(post.Tags, to) {
+				return apperrors.ErrDuplicateTag
+			}
+		}
+		return nil
+	})
+}
+
+func (s *postService) MergeTags(ctx context.Context, sources []string, target string) (int, error) {
+	if err := s.requireModerator(ctx); err != nil {
+		return 0, err
+	}
+
+	target = domain.NormalizeTag(target)
+	var merged []string
+	for _, tag := range domain.NormalizeTags(sources) {
+		if tag != target {
+			merged = append(merged, tag)
+		}
+	}
+	if target == "" || len(merged) == 0 {
+		return 0, apperrors.ErrInvalidInput
+	}
+
+	return s.retag(ctx, merged, target, nil)
+}
+
+// retag replaces sources with target on every post carrying one of them,
+// trashed posts included, and returns the number of posts it rewrote. All
+// posts are stored in one UpdateAll so no reader sees a tag half renamed;
+// when a concurrent write makes it fail, retag starts over from a fresh
+// listing. check, if set, can reject the rewrite after seeing every post.
+// Like rewriteEach these writes bypass moderation: they are made by a
+// moderator and leave the wording of the posts alone.
+func (s *postService) retag(ctx context.Context, sources []string, target string, check func([]*domain.Post) error) (int, error) {
+	s.retagMu.Lock()
+	defer s.retagMu.Unlock()
+
+	replace := make(map[string]bool, len(sources))
+	for _, tag := range sources {
+		replace[tag] = true
+	}
+
+	for attempt := 0; attempt < maxRetagAttempts; attempt++ {
+		posts, err := s.repo.List(ctx)
+		if err != nil {
+			return 0, err
+		}
+		if check != nil {
+			if err := check(posts); err != nil {
+				return 0, err
+			}
+		}
+
+		var changed, previous []*domain.Post
+		for _, post := range posts {
+			tags, ok := replaceTags(post.Tags, replace, target)
+			if !ok {
+				continue
+			}
+			previous = append(previous, post.Clone())
+			post.Tags = tags
+			changed = append(changed, post)
+		}
+		if len(changed) == 0 {
+			return 0, apperrors.ErrTagNotFound
+		}
+
+		err = s.repo.UpdateAll(ctx, changed)
+		if errors.Is(err, apperrors.ErrVersionConflict) || errors.Is(err, apperrors.ErrPostNotFound) {
+			continue
+		}
+		if err != nil {
+			return 0, err
+		}
+
+		for i, post := range changed {
+			s.indexPost(post)
+			if !post.Deleted() {
+				s.events.publish(domain.PostUpdated, post)
+			}
+			s.recordRevision(ctx, previous[i], post)
+		}
+		return len(changed), nil
+	}
+	return 0, apperrors.ErrVersionConflict
+}
+
+// replaceTags returns tags with every tag in replace swapped for target, and
+// whether there was any to swap.
+func replaceTags(tags []string, replace map[string]bool, target string) ([]string, bool) {
+	replaced := false
+	result := make([]string, len(tags))
+	for i, tag := range tags {
+		if replace[domain.NormalizeTag(tag)] {
+			tag, replaced = target, true
+		}
+		result[i] = tag
+	}
+	if !replaced {
+		return tags, false
+	}
+	return domain.NormalizeTags(result), true
+}
+
+func (s *postService) MigrateTags(ctx context.Context) (int, error) {
+	posts, err := s.repo.List(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return s.rewriteEach(ctx, posts, func(post *domain.Post) (bool, error) {
+		tags := domain.NormalizeTags(post.Tags)
+		if equalTags(post.Tags, tags) {
+			return false, nil
+		}
+		post.Tags = tags
+		return true, nil
+	})
+}
@@ -0,0 +1,207 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/BhaveetKumar/gRPC-server-go/internal/domain"
+	apperrors "github.com/BhaveetKumar/gRPC-server-go/internal/errors"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/repository/memory"
+)
+
+func assertTagList(t *testing.T, got []string, want ...string) {
+	t.Helper()
+
+	if len(got) != len(want) {
+		t.Fatalf("unexpected tags: got %q, want %q", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("unexpected tags: got %q, want %q", got, want)
+		}
+	}
+}
+
+func TestPostService_NormalizesTags(t *testing.T) {
+	service := NewPostService(memory.NewPostRepository())
+	ctx := context.Background()
+
+	post := mustCreatePublished(t, service, "title", "alice", "", []string{"  Go ", "go", "Go Lang", "go_lang", "!!", "Café"})
+	assertTagList(t, post.Tags, "go", "go-lang", "café")
+
+	posts, _, err := service.ListPosts(ctx, ListPostsParams{Tag: " GO-Lang "})
+	if err != nil || len(posts) != 1 {
+		t.Fatalf("expected the tag filter to be normalized, got %d posts, %v", len(posts), err)
+	}
+	if _, _, err := service.ListPosts(ctx, ListPostsParams{Tag: "--"}); err != apperrors.ErrInvalidInput {
+		t.Fatalf("expected invalid input for an empty tag filter, got %v", err)
+	}
+
+	updated, err := service.UpdatePost(ctx, post.ID, PostUpdate{Tags: []string{"gRPC", " grpc"}}, []string{FieldTags}, "")
+	if err != nil {
+		t.Fatalf("update failed: %v", err)
+	}
+	assertTagList(t, updated.Tags, "grpc")
+}
+
+func TestPostService_ListTags(t *testing.T) {
+	service := NewPostService(memory.NewPostRepository())
+	ctx := context.Background()
+
+	mustCreatePublished(t, service, "one", "alice", "", []string{"go", "grpc"})
+	mustCreatePublished(t, service, "two", "alice", "", []string{"go"})
+	trashed := mustCreatePublished(t, service, "three", "alice", "", []string{"go", "rust"})
+	if _, err := service.CreatePost(ctx, "draft", "content", "alice", "", []string{"go", "draft"}); err != nil {
+		t.Fatalf("create failed: %v", err)
+	}
+	if err := service.DeletePost(ctx, trashed.ID, ""); err != nil {
+		t.Fatalf("delete failed: %v", err)
+	}
+
+	tags, next, err := service.ListTags(ctx, ListTagsParams{})
+	if err != nil || next != "" {
+		t.Fatalf("list tags failed: %v (next %q)", err, next)
+	}
+	if len(tags) != 2 || *tags[0] != (TagCount{Tag: "go", Posts: 2}) || *tags[1] != (TagCount{Tag: "grpc", Posts: 1}) {
+		t.Fatalf("expected only the tags of public posts, got %+v", tags)
+	}
+
+	if _, err := service.RestorePost(ctx, trashed.ID, ""); err != nil {
+		t.Fatalf("restore failed: %v", err)
+	}
+
+	var names []string
+	token := ""
+	for {
+		page, next, err := service.ListTags(ctx, ListTagsParams{PageSize: 2, PageToken: token, OrderBy: TagOrderName})
+		if err != nil {
+			t.Fatalf("list tags failed: %v", err)
+		}
+		for _, tag := range page {
+			names = append(names, tag.Tag)
+		}
+		if next == "" {
+			break
+		}
+		token = next
+	}
+	assertTagList(t, names, "go", "grpc", "rust")
+
+	if _, _, err := service.ListTags(ctx, ListTagsParams{PageToken: token}); err != apperrors.ErrInvalidInput {
+		t.Fatalf("expected a token from another order to be rejected, got %v", err)
+	}
+}
+
+func TestPostService_RenameTag(t *testing.T) {
+	service := NewPostService(memory.NewPostRepository(), WithModerators("mod"))
+	ctx := context.Background()
+	mod := WithCaller(ctx, "mod")
+
+	first := mustCreatePublished(t, service, "one", "alice", "", []string{"golang", "grpc"})
+	trashed := mustCreatePublished(t, service, "two", "bob", "", []string{"golang"})
+	if err := service.DeletePost(ctx, trashed.ID, ""); err != nil {
+		t.Fatalf("delete failed: %v", err)
+	}
+
+	if _, err := service.RenameTag(ctx, "golang", "go"); err != apperrors.ErrPermissionDenied {
+		t.Fatalf("expected permission denied for a non-moderator, got %v", err)
+	}
+	if _, err := service.RenameTag(mod, "Golang", "golang"); err != apperrors.ErrInvalidInput {
+		t.Fatalf("expected invalid input for a rename to the same tag, got %v", err)
+	}
+	if _, err := service.RenameTag(mod, "missing", "go"); err != apperrors.ErrTagNotFound {
+		t.Fatalf("expected tag not found, got %v", err)
+	}
+	if _, err := service.RenameTag(mod, "golang", "gRPC"); err != apperrors.ErrDuplicateTag {
+		t.Fatalf("expected duplicate tag, got %v", err)
+	}
+
+	changed, err := service.RenameTag(mod, "GoLang", "Go")
+	if err != nil || changed != 2 {
+		t.Fatalf("expected 2 posts to be renamed, got %d, %v", changed, err)
+	}
+
+	renamed, _ := service.GetPost(ctx, first.ID)
+	assertTagList(t, renamed.Tags, "go", "grpc")
+	restored, err := service.RestorePost(ctx, trashed.ID, "")
+	if err != nil {
+		t.Fatalf("restore failed: %v", err)
+	}
+	assertTagList(t, restored.Tags, "go")
+
+	revs, _, err := service.ListPostRevisions(ctx, ListRevisionsParams{PostID: first.ID})
+	if err != nil || len(revs) == 0 || revs[0].Editor != "mod" || len(revs[0].ChangedFields) != 1 || revs[0].ChangedFields[0] != FieldTags {
+		t.Fatalf("expected the rename to be recorded as a revision, got %+v, %v", revs, err)
+	}
+
+	tags, _, _ := service.ListTags(ctx, ListTagsParams{OrderBy: TagOrderName})
+	if len(tags) != 2 || *tags[0] != (TagCount{Tag: "go", Posts: 2}) {
+		t.Fatalf("expected the tag index to follow the rename, got %+v", tags)
+	}
+}
+
+func TestPostService_MergeTags(t *testing.T) {
+	service := NewPostService(memory.NewPostRepository(), WithModerators("mod"))
+	ctx := context.Background()
+	mod := WithCaller(ctx, "mod")
+
+	both := mustCreatePublished(t, service, "one", "alice", "", []string{"golang", "testing", "go-lang"})
+	target := mustCreatePublished(t, service, "two", "alice", "", []string{"go"})
+	other := mustCreatePublished(t, service, "three", "alice", "", []string{"rust"})
+
+	if _, err := service.MergeTags(mod, []string{"go"}, "Go"); err != apperrors.ErrInvalidInput {
+		t.Fatalf("expected invalid input for merging a tag into itself, got %v", err)
+	}
+	if _, err := service.MergeTags(mod, []string{"missing"}, "go"); err != apperrors.ErrTagNotFound {
+		t.Fatalf("expected tag not found, got %v", err)
+	}
+
+	changed, err := service.MergeTags(mod, []string{"golang", "Go Lang", "go"}, "go")
+	if err != nil || changed != 1 {
+		t.Fatalf("expected 1 post to be merged, got %d, %v", changed, err)
+	}
+
+	merged, _ := service.GetPost(ctx, both.ID)
+	assertTagList(t, merged.Tags, "go", "testing")
+	for _, post := range []*domain.Post{target, other} {
+		if unchanged, _ := service.GetPost(ctx, post.ID); unchanged.Version != post.Version {
+			t.Fatalf("expected post %s to be left alone", post.Title)
+		}
+	}
+
+	tags, _, _ := service.ListTags(ctx, ListTagsParams{})
+	if len(tags) != 3 || *tags[0] != (TagCount{Tag: "go", Posts: 2}) {
+		t.Fatalf("expected the tag index to follow the merge, got %+v", tags)
+	}
+}
+
+func TestPostService_MigrateTags(t *testing.T) {
+	repo := memory.NewPostRepository()
+	ctx := context.Background()
+
+	// Posts stored before tags were normalized keep their raw tags.
+	for _, post := range []*domain.Post{
+		{ID: "p1", Title: "t", Content: "c", Author: "alice", Tags: []string{" Go", "go ", "gRPC"}, Status: domain.StatusPublished},
+		{ID: "p2", Title: "t", Content: "c", Author: "alice", Tags: []string{"go"}, Status: domain.StatusPublished},
+	} {
+		if err := repo.Create(ctx, post); err != nil {
+			t.Fatalf("seed %s failed: %v", post.ID, err)
+		}
+	}
+
+	service := NewPostService(repo)
+	migrated, err := service.MigrateTags(ctx)
+	if err != nil || migrated != 1 {
+		t.Fatalf("expected 1 post to be normalized, got %d, %v", migrated, err)
+	}
+	p1, _ := repo.GetByID(ctx, "p1")
+	assertTagList(t, p1.Tags, "go", "grpc")
+
+	if err := service.RebuildIndexes(ctx); err != nil {
+		t.Fatalf("rebuild failed: %v", err)
+	}
+	tags, _, _ := service.ListTags(ctx, ListTagsParams{})
+	if len(tags) != 2 || *tags[0] != (TagCount{Tag: "go", Posts: 2}) {
+		t.Fatalf("expected the rebuilt tag index to count both posts, got %+v", tags)
+	}
+}
@@ -0,0 +1,236 @@
+// Package tlsconfig builds the TLS configuration of the server and the
+// client from PEM files and reloads the files when they change, so that
+// certificates can be rotated without restarting.
+package tlsconfig
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/BhaveetKumar/gRPC-server-go/internal/clock"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/logger"
+)
+
+// DefaultReloadInterval is how often Run checks the files for changes.
+const DefaultReloadInterval = 10 * time.Second
+
+// Files names the PEM files of one side of a connection. CertFile and
+// KeyFile hold its own certificate chain and key, CAFile the certificates
+// the other side's certificate must chain to.
+type Files struct {
+	CertFile string
+	KeyFile  string
+	CAFile   string
+}
+
+// fileStamp identifies a version of a file well enough to notice it was
+// replaced.
+type fileStamp struct {
+	modTime time.Time
+	size    int64
+}
+
+// Reloader holds the certificate and CA pool loaded from Files and replaces
+// them when the files change.
+type Reloader struct {
+	files Files
+	clock clock.Clock
+
+	mu     sync.RWMutex
+	cert   *tls.Certificate
+	pool   *x509.CertPool
+	stamps map[string]fileStamp
+}
+
+// NewReloader loads files. The certificate may be left out on the client
+// side and the CA bundle wherever the system roots or no peer verification
+// will do, but a certificate needs its key.
+func NewReloader(files Files, c clock.Clock) (*Reloader, error) {
+	if (files.CertFile == "") != (files.KeyFile == "") {
+		return nil, errors.New("tls certificate and key files must be set together")
+	}
+	r := &Reloader{files: files, clock: c}
+	if err := r.Reload(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Reload reads the files again. On error the previously loaded certificate
+// and pool stay in use.
+func (r *Reloader) Reload() error {
+	stamps, err := r.stat()
+	if err != nil {
+		return err
+	}
+
+	var cert *tls.Certificate
+	if r.files.CertFile != "" {
+		loaded, err := tls.LoadX509KeyPair(r.files.CertFile, r.files.KeyFile)
+		if err != nil {
+			return fmt.Errorf("load tls certificate: %w", err)
+		}
+		cert = &loaded
+	}
+
+	var pool *x509.CertPool
+	if r.files.CAFile != "" {
+		pem, err := os.ReadFile(r.files.CAFile)
+		if err != nil {
+			return fmt.Errorf("read tls ca bundle: %w", err)
+		}
+		pool = x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return fmt.Errorf("tls ca bundle %s holds no certificates", r.files.CAFile)
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cert, r.pool, r.stamps = cert, pool, stamps
+	return nil
+}
+
+// Run reloads the files whenever one of them changes, checking every
+// interval until ctx is done. A failed reload, say while only the
+// certificate of a new pair has been written, is logged and retried on the
+// next check.
+func (r *Reloader) Run(ctx context.Context, interval time.Duration, log *logger.Logger) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-r.clock.After(interval):
+		}
+
+		if !r.changed() {
+			continue
+		}
+		if err := r.Reload(); err != nil {
+			log.Error(fmt.Sprintf("tls reload failed: %v", err))
+			continue
+		}
+		log.Info("reloaded tls certificates")
+	}
+}
+
+func (r *Reloader) changed() bool {
+	stamps, err := r.stat()
+	if err != nil {
+		// Probably mid-rotation; check again next time.
+		return false
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for name, stamp := range stamps {
+		if r.stamps[name] != stamp {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Reloader) stat() (map[string]fileStamp, error) {
+	stamps := make(map[string]fileStamp)
+	for _, name := range []string{r.files.CertFile, r.files.KeyFile, r.files.CAFile} {
+		if name == "" {
+			continue
+		}
+		info, err := os.Stat(name)
+		if err != nil {
+			return nil, err
+		}
+		stamps[name] = fileStamp{modTime: info.ModTime(), size: info.Size()}
+	}
+	return stamps, nil
+}
+
+func (r *Reloader) current() (*tls.Certificate, *x509.CertPool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.cert, r.pool
+}
+
+// ServerConfig returns a server configuration that presents the current
+// certificate to every new connection. With a CA bundle, clients presenting
+// a certificate must chain to it, and requireClientCert rejects clients that
+// present none.
+func (r *Reloader) ServerConfig(requireClientCert bool) (*tls.Config, error) {
+	if r.files.CertFile == "" {
+		return nil, errors.New("tls server needs a certificate and key")
+	}
+	if requireClientCert && r.files.CAFile == "" {
+		return nil, errors.New("requiring client certificates needs a ca bundle")
+	}
+
+	clientAuth := tls.NoClientCert
+	switch {
+	case requireClientCert:
+		clientAuth = tls.RequireAndVerifyClientCert
+	case r.files.CAFile != "":
+		clientAuth = tls.VerifyClientCertIfGiven
+	}
+
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			cert, pool := r.current()
+			return &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				Certificates: []tls.Certificate{*cert},
+				ClientCAs:    pool,
+				ClientAuth:   clientAuth,
+			}, nil
+		},
+	}, nil
+}
+
+// ClientConfig returns a client configuration that verifies the server
+// against the current CA bundle, or the system roots without one, and
+// presents the current certificate when the server asks for one.
+// serverName overrides the name the server certificate must be valid for.
+func (r *Reloader) ClientConfig(serverName string) *tls.Config {
+	cfg := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: serverName,
+		GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			cert, _ := r.current()
+			if cert == nil {
+				return &tls.Certificate{}, nil
+			}
+			return cert, nil
+		},
+	}
+	if r.files.CAFile == "" {
+		return cfg
+	}
+
+	// RootCAs is fixed once a connection starts, so verify against the
+	// pool loaded at handshake time instead. InsecureSkipVerify only turns
+	// off the built-in check that VerifyConnection replaces.
+	cfg.InsecureSkipVerify = true
+	cfg.VerifyConnection = func(state tls.ConnectionState) error {
+		_, pool := r.current()
+		if len(state.PeerCertificates) == 0 {
+			return errors.New("tls server presented no certificate")
+		}
+		opts := x509.VerifyOptions{
+			DNSName:       state.ServerName,
+			Roots:         pool,
+			Intermediates: x509.NewCertPool(),
+		}
+		for _, cert := range state.PeerCertificates[1:] {
+			opts.Intermediates.AddCert(cert)
+		}
+		_, err := state.PeerCertificates[0].Verify(opts)
+		return err
+	}
+	return cfg
+}
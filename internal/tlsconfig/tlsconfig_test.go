@@ -0,0 +1,126 @@
+package tlsconfig
+
+import (
+	"context"
+	"crypto/x509"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/BhaveetKumar/gRPC-server-go/internal/clock"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/logger"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/tlsconfig/tlstest"
+)
+
+func waitFor(t *testing.T, what string, cond func() bool) {
+	t.Helper()
+
+	deadline := time.Now().Add(2 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %s", what)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func commonName(t *testing.T, r *Reloader) string {
+	t.Helper()
+
+	cert, _ := r.current()
+	leaf, err := x509.ParseCertificate(cert.Certificate[0])
+	if err != nil {
+		t.Fatalf("parse certificate: %v", err)
+	}
+	return leaf.Subject.CommonName
+}
+
+// rewrite replaces the contents of path and moves its modification time
+// forward so the change is seen even on coarse-grained file systems.
+func rewrite(t *testing.T, path string, data []byte, at time.Time) {
+	t.Helper()
+
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatalf("rewrite %s: %v", path, err)
+	}
+	if err := os.Chtimes(path, at, at); err != nil {
+		t.Fatalf("touch %s: %v", path, err)
+	}
+}
+
+func TestNewReloader_Validates(t *testing.T) {
+	dir := t.TempDir()
+	ca := tlstest.NewCA(t, "ca")
+	certPEM, keyPEM := ca.Issue(t, "server")
+	certFile := tlstest.WriteFile(t, dir, "cert.pem", certPEM)
+	keyFile := tlstest.WriteFile(t, dir, "key.pem", keyPEM)
+	caFile := tlstest.WriteFile(t, dir, "ca.pem", ca.PEM)
+
+	for name, files := range map[string]Files{
+		"cert without key": {CertFile: certFile},
+		"key not matching": {CertFile: certFile, KeyFile: caFile},
+		"missing file":     {CertFile: certFile, KeyFile: dir + "/missing.pem"},
+		"empty ca bundle":  {CertFile: certFile, KeyFile: keyFile, CAFile: keyFile},
+	} {
+		if _, err := NewReloader(files, clock.Real()); err == nil {
+			t.Fatalf("%s: expected an error", name)
+		}
+	}
+
+	reloader, err := NewReloader(Files{CAFile: caFile}, clock.Real())
+	if err != nil {
+		t.Fatalf("new client-only reloader failed: %v", err)
+	}
+	if _, err := reloader.ServerConfig(false); err == nil {
+		t.Fatal("expected a server config without a certificate to be rejected")
+	}
+
+	reloader, err = NewReloader(Files{CertFile: certFile, KeyFile: keyFile}, clock.Real())
+	if err != nil {
+		t.Fatalf("new reloader failed: %v", err)
+	}
+	if _, err := reloader.ServerConfig(true); err == nil {
+		t.Fatal("expected requiring client certificates without a ca bundle to be rejected")
+	}
+}
+
+func TestReloader_RunReloadsChangedFiles(t *testing.T) {
+	dir := t.TempDir()
+	ca := tlstest.NewCA(t, "ca")
+	certPEM, keyPEM := ca.Issue(t, "first")
+	certFile := tlstest.WriteFile(t, dir, "cert.pem", certPEM)
+	keyFile := tlstest.WriteFile(t, dir, "key.pem", keyPEM)
+
+	clk := clock.NewFake(time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC))
+	reloader, err := NewReloader(Files{CertFile: certFile, KeyFile: keyFile}, clk)
+	if err != nil {
+		t.Fatalf("new reloader failed: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		reloader.Run(ctx, time.Minute, logger.New())
+	}()
+	defer func() {
+		cancel()
+		<-done
+	}()
+
+	// Only the certificate of the new pair has been written: the old pair
+	// stays in use.
+	certPEM, keyPEM = ca.Issue(t, "second")
+	touched := time.Now().Add(time.Minute)
+	rewrite(t, certFile, certPEM, touched)
+	waitFor(t, "reloader to wait on the clock", func() bool { return clk.Waiters() > 0 })
+	clk.Advance(time.Minute)
+	waitFor(t, "failed reload to finish", func() bool { return clk.Waiters() > 0 })
+	if name := commonName(t, reloader); name != "first" {
+		t.Fatalf("expected the old certificate to stay in use, got %q", name)
+	}
+
+	rewrite(t, keyFile, keyPEM, touched)
+	clk.Advance(time.Minute)
+	waitFor(t, "new certificate to be loaded", func() bool { return commonName(t, reloader) == "second" })
+}
@@ -9,6 +9,9 @@ package blogv1
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -21,6 +24,386 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type PostStatus int32
+
+const (
+	PostStatus_POST_STATUS_UNSPECIFIED PostStatus = 0
+	PostStatus_POST_STATUS_DRAFT       PostStatus = 1
+	PostStatus_POST_STATUS_IN_REVIEW   PostStatus = 2
+	PostStatus_POST_STATUS_SCHEDULED   PostStatus = 3
+	PostStatus_POST_STATUS_PUBLISHED   PostStatus = 4
+	PostStatus_POST_STATUS_ARCHIVED    PostStatus = 5
+)
+
+// Enum value maps for PostStatus.
+var (
+	PostStatus_name = map[int32]string{
+		0: "POST_STATUS_UNSPECIFIED",
+		1: "POST_STATUS_DRAFT",
+		2: "POST_STATUS_IN_REVIEW",
+		3: "POST_STATUS_SCHEDULED",
+		4: "POST_STATUS_PUBLISHED",
+		5: "POST_STATUS_ARCHIVED",
+	}
+	PostStatus_value = map[string]int32{
+		"POST_STATUS_UNSPECIFIED": 0,
+		"POST_STATUS_DRAFT":       1,
+		"POST_STATUS_IN_REVIEW":   2,
+		"POST_STATUS_SCHEDULED":   3,
+		"POST_STATUS_PUBLISHED":   4,
+		"POST_STATUS_ARCHIVED":    5,
+	}
+)
+
+func (x PostStatus) Enum() *PostStatus {
+	p := new(PostStatus)
+	*p = x
+	return p
+}
+
+func (x PostStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PostStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_blog_v1_blog_proto_enumTypes[0].Descriptor()
+}
+
+func (PostStatus) Type() protoreflect.EnumType {
+	return &file_proto_blog_v1_blog_proto_enumTypes[0]
+}
+
+func (x PostStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PostStatus.Descriptor instead.
+func (PostStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_blog_v1_blog_proto_rawDescGZIP(), []int{0}
+}
+
+type ModerationState int32
+
+const (
+	ModerationState_MODERATION_STATE_UNSPECIFIED ModerationState = 0
+	ModerationState_MODERATION_STATE_APPROVED    ModerationState = 1
+	// Waiting for a moderator; hidden from everyone but the author.
+	ModerationState_MODERATION_STATE_PENDING ModerationState = 2
+	// Hidden from everyone but the author until it is edited and approved.
+	ModerationState_MODERATION_STATE_REJECTED ModerationState = 3
+)
+
+// Enum value maps for ModerationState.
+var (
+	ModerationState_name = map[int32]string{
+		0: "MODERATION_STATE_UNSPECIFIED",
+		1: "MODERATION_STATE_APPROVED",
+		2: "MODERATION_STATE_PENDING",
+		3: "MODERATION_STATE_REJECTED",
+	}
+	ModerationState_value = map[string]int32{
+		"MODERATION_STATE_UNSPECIFIED": 0,
+		"MODERATION_STATE_APPROVED":    1,
+		"MODERATION_STATE_PENDING":     2,
+		"MODERATION_STATE_REJECTED":    3,
+	}
+)
+
+func (x ModerationState) Enum() *ModerationState {
+	p := new(ModerationState)
+	*p = x
+	return p
+}
+
+func (x ModerationState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ModerationState) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_blog_v1_blog_proto_enumTypes[1].Descriptor()
+}
+
+func (ModerationState) Type() protoreflect.EnumType {
+	return &file_proto_blog_v1_blog_proto_enumTypes[1]
+}
+
+func (x ModerationState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ModerationState.Descriptor instead.
+func (ModerationState) EnumDescriptor() ([]byte, []int) {
+	return file_proto_blog_v1_blog_proto_rawDescGZIP(), []int{1}
+}
+
+type PostOrder int32
+
+const (
+	PostOrder_POST_ORDER_UNSPECIFIED           PostOrder = 0
+	PostOrder_POST_ORDER_PUBLICATION_DATE_DESC PostOrder = 1
+	PostOrder_POST_ORDER_PUBLICATION_DATE_ASC  PostOrder = 2
+	PostOrder_POST_ORDER_TITLE_ASC             PostOrder = 3
+	PostOrder_POST_ORDER_TITLE_DESC            PostOrder = 4
+	// Creation order; only available when the server uses a time-ordered ID
+	// generator.
+	PostOrder_POST_ORDER_ID_ASC  PostOrder = 5
+	PostOrder_POST_ORDER_ID_DESC PostOrder = 6
+)
+
+// Enum value maps for PostOrder.
+var (
+	PostOrder_name = map[int32]string{
+		0: "POST_ORDER_UNSPECIFIED",
+		1: "POST_ORDER_PUBLICATION_DATE_DESC",
+		2: "POST_ORDER_PUBLICATION_DATE_ASC",
+		3: "POST_ORDER_TITLE_ASC",
+		4: "POST_ORDER_TITLE_DESC",
+		5: "POST_ORDER_ID_ASC",
+		6: "POST_ORDER_ID_DESC",
+	}
+	PostOrder_value = map[string]int32{
+		"POST_ORDER_UNSPECIFIED":           0,
+		"POST_ORDER_PUBLICATION_DATE_DESC": 1,
+		"POST_ORDER_PUBLICATION_DATE_ASC":  2,
+		"POST_ORDER_TITLE_ASC":             3,
+		"POST_ORDER_TITLE_DESC":            4,
+		"POST_ORDER_ID_ASC":                5,
+		"POST_ORDER_ID_DESC":               6,
+	}
+)
+
+func (x PostOrder) Enum() *PostOrder {
+	p := new(PostOrder)
+	*p = x
+	return p
+}
+
+func (x PostOrder) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PostOrder) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_blog_v1_blog_proto_enumTypes[2].Descriptor()
+}
+
+func (PostOrder) Type() protoreflect.EnumType {
+	return &file_proto_blog_v1_blog_proto_enumTypes[2]
+}
+
+func (x PostOrder) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PostOrder.Descriptor instead.
+func (PostOrder) EnumDescriptor() ([]byte, []int) {
+	return file_proto_blog_v1_blog_proto_rawDescGZIP(), []int{2}
+}
+
+type DiffFormat int32
+
+const (
+	// Same as DIFF_FORMAT_HUNKS.
+	DiffFormat_DIFF_FORMAT_UNSPECIFIED DiffFormat = 0
+	DiffFormat_DIFF_FORMAT_HUNKS       DiffFormat = 1
+	DiffFormat_DIFF_FORMAT_UNIFIED     DiffFormat = 2
+)
+
+// Enum value maps for DiffFormat.
+var (
+	DiffFormat_name = map[int32]string{
+		0: "DIFF_FORMAT_UNSPECIFIED",
+		1: "DIFF_FORMAT_HUNKS",
+		2: "DIFF_FORMAT_UNIFIED",
+	}
+	DiffFormat_value = map[string]int32{
+		"DIFF_FORMAT_UNSPECIFIED": 0,
+		"DIFF_FORMAT_HUNKS":       1,
+		"DIFF_FORMAT_UNIFIED":     2,
+	}
+)
+
+func (x DiffFormat) Enum() *DiffFormat {
+	p := new(DiffFormat)
+	*p = x
+	return p
+}
+
+func (x DiffFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DiffFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_blog_v1_blog_proto_enumTypes[3].Descriptor()
+}
+
+func (DiffFormat) Type() protoreflect.EnumType {
+	return &file_proto_blog_v1_blog_proto_enumTypes[3]
+}
+
+func (x DiffFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DiffFormat.Descriptor instead.
+func (DiffFormat) EnumDescriptor() ([]byte, []int) {
+	return file_proto_blog_v1_blog_proto_rawDescGZIP(), []int{3}
+}
+
+type DiffOp int32
+
+const (
+	DiffOp_DIFF_OP_UNSPECIFIED DiffOp = 0
+	DiffOp_DIFF_OP_EQUAL       DiffOp = 1
+	DiffOp_DIFF_OP_INSERT      DiffOp = 2
+	DiffOp_DIFF_OP_DELETE      DiffOp = 3
+)
+
+// Enum value maps for DiffOp.
+var (
+	DiffOp_name = map[int32]string{
+		0: "DIFF_OP_UNSPECIFIED",
+		1: "DIFF_OP_EQUAL",
+		2: "DIFF_OP_INSERT",
+		3: "DIFF_OP_DELETE",
+	}
+	DiffOp_value = map[string]int32{
+		"DIFF_OP_UNSPECIFIED": 0,
+		"DIFF_OP_EQUAL":       1,
+		"DIFF_OP_INSERT":      2,
+		"DIFF_OP_DELETE":      3,
+	}
+)
+
+func (x DiffOp) Enum() *DiffOp {
+	p := new(DiffOp)
+	*p = x
+	return p
+}
+
+func (x DiffOp) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DiffOp) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_blog_v1_blog_proto_enumTypes[4].Descriptor()
+}
+
+func (DiffOp) Type() protoreflect.EnumType {
+	return &file_proto_blog_v1_blog_proto_enumTypes[4]
+}
+
+func (x DiffOp) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DiffOp.Descriptor instead.
+func (DiffOp) EnumDescriptor() ([]byte, []int) {
+	return file_proto_blog_v1_blog_proto_rawDescGZIP(), []int{4}
+}
+
+type TagOrder int32
+
+const (
+	// Same as TAG_ORDER_COUNT.
+	TagOrder_TAG_ORDER_UNSPECIFIED TagOrder = 0
+	// Most used tags first.
+	TagOrder_TAG_ORDER_COUNT TagOrder = 1
+	TagOrder_TAG_ORDER_NAME  TagOrder = 2
+)
+
+// Enum value maps for TagOrder.
+var (
+	TagOrder_name = map[int32]string{
+		0: "TAG_ORDER_UNSPECIFIED",
+		1: "TAG_ORDER_COUNT",
+		2: "TAG_ORDER_NAME",
+	}
+	TagOrder_value = map[string]int32{
+		"TAG_ORDER_UNSPECIFIED": 0,
+		"TAG_ORDER_COUNT":       1,
+		"TAG_ORDER_NAME":        2,
+	}
+)
+
+func (x TagOrder) Enum() *TagOrder {
+	p := new(TagOrder)
+	*p = x
+	return p
+}
+
+func (x TagOrder) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TagOrder) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_blog_v1_blog_proto_enumTypes[5].Descriptor()
+}
+
+func (TagOrder) Type() protoreflect.EnumType {
+	return &file_proto_blog_v1_blog_proto_enumTypes[5]
+}
+
+func (x TagOrder) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TagOrder.Descriptor instead.
+func (TagOrder) EnumDescriptor() ([]byte, []int) {
+	return file_proto_blog_v1_blog_proto_rawDescGZIP(), []int{5}
+}
+
+type PostEventType int32
+
+const (
+	PostEventType_POST_EVENT_TYPE_UNSPECIFIED PostEventType = 0
+	PostEventType_POST_EVENT_TYPE_CREATED     PostEventType = 1
+	PostEventType_POST_EVENT_TYPE_UPDATED     PostEventType = 2
+	PostEventType_POST_EVENT_TYPE_DELETED     PostEventType = 3
+)
+
+// Enum value maps for PostEventType.
+var (
+	PostEventType_name = map[int32]string{
+		0: "POST_EVENT_TYPE_UNSPECIFIED",
+		1: "POST_EVENT_TYPE_CREATED",
+		2: "POST_EVENT_TYPE_UPDATED",
+		3: "POST_EVENT_TYPE_DELETED",
+	}
+	PostEventType_value = map[string]int32{
+		"POST_EVENT_TYPE_UNSPECIFIED": 0,
+		"POST_EVENT_TYPE_CREATED":     1,
+		"POST_EVENT_TYPE_UPDATED":     2,
+		"POST_EVENT_TYPE_DELETED":     3,
+	}
+)
+
+func (x PostEventType) Enum() *PostEventType {
+	p := new(PostEventType)
+	*p = x
+	return p
+}
+
+func (x PostEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PostEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_blog_v1_blog_proto_enumTypes[6].Descriptor()
+}
+
+func (PostEventType) Type() protoreflect.EnumType {
+	return &file_proto_blog_v1_blog_proto_enumTypes[6]
+}
+
+func (x PostEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PostEventType.Descriptor instead.
+func (PostEventType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_blog_v1_blog_proto_rawDescGZIP(), []int{6}
+}
+
 type Post struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	PostId          string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
@@ -29,8 +412,23 @@ type Post struct {
 	Author          string                 `protobuf:"bytes,4,opt,name=author,proto3" json:"author,omitempty"`
 	PublicationDate string                 `protobuf:"bytes,5,opt,name=publication_date,json=publicationDate,proto3" json:"publication_date,omitempty"`
 	Tags            []string               `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	Version         int64                  `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`
+	Etag            string                 `protobuf:"bytes,8,opt,name=etag,proto3" json:"etag,omitempty"`
+	Status          PostStatus             `protobuf:"varint,9,opt,name=status,proto3,enum=blog.v1.PostStatus" json:"status,omitempty"`
+	// publication_date parsed into a timestamp; unset when there is no date.
+	PublishTime *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=publish_time,json=publishTime,proto3" json:"publish_time,omitempty"`
+	// Set while the post is in the trash.
+	DeleteTime      *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=delete_time,json=deleteTime,proto3" json:"delete_time,omitempty"`
+	ModerationState ModerationState        `protobuf:"varint,12,opt,name=moderation_state,json=moderationState,proto3,enum=blog.v1.ModerationState" json:"moderation_state,omitempty"`
+	// Why the post is pending or rejected.
+	ModerationReason string `protobuf:"bytes,13,opt,name=moderation_reason,json=moderationReason,proto3" json:"moderation_reason,omitempty"`
+	// The Author the byline belongs to; author holds that author's name.
+	AuthorId string `protobuf:"bytes,14,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	// Generated from the title and unique; slugs the post had before keep
+	// leading to it through GetPostBySlug.
+	Slug          string `protobuf:"bytes,15,opt,name=slug,proto3" json:"slug,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Post) Reset() {
@@ -105,6 +503,69 @@ func (x *Post) GetTags() []string {
 	return nil
 }
 
+func (x *Post) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Post) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+func (x *Post) GetStatus() PostStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PostStatus_POST_STATUS_UNSPECIFIED
+}
+
+func (x *Post) GetPublishTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishTime
+	}
+	return nil
+}
+
+func (x *Post) GetDeleteTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeleteTime
+	}
+	return nil
+}
+
+func (x *Post) GetModerationState() ModerationState {
+	if x != nil {
+		return x.ModerationState
+	}
+	return ModerationState_MODERATION_STATE_UNSPECIFIED
+}
+
+func (x *Post) GetModerationReason() string {
+	if x != nil {
+		return x.ModerationReason
+	}
+	return ""
+}
+
+func (x *Post) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *Post) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
 type CreatePostRequest struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	Title           string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
@@ -112,8 +573,11 @@ type CreatePostRequest struct {
 	Author          string                 `protobuf:"bytes,3,opt,name=author,proto3" json:"author,omitempty"`
 	PublicationDate string                 `protobuf:"bytes,4,opt,name=publication_date,json=publicationDate,proto3" json:"publication_date,omitempty"`
 	Tags            []string               `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// Names the post's author by ID instead of by the author field. When
+	// author is used, an author that does not exist yet is created.
+	AuthorId      string `protobuf:"bytes,6,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreatePostRequest) Reset() {
@@ -181,6 +645,13 @@ func (x *CreatePostRequest) GetTags() []string {
 	return nil
 }
 
+func (x *CreatePostRequest) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
 type CreatePostResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Post          *Post                  `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
@@ -313,31 +784,27 @@ func (x *GetPostResponse) GetPost() *Post {
 	return nil
 }
 
-type UpdatePostRequest struct {
+type GetPostBySlugRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PostId        string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
-	Author        string                 `protobuf:"bytes,4,opt,name=author,proto3" json:"author,omitempty"`
-	Tags          []string               `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`
+	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdatePostRequest) Reset() {
-	*x = UpdatePostRequest{}
+func (x *GetPostBySlugRequest) Reset() {
+	*x = GetPostBySlugRequest{}
 	mi := &file_proto_blog_v1_blog_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdatePostRequest) String() string {
+func (x *GetPostBySlugRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdatePostRequest) ProtoMessage() {}
+func (*GetPostBySlugRequest) ProtoMessage() {}
 
-func (x *UpdatePostRequest) ProtoReflect() protoreflect.Message {
+func (x *GetPostBySlugRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_blog_v1_blog_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -349,67 +816,42 @@ func (x *UpdatePostRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdatePostRequest.ProtoReflect.Descriptor instead.
-func (*UpdatePostRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetPostBySlugRequest.ProtoReflect.Descriptor instead.
+func (*GetPostBySlugRequest) Descriptor() ([]byte, []int) {
 	return file_proto_blog_v1_blog_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *UpdatePostRequest) GetPostId() string {
-	if x != nil {
-		return x.PostId
-	}
-	return ""
-}
-
-func (x *UpdatePostRequest) GetTitle() string {
-	if x != nil {
-		return x.Title
-	}
-	return ""
-}
-
-func (x *UpdatePostRequest) GetContent() string {
-	if x != nil {
-		return x.Content
-	}
-	return ""
-}
-
-func (x *UpdatePostRequest) GetAuthor() string {
+func (x *GetPostBySlugRequest) GetSlug() string {
 	if x != nil {
-		return x.Author
+		return x.Slug
 	}
 	return ""
 }
 
-func (x *UpdatePostRequest) GetTags() []string {
-	if x != nil {
-		return x.Tags
-	}
-	return nil
-}
-
-type UpdatePostResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Post          *Post                  `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
+type GetPostBySlugResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Post  *Post                  `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
+	// Set when the slug is one the post held before; post.slug is the
+	// current one to redirect to.
+	Redirect      bool `protobuf:"varint,2,opt,name=redirect,proto3" json:"redirect,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdatePostResponse) Reset() {
-	*x = UpdatePostResponse{}
+func (x *GetPostBySlugResponse) Reset() {
+	*x = GetPostBySlugResponse{}
 	mi := &file_proto_blog_v1_blog_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdatePostResponse) String() string {
+func (x *GetPostBySlugResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdatePostResponse) ProtoMessage() {}
+func (*GetPostBySlugResponse) ProtoMessage() {}
 
-func (x *UpdatePostResponse) ProtoReflect() protoreflect.Message {
+func (x *GetPostBySlugResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_blog_v1_blog_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -421,40 +863,4284 @@ func (x *UpdatePostResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdatePostResponse.ProtoReflect.Descriptor instead.
-func (*UpdatePostResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetPostBySlugResponse.ProtoReflect.Descriptor instead.
+func (*GetPostBySlugResponse) Descriptor() ([]byte, []int) {
 	return file_proto_blog_v1_blog_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *UpdatePostResponse) GetPost() *Post {
+func (x *GetPostBySlugResponse) GetPost() *Post {
 	if x != nil {
 		return x.Post
 	}
 	return nil
 }
 
-type DeletePostRequest struct {
+func (x *GetPostBySlugResponse) GetRedirect() bool {
+	if x != nil {
+		return x.Redirect
+	}
+	return false
+}
+
+type UpdatePostRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PostId          string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Title           string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Content         string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	Author          string                 `protobuf:"bytes,4,opt,name=author,proto3" json:"author,omitempty"`
+	Tags            []string               `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`
+	PublicationDate string                 `protobuf:"bytes,6,opt,name=publication_date,json=publicationDate,proto3" json:"publication_date,omitempty"`
+	// Paths of the Post fields to update. When empty, title, content, author
+	// and tags are replaced.
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,7,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	// When set, the update is rejected with ABORTED unless it matches the
+	// post's current etag.
+	Etag string `protobuf:"bytes,8,opt,name=etag,proto3" json:"etag,omitempty"`
+	// Only applied when "status" is in update_mask, and only along allowed
+	// transitions; otherwise FAILED_PRECONDITION is returned.
+	Status PostStatus `protobuf:"varint,9,opt,name=status,proto3,enum=blog.v1.PostStatus" json:"status,omitempty"`
+	// When "author" is in update_mask, names the new author by ID instead of
+	// by the author field.
+	AuthorId      string `protobuf:"bytes,10,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePostRequest) Reset() {
+	*x = UpdatePostRequest{}
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePostRequest) ProtoMessage() {}
+
+func (x *UpdatePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePostRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePostRequest) Descriptor() ([]byte, []int) {
+	return file_proto_blog_v1_blog_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdatePostRequest) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *UpdatePostRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *UpdatePostRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *UpdatePostRequest) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *UpdatePostRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *UpdatePostRequest) GetPublicationDate() string {
+	if x != nil {
+		return x.PublicationDate
+	}
+	return ""
+}
+
+func (x *UpdatePostRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdatePostRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+func (x *UpdatePostRequest) GetStatus() PostStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PostStatus_POST_STATUS_UNSPECIFIED
+}
+
+func (x *UpdatePostRequest) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+type UpdatePostResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Post          *Post                  `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePostResponse) Reset() {
+	*x = UpdatePostResponse{}
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePostResponse) ProtoMessage() {}
+
+func (x *UpdatePostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePostResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePostResponse) Descriptor() ([]byte, []int) {
+	return file_proto_blog_v1_blog_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdatePostResponse) GetPost() *Post {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+// DeletePostRequest moves a post to the trash. Trashed posts are hidden from
+// reads and permanently removed once the server's trash retention passes.
+type DeletePostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Etag          string                 `protobuf:"bytes,2,opt,name=etag,proto3" json:"etag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePostRequest) Reset() {
+	*x = DeletePostRequest{}
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePostRequest) ProtoMessage() {}
+
+func (x *DeletePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePostRequest.ProtoReflect.Descriptor instead.
+func (*DeletePostRequest) Descriptor() ([]byte, []int) {
+	return file_proto_blog_v1_blog_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeletePostRequest) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *DeletePostRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+type DeletePostResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePostResponse) Reset() {
+	*x = DeletePostResponse{}
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePostResponse) ProtoMessage() {}
+
+func (x *DeletePostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePostResponse.ProtoReflect.Descriptor instead.
+func (*DeletePostResponse) Descriptor() ([]byte, []int) {
+	return file_proto_blog_v1_blog_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DeletePostResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type RestorePostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Etag          string                 `protobuf:"bytes,2,opt,name=etag,proto3" json:"etag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestorePostRequest) Reset() {
+	*x = RestorePostRequest{}
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestorePostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestorePostRequest) ProtoMessage() {}
+
+func (x *RestorePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestorePostRequest.ProtoReflect.Descriptor instead.
+func (*RestorePostRequest) Descriptor() ([]byte, []int) {
+	return file_proto_blog_v1_blog_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RestorePostRequest) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *RestorePostRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+type RestorePostResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Post          *Post                  `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestorePostResponse) Reset() {
+	*x = RestorePostResponse{}
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestorePostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestorePostResponse) ProtoMessage() {}
+
+func (x *RestorePostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestorePostResponse.ProtoReflect.Descriptor instead.
+func (*RestorePostResponse) Descriptor() ([]byte, []int) {
+	return file_proto_blog_v1_blog_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RestorePostResponse) GetPost() *Post {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+// PurgePostRequest permanently removes a post that is in the trash.
+type PurgePostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Etag          string                 `protobuf:"bytes,2,opt,name=etag,proto3" json:"etag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgePostRequest) Reset() {
+	*x = PurgePostRequest{}
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgePostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgePostRequest) ProtoMessage() {}
+
+func (x *PurgePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgePostRequest.ProtoReflect.Descriptor instead.
+func (*PurgePostRequest) Descriptor() ([]byte, []int) {
+	return file_proto_blog_v1_blog_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *PurgePostRequest) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *PurgePostRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+type PurgePostResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgePostResponse) Reset() {
+	*x = PurgePostResponse{}
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgePostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgePostResponse) ProtoMessage() {}
+
+func (x *PurgePostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgePostResponse.ProtoReflect.Descriptor instead.
+func (*PurgePostResponse) Descriptor() ([]byte, []int) {
+	return file_proto_blog_v1_blog_proto_rawDescGZIP(), []int{14}
+}
+
+type ListPostsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PageSize        int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken       string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Author          string                 `protobuf:"bytes,3,opt,name=author,proto3" json:"author,omitempty"`
+	Tag             string                 `protobuf:"bytes,4,opt,name=tag,proto3" json:"tag,omitempty"`
+	PublishedAfter  string                 `protobuf:"bytes,5,opt,name=published_after,json=publishedAfter,proto3" json:"published_after,omitempty"`
+	PublishedBefore string                 `protobuf:"bytes,6,opt,name=published_before,json=publishedBefore,proto3" json:"published_before,omitempty"`
+	OrderBy         PostOrder              `protobuf:"varint,7,opt,name=order_by,json=orderBy,proto3,enum=blog.v1.PostOrder" json:"order_by,omitempty"`
+	Status          PostStatus             `protobuf:"varint,8,opt,name=status,proto3,enum=blog.v1.PostStatus" json:"status,omitempty"`
+	// Also return the caller's own posts that are in the trash.
+	ShowDeleted   bool   `protobuf:"varint,9,opt,name=show_deleted,json=showDeleted,proto3" json:"show_deleted,omitempty"`
+	AuthorId      string `protobuf:"bytes,10,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPostsRequest) Reset() {
+	*x = ListPostsRequest{}
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPostsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPostsRequest) ProtoMessage() {}
+
+func (x *ListPostsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPostsRequest.ProtoReflect.Descriptor instead.
+func (*ListPostsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_blog_v1_blog_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListPostsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListPostsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListPostsRequest) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *ListPostsRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *ListPostsRequest) GetPublishedAfter() string {
+	if x != nil {
+		return x.PublishedAfter
+	}
+	return ""
+}
+
+func (x *ListPostsRequest) GetPublishedBefore() string {
+	if x != nil {
+		return x.PublishedBefore
+	}
+	return ""
+}
+
+func (x *ListPostsRequest) GetOrderBy() PostOrder {
+	if x != nil {
+		return x.OrderBy
+	}
+	return PostOrder_POST_ORDER_UNSPECIFIED
+}
+
+func (x *ListPostsRequest) GetStatus() PostStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PostStatus_POST_STATUS_UNSPECIFIED
+}
+
+func (x *ListPostsRequest) GetShowDeleted() bool {
+	if x != nil {
+		return x.ShowDeleted
+	}
+	return false
+}
+
+func (x *ListPostsRequest) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+type ListPostsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Posts         []*Post                `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPostsResponse) Reset() {
+	*x = ListPostsResponse{}
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPostsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPostsResponse) ProtoMessage() {}
+
+func (x *ListPostsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPostsResponse.ProtoReflect.Descriptor instead.
+func (*ListPostsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_blog_v1_blog_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListPostsResponse) GetPosts() []*Post {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
+func (x *ListPostsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type PublishPostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Etag          string                 `protobuf:"bytes,2,opt,name=etag,proto3" json:"etag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublishPostRequest) Reset() {
+	*x = PublishPostRequest{}
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishPostRequest) ProtoMessage() {}
+
+func (x *PublishPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishPostRequest.ProtoReflect.Descriptor instead.
+func (*PublishPostRequest) Descriptor() ([]byte, []int) {
+	return file_proto_blog_v1_blog_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *PublishPostRequest) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *PublishPostRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+type PublishPostResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Post          *Post                  `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublishPostResponse) Reset() {
+	*x = PublishPostResponse{}
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishPostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishPostResponse) ProtoMessage() {}
+
+func (x *PublishPostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishPostResponse.ProtoReflect.Descriptor instead.
+func (*PublishPostResponse) Descriptor() ([]byte, []int) {
+	return file_proto_blog_v1_blog_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *PublishPostResponse) GetPost() *Post {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+type UnpublishPostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Etag          string                 `protobuf:"bytes,2,opt,name=etag,proto3" json:"etag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnpublishPostRequest) Reset() {
+	*x = UnpublishPostRequest{}
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnpublishPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnpublishPostRequest) ProtoMessage() {}
+
+func (x *UnpublishPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnpublishPostRequest.ProtoReflect.Descriptor instead.
+func (*UnpublishPostRequest) Descriptor() ([]byte, []int) {
+	return file_proto_blog_v1_blog_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UnpublishPostRequest) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *UnpublishPostRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+type UnpublishPostResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Post          *Post                  `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnpublishPostResponse) Reset() {
+	*x = UnpublishPostResponse{}
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnpublishPostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnpublishPostResponse) ProtoMessage() {}
+
+func (x *UnpublishPostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnpublishPostResponse.ProtoReflect.Descriptor instead.
+func (*UnpublishPostResponse) Descriptor() ([]byte, []int) {
+	return file_proto_blog_v1_blog_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *UnpublishPostResponse) GetPost() *Post {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+type ArchivePostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Etag          string                 `protobuf:"bytes,2,opt,name=etag,proto3" json:"etag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchivePostRequest) Reset() {
+	*x = ArchivePostRequest{}
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchivePostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchivePostRequest) ProtoMessage() {}
+
+func (x *ArchivePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchivePostRequest.ProtoReflect.Descriptor instead.
+func (*ArchivePostRequest) Descriptor() ([]byte, []int) {
+	return file_proto_blog_v1_blog_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ArchivePostRequest) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *ArchivePostRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+type ArchivePostResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Post          *Post                  `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchivePostResponse) Reset() {
+	*x = ArchivePostResponse{}
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchivePostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchivePostResponse) ProtoMessage() {}
+
+func (x *ArchivePostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchivePostResponse.ProtoReflect.Descriptor instead.
+func (*ArchivePostResponse) Descriptor() ([]byte, []int) {
+	return file_proto_blog_v1_blog_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ArchivePostResponse) GetPost() *Post {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+type SchedulePostRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	PostId string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	// Must be in the future. The post is published automatically at this time.
+	PublishAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"`
+	Etag          string                 `protobuf:"bytes,3,opt,name=etag,proto3" json:"etag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SchedulePostRequest) Reset() {
+	*x = SchedulePostRequest{}
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SchedulePostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchedulePostRequest) ProtoMessage() {}
+
+func (x *SchedulePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchedulePostRequest.ProtoReflect.Descriptor instead.
+func (*SchedulePostRequest) Descriptor() ([]byte, []int) {
+	return file_proto_blog_v1_blog_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SchedulePostRequest) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *SchedulePostRequest) GetPublishAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishAt
+	}
+	return nil
+}
+
+func (x *SchedulePostRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+type SchedulePostResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Post          *Post                  `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SchedulePostResponse) Reset() {
+	*x = SchedulePostResponse{}
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SchedulePostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchedulePostResponse) ProtoMessage() {}
+
+func (x *SchedulePostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchedulePostResponse.ProtoReflect.Descriptor instead.
+func (*SchedulePostResponse) Descriptor() ([]byte, []int) {
+	return file_proto_blog_v1_blog_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SchedulePostResponse) GetPost() *Post {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+// ListPendingPostsRequest lists the posts waiting for a moderator, longest
+// waiting first. Only moderators may call it, ApprovePost and RejectPost.
+type ListPendingPostsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPendingPostsRequest) Reset() {
+	*x = ListPendingPostsRequest{}
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPendingPostsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPendingPostsRequest) ProtoMessage() {}
+
+func (x *ListPendingPostsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPendingPostsRequest.ProtoReflect.Descriptor instead.
+func (*ListPendingPostsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_blog_v1_blog_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListPendingPostsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListPendingPostsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListPendingPostsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Posts         []*Post                `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPendingPostsResponse) Reset() {
+	*x = ListPendingPostsResponse{}
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPendingPostsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPendingPostsResponse) ProtoMessage() {}
+
+func (x *ListPendingPostsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPendingPostsResponse.ProtoReflect.Descriptor instead.
+func (*ListPendingPostsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_blog_v1_blog_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListPendingPostsResponse) GetPosts() []*Post {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
+func (x *ListPendingPostsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ApprovePostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Etag          string                 `protobuf:"bytes,2,opt,name=etag,proto3" json:"etag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApprovePostRequest) Reset() {
+	*x = ApprovePostRequest{}
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApprovePostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApprovePostRequest) ProtoMessage() {}
+
+func (x *ApprovePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApprovePostRequest.ProtoReflect.Descriptor instead.
+func (*ApprovePostRequest) Descriptor() ([]byte, []int) {
+	return file_proto_blog_v1_blog_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ApprovePostRequest) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *ApprovePostRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+type ApprovePostResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Post          *Post                  `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApprovePostResponse) Reset() {
+	*x = ApprovePostResponse{}
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApprovePostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApprovePostResponse) ProtoMessage() {}
+
+func (x *ApprovePostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApprovePostResponse.ProtoReflect.Descriptor instead.
+func (*ApprovePostResponse) Descriptor() ([]byte, []int) {
+	return file_proto_blog_v1_blog_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ApprovePostResponse) GetPost() *Post {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+type RejectPostRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	PostId string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	// Shown to the author as the post's moderation_reason.
+	Reason        string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Etag          string `protobuf:"bytes,3,opt,name=etag,proto3" json:"etag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectPostRequest) Reset() {
+	*x = RejectPostRequest{}
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectPostRequest) ProtoMessage() {}
+
+func (x *RejectPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectPostRequest.ProtoReflect.Descriptor instead.
+func (*RejectPostRequest) Descriptor() ([]byte, []int) {
+	return file_proto_blog_v1_blog_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *RejectPostRequest) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *RejectPostRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *RejectPostRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+type RejectPostResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Post          *Post                  `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectPostResponse) Reset() {
+	*x = RejectPostResponse{}
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectPostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectPostResponse) ProtoMessage() {}
+
+func (x *RejectPostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectPostResponse.ProtoReflect.Descriptor instead.
+func (*RejectPostResponse) Descriptor() ([]byte, []int) {
+	return file_proto_blog_v1_blog_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *RejectPostResponse) GetPost() *Post {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+// PostRevision is an immutable copy of a post as one write left it. The
+// revision number is the post version that write produced.
+type PostRevision struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	PostId   string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Revision int64                  `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	Post     *Post                  `protobuf:"bytes,3,opt,name=post,proto3" json:"post,omitempty"`
+	// Caller that made the write; empty for writes made by the server itself,
+	// such as scheduled publishing.
+	Editor        string                 `protobuf:"bytes,4,opt,name=editor,proto3" json:"editor,omitempty"`
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	ChangedFields []string               `protobuf:"bytes,6,rep,name=changed_fields,json=changedFields,proto3" json:"changed_fields,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PostRevision) Reset() {
+	*x = PostRevision{}
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostRevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostRevision) ProtoMessage() {}
+
+func (x *PostRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostRevision.ProtoReflect.Descriptor instead.
+func (*PostRevision) Descriptor() ([]byte, []int) {
+	return file_proto_blog_v1_blog_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *PostRevision) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *PostRevision) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *PostRevision) GetPost() *Post {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+func (x *PostRevision) GetEditor() string {
+	if x != nil {
+		return x.Editor
+	}
+	return ""
+}
+
+func (x *PostRevision) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *PostRevision) GetChangedFields() []string {
+	if x != nil {
+		return x.ChangedFields
+	}
+	return nil
+}
+
+type ListPostRevisionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPostRevisionsRequest) Reset() {
+	*x = ListPostRevisionsRequest{}
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPostRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPostRevisionsRequest) ProtoMessage() {}
+
+func (x *ListPostRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPostRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*ListPostRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_blog_v1_blog_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListPostRevisionsRequest) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *ListPostRevisionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListPostRevisionsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListPostRevisionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Newest first.
+	Revisions     []*PostRevision `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"`
+	NextPageToken string          `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPostRevisionsResponse) Reset() {
+	*x = ListPostRevisionsResponse{}
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPostRevisionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPostRevisionsResponse) ProtoMessage() {}
+
+func (x *ListPostRevisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPostRevisionsResponse.ProtoReflect.Descriptor instead.
+func (*ListPostRevisionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_blog_v1_blog_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListPostRevisionsResponse) GetRevisions() []*PostRevision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+func (x *ListPostRevisionsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetPostRevisionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Revision      int64                  `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPostRevisionRequest) Reset() {
+	*x = GetPostRevisionRequest{}
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPostRevisionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPostRevisionRequest) ProtoMessage() {}
+
+func (x *GetPostRevisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostRevisionRequest.ProtoReflect.Descriptor instead.
+func (*GetPostRevisionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_blog_v1_blog_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetPostRevisionRequest) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *GetPostRevisionRequest) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+type GetPostRevisionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revision      *PostRevision          `protobuf:"bytes,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPostRevisionResponse) Reset() {
+	*x = GetPostRevisionResponse{}
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPostRevisionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPostRevisionResponse) ProtoMessage() {}
+
+func (x *GetPostRevisionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostRevisionResponse.ProtoReflect.Descriptor instead.
+func (*GetPostRevisionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_blog_v1_blog_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetPostRevisionResponse) GetRevision() *PostRevision {
+	if x != nil {
+		return x.Revision
+	}
+	return nil
+}
+
+// RestorePostRevisionRequest copies the title, content, author, publication
+// date and tags of a revision back onto the post as a new version. The status
+// is not restored.
+type RestorePostRevisionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Revision      int64                  `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	Etag          string                 `protobuf:"bytes,3,opt,name=etag,proto3" json:"etag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestorePostRevisionRequest) Reset() {
+	*x = RestorePostRevisionRequest{}
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestorePostRevisionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestorePostRevisionRequest) ProtoMessage() {}
+
+func (x *RestorePostRevisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestorePostRevisionRequest.ProtoReflect.Descriptor instead.
+func (*RestorePostRevisionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_blog_v1_blog_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *RestorePostRevisionRequest) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *RestorePostRevisionRequest) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *RestorePostRevisionRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+type RestorePostRevisionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Post          *Post                  `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestorePostRevisionResponse) Reset() {
+	*x = RestorePostRevisionResponse{}
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestorePostRevisionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestorePostRevisionResponse) ProtoMessage() {}
+
+func (x *RestorePostRevisionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestorePostRevisionResponse.ProtoReflect.Descriptor instead.
+func (*RestorePostRevisionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_blog_v1_blog_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *RestorePostRevisionResponse) GetPost() *Post {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+type DiffPostRevisionsRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	PostId       string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	FromRevision int64                  `protobuf:"varint,2,opt,name=from_revision,json=fromRevision,proto3" json:"from_revision,omitempty"`
+	// 0 compares against the newest revision.
+	ToRevision int64      `protobuf:"varint,3,opt,name=to_revision,json=toRevision,proto3" json:"to_revision,omitempty"`
+	Format     DiffFormat `protobuf:"varint,4,opt,name=format,proto3,enum=blog.v1.DiffFormat" json:"format,omitempty"`
+	// Unchanged lines shown around each change; 0 uses the default of 3.
+	ContextLines  int32 `protobuf:"varint,5,opt,name=context_lines,json=contextLines,proto3" json:"context_lines,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffPostRevisionsRequest) Reset() {
+	*x = DiffPostRevisionsRequest{}
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffPostRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffPostRevisionsRequest) ProtoMessage() {}
+
+func (x *DiffPostRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffPostRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*DiffPostRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_blog_v1_blog_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *DiffPostRevisionsRequest) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *DiffPostRevisionsRequest) GetFromRevision() int64 {
+	if x != nil {
+		return x.FromRevision
+	}
+	return 0
+}
+
+func (x *DiffPostRevisionsRequest) GetToRevision() int64 {
+	if x != nil {
+		return x.ToRevision
+	}
+	return 0
+}
+
+func (x *DiffPostRevisionsRequest) GetFormat() DiffFormat {
+	if x != nil {
+		return x.Format
+	}
+	return DiffFormat_DIFF_FORMAT_UNSPECIFIED
+}
+
+func (x *DiffPostRevisionsRequest) GetContextLines() int32 {
+	if x != nil {
+		return x.ContextLines
+	}
+	return 0
+}
+
+type DiffSegment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Op            DiffOp                 `protobuf:"varint,1,opt,name=op,proto3,enum=blog.v1.DiffOp" json:"op,omitempty"`
+	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffSegment) Reset() {
+	*x = DiffSegment{}
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffSegment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffSegment) ProtoMessage() {}
+
+func (x *DiffSegment) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffSegment.ProtoReflect.Descriptor instead.
+func (*DiffSegment) Descriptor() ([]byte, []int) {
+	return file_proto_blog_v1_blog_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *DiffSegment) GetOp() DiffOp {
+	if x != nil {
+		return x.Op
+	}
+	return DiffOp_DIFF_OP_UNSPECIFIED
+}
+
+func (x *DiffSegment) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type DiffLine struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Op    DiffOp                 `protobuf:"varint,1,opt,name=op,proto3,enum=blog.v1.DiffOp" json:"op,omitempty"`
+	Text  string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	// Word level changes for a line that replaces, or is replaced by, the
+	// matching line on the other side.
+	Words         []*DiffSegment `protobuf:"bytes,3,rep,name=words,proto3" json:"words,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffLine) Reset() {
+	*x = DiffLine{}
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffLine) ProtoMessage() {}
+
+func (x *DiffLine) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffLine.ProtoReflect.Descriptor instead.
+func (*DiffLine) Descriptor() ([]byte, []int) {
+	return file_proto_blog_v1_blog_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *DiffLine) GetOp() DiffOp {
+	if x != nil {
+		return x.Op
+	}
+	return DiffOp_DIFF_OP_UNSPECIFIED
+}
+
+func (x *DiffLine) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *DiffLine) GetWords() []*DiffSegment {
+	if x != nil {
+		return x.Words
+	}
+	return nil
+}
+
+type DiffHunk struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OldStart      int32                  `protobuf:"varint,1,opt,name=old_start,json=oldStart,proto3" json:"old_start,omitempty"`
+	OldLines      int32                  `protobuf:"varint,2,opt,name=old_lines,json=oldLines,proto3" json:"old_lines,omitempty"`
+	NewStart      int32                  `protobuf:"varint,3,opt,name=new_start,json=newStart,proto3" json:"new_start,omitempty"`
+	NewLines      int32                  `protobuf:"varint,4,opt,name=new_lines,json=newLines,proto3" json:"new_lines,omitempty"`
+	Lines         []*DiffLine            `protobuf:"bytes,5,rep,name=lines,proto3" json:"lines,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffHunk) Reset() {
+	*x = DiffHunk{}
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffHunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffHunk) ProtoMessage() {}
+
+func (x *DiffHunk) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffHunk.ProtoReflect.Descriptor instead.
+func (*DiffHunk) Descriptor() ([]byte, []int) {
+	return file_proto_blog_v1_blog_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *DiffHunk) GetOldStart() int32 {
+	if x != nil {
+		return x.OldStart
+	}
+	return 0
+}
+
+func (x *DiffHunk) GetOldLines() int32 {
+	if x != nil {
+		return x.OldLines
+	}
+	return 0
+}
+
+func (x *DiffHunk) GetNewStart() int32 {
+	if x != nil {
+		return x.NewStart
+	}
+	return 0
+}
+
+func (x *DiffHunk) GetNewLines() int32 {
+	if x != nil {
+		return x.NewLines
+	}
+	return 0
+}
+
+func (x *DiffHunk) GetLines() []*DiffLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+type FieldDiff struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	OldValue      string                 `protobuf:"bytes,2,opt,name=old_value,json=oldValue,proto3" json:"old_value,omitempty"`
+	NewValue      string                 `protobuf:"bytes,3,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FieldDiff) Reset() {
+	*x = FieldDiff{}
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FieldDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldDiff) ProtoMessage() {}
+
+func (x *FieldDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldDiff.ProtoReflect.Descriptor instead.
+func (*FieldDiff) Descriptor() ([]byte, []int) {
+	return file_proto_blog_v1_blog_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *FieldDiff) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *FieldDiff) GetOldValue() string {
+	if x != nil {
+		return x.OldValue
+	}
+	return ""
+}
+
+func (x *FieldDiff) GetNewValue() string {
+	if x != nil {
+		return x.NewValue
+	}
+	return ""
+}
+
+type DiffPostRevisionsResponse struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	PostId       string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	FromRevision int64                  `protobuf:"varint,2,opt,name=from_revision,json=fromRevision,proto3" json:"from_revision,omitempty"`
+	ToRevision   int64                  `protobuf:"varint,3,opt,name=to_revision,json=toRevision,proto3" json:"to_revision,omitempty"`
+	// Title and author changes.
+	Fields      []*FieldDiff `protobuf:"bytes,4,rep,name=fields,proto3" json:"fields,omitempty"`
+	AddedTags   []string     `protobuf:"bytes,5,rep,name=added_tags,json=addedTags,proto3" json:"added_tags,omitempty"`
+	RemovedTags []string     `protobuf:"bytes,6,rep,name=removed_tags,json=removedTags,proto3" json:"removed_tags,omitempty"`
+	// Content changes; hunks for DIFF_FORMAT_HUNKS, unified_diff otherwise.
+	Hunks         []*DiffHunk `protobuf:"bytes,7,rep,name=hunks,proto3" json:"hunks,omitempty"`
+	UnifiedDiff   string      `protobuf:"bytes,8,opt,name=unified_diff,json=unifiedDiff,proto3" json:"unified_diff,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffPostRevisionsResponse) Reset() {
+	*x = DiffPostRevisionsResponse{}
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffPostRevisionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffPostRevisionsResponse) ProtoMessage() {}
+
+func (x *DiffPostRevisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffPostRevisionsResponse.ProtoReflect.Descriptor instead.
+func (*DiffPostRevisionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_blog_v1_blog_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *DiffPostRevisionsResponse) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *DiffPostRevisionsResponse) GetFromRevision() int64 {
+	if x != nil {
+		return x.FromRevision
+	}
+	return 0
+}
+
+func (x *DiffPostRevisionsResponse) GetToRevision() int64 {
+	if x != nil {
+		return x.ToRevision
+	}
+	return 0
+}
+
+func (x *DiffPostRevisionsResponse) GetFields() []*FieldDiff {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *DiffPostRevisionsResponse) GetAddedTags() []string {
+	if x != nil {
+		return x.AddedTags
+	}
+	return nil
+}
+
+func (x *DiffPostRevisionsResponse) GetRemovedTags() []string {
+	if x != nil {
+		return x.RemovedTags
+	}
+	return nil
+}
+
+func (x *DiffPostRevisionsResponse) GetHunks() []*DiffHunk {
+	if x != nil {
+		return x.Hunks
+	}
+	return nil
+}
+
+func (x *DiffPostRevisionsResponse) GetUnifiedDiff() string {
+	if x != nil {
+		return x.UnifiedDiff
+	}
+	return ""
+}
+
+// Comment is a reader comment on a post. Replies name the comment they answer
+// in parent_id, so threads nest to any depth.
+type Comment struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	CommentId string                 `protobuf:"bytes,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	PostId    string                 `protobuf:"bytes,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	// Empty for a top-level comment.
+	ParentId   string                 `protobuf:"bytes,3,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	Author     string                 `protobuf:"bytes,4,opt,name=author,proto3" json:"author,omitempty"`
+	Content    string                 `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	UpdateTime *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	Version    int64                  `protobuf:"varint,8,opt,name=version,proto3" json:"version,omitempty"`
+	Etag       string                 `protobuf:"bytes,9,opt,name=etag,proto3" json:"etag,omitempty"`
+	// Set on a deleted comment that is kept, without author and content,
+	// because it still has replies.
+	Deleted bool `protobuf:"varint,10,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	// Only set by ListComments: the number of comments between this one and
+	// the top of the listing, and the number of direct replies.
+	Depth         int32 `protobuf:"varint,11,opt,name=depth,proto3" json:"depth,omitempty"`
+	ReplyCount    int32 `protobuf:"varint,12,opt,name=reply_count,json=replyCount,proto3" json:"reply_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Comment) Reset() {
+	*x = Comment{}
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Comment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Comment) ProtoMessage() {}
+
+func (x *Comment) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Comment.ProtoReflect.Descriptor instead.
+func (*Comment) Descriptor() ([]byte, []int) {
+	return file_proto_blog_v1_blog_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *Comment) GetCommentId() string {
+	if x != nil {
+		return x.CommentId
+	}
+	return ""
+}
+
+func (x *Comment) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *Comment) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *Comment) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *Comment) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *Comment) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *Comment) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+func (x *Comment) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Comment) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+func (x *Comment) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+func (x *Comment) GetDepth() int32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+func (x *Comment) GetReplyCount() int32 {
+	if x != nil {
+		return x.ReplyCount
+	}
+	return 0
+}
+
+type AddCommentRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	PostId string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	// Set to reply to another comment on the same post.
+	ParentId      string `protobuf:"bytes,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	Author        string `protobuf:"bytes,3,opt,name=author,proto3" json:"author,omitempty"`
+	Content       string `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddCommentRequest) Reset() {
+	*x = AddCommentRequest{}
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCommentRequest) ProtoMessage() {}
+
+func (x *AddCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCommentRequest.ProtoReflect.Descriptor instead.
+func (*AddCommentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_blog_v1_blog_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *AddCommentRequest) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *AddCommentRequest) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *AddCommentRequest) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *AddCommentRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type AddCommentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Comment       *Comment               `protobuf:"bytes,1,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddCommentResponse) Reset() {
+	*x = AddCommentResponse{}
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddCommentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCommentResponse) ProtoMessage() {}
+
+func (x *AddCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCommentResponse.ProtoReflect.Descriptor instead.
+func (*AddCommentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_blog_v1_blog_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *AddCommentResponse) GetComment() *Comment {
+	if x != nil {
+		return x.Comment
+	}
+	return nil
+}
+
+// ListCommentsRequest lists the comments of a post in thread order: every
+// comment is followed by its replies, oldest first.
+type ListCommentsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	PostId string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	// When set, only the replies below this comment are listed.
+	ParentId      string `protobuf:"bytes,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	PageSize      int32  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCommentsRequest) Reset() {
+	*x = ListCommentsRequest{}
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCommentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCommentsRequest) ProtoMessage() {}
+
+func (x *ListCommentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCommentsRequest.ProtoReflect.Descriptor instead.
+func (*ListCommentsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_blog_v1_blog_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ListCommentsRequest) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *ListCommentsRequest) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *ListCommentsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListCommentsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListCommentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Comments      []*Comment             `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCommentsResponse) Reset() {
+	*x = ListCommentsResponse{}
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCommentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCommentsResponse) ProtoMessage() {}
+
+func (x *ListCommentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCommentsResponse.ProtoReflect.Descriptor instead.
+func (*ListCommentsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_blog_v1_blog_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ListCommentsResponse) GetComments() []*Comment {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+func (x *ListCommentsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type EditCommentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CommentId     string                 `protobuf:"bytes,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	Etag          string                 `protobuf:"bytes,3,opt,name=etag,proto3" json:"etag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EditCommentRequest) Reset() {
+	*x = EditCommentRequest{}
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditCommentRequest) ProtoMessage() {}
+
+func (x *EditCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditCommentRequest.ProtoReflect.Descriptor instead.
+func (*EditCommentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_blog_v1_blog_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *EditCommentRequest) GetCommentId() string {
+	if x != nil {
+		return x.CommentId
+	}
+	return ""
+}
+
+func (x *EditCommentRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *EditCommentRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+type EditCommentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Comment       *Comment               `protobuf:"bytes,1,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EditCommentResponse) Reset() {
+	*x = EditCommentResponse{}
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditCommentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditCommentResponse) ProtoMessage() {}
+
+func (x *EditCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditCommentResponse.ProtoReflect.Descriptor instead.
+func (*EditCommentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_blog_v1_blog_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *EditCommentResponse) GetComment() *Comment {
+	if x != nil {
+		return x.Comment
+	}
+	return nil
+}
+
+// DeleteCommentRequest removes a comment, or replaces it with a tombstone
+// while it still has replies.
+type DeleteCommentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CommentId     string                 `protobuf:"bytes,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	Etag          string                 `protobuf:"bytes,2,opt,name=etag,proto3" json:"etag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCommentRequest) Reset() {
+	*x = DeleteCommentRequest{}
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCommentRequest) ProtoMessage() {}
+
+func (x *DeleteCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCommentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCommentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_blog_v1_blog_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *DeleteCommentRequest) GetCommentId() string {
+	if x != nil {
+		return x.CommentId
+	}
+	return ""
+}
+
+func (x *DeleteCommentRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+type DeleteCommentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCommentResponse) Reset() {
+	*x = DeleteCommentResponse{}
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCommentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCommentResponse) ProtoMessage() {}
+
+func (x *DeleteCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCommentResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCommentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_blog_v1_blog_proto_rawDescGZIP(), []int{52}
+}
+
+// Author is the profile behind post bylines. Names are unique ignoring case
+// and spacing.
+type Author struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuthorId      string                 `protobuf:"bytes,1,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Bio           string                 `protobuf:"bytes,3,opt,name=bio,proto3" json:"bio,omitempty"`
+	AvatarUrl     string                 `protobuf:"bytes,4,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	Version       int64                  `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`
+	Etag          string                 `protobuf:"bytes,8,opt,name=etag,proto3" json:"etag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Author) Reset() {
+	*x = Author{}
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Author) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Author) ProtoMessage() {}
+
+func (x *Author) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Author.ProtoReflect.Descriptor instead.
+func (*Author) Descriptor() ([]byte, []int) {
+	return file_proto_blog_v1_blog_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *Author) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *Author) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Author) GetBio() string {
+	if x != nil {
+		return x.Bio
+	}
+	return ""
+}
+
+func (x *Author) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+func (x *Author) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *Author) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+func (x *Author) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Author) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+type CreateAuthorRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Bio   string                 `protobuf:"bytes,2,opt,name=bio,proto3" json:"bio,omitempty"`
+	// An absolute http or https URL.
+	AvatarUrl     string `protobuf:"bytes,3,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAuthorRequest) Reset() {
+	*x = CreateAuthorRequest{}
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAuthorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAuthorRequest) ProtoMessage() {}
+
+func (x *CreateAuthorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAuthorRequest.ProtoReflect.Descriptor instead.
+func (*CreateAuthorRequest) Descriptor() ([]byte, []int) {
+	return file_proto_blog_v1_blog_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *CreateAuthorRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateAuthorRequest) GetBio() string {
+	if x != nil {
+		return x.Bio
+	}
+	return ""
+}
+
+func (x *CreateAuthorRequest) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+type CreateAuthorResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Author        *Author                `protobuf:"bytes,1,opt,name=author,proto3" json:"author,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAuthorResponse) Reset() {
+	*x = CreateAuthorResponse{}
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAuthorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAuthorResponse) ProtoMessage() {}
+
+func (x *CreateAuthorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAuthorResponse.ProtoReflect.Descriptor instead.
+func (*CreateAuthorResponse) Descriptor() ([]byte, []int) {
+	return file_proto_blog_v1_blog_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *CreateAuthorResponse) GetAuthor() *Author {
+	if x != nil {
+		return x.Author
+	}
+	return nil
+}
+
+type GetAuthorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuthorId      string                 `protobuf:"bytes,1,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAuthorRequest) Reset() {
+	*x = GetAuthorRequest{}
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAuthorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAuthorRequest) ProtoMessage() {}
+
+func (x *GetAuthorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAuthorRequest.ProtoReflect.Descriptor instead.
+func (*GetAuthorRequest) Descriptor() ([]byte, []int) {
+	return file_proto_blog_v1_blog_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GetAuthorRequest) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+type GetAuthorResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Author        *Author                `protobuf:"bytes,1,opt,name=author,proto3" json:"author,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAuthorResponse) Reset() {
+	*x = GetAuthorResponse{}
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAuthorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAuthorResponse) ProtoMessage() {}
+
+func (x *GetAuthorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAuthorResponse.ProtoReflect.Descriptor instead.
+func (*GetAuthorResponse) Descriptor() ([]byte, []int) {
+	return file_proto_blog_v1_blog_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GetAuthorResponse) GetAuthor() *Author {
+	if x != nil {
+		return x.Author
+	}
+	return nil
+}
+
+// UpdateAuthorRequest changes an author's profile. Renaming an author also
+// renames the bylines of their posts.
+type UpdateAuthorRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	AuthorId  string                 `protobuf:"bytes,1,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Name      string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Bio       string                 `protobuf:"bytes,3,opt,name=bio,proto3" json:"bio,omitempty"`
+	AvatarUrl string                 `protobuf:"bytes,4,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
+	// Paths of the Author fields to update. When empty, name, bio and
+	// avatar_url are replaced.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,5,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	Etag          string                 `protobuf:"bytes,6,opt,name=etag,proto3" json:"etag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAuthorRequest) Reset() {
+	*x = UpdateAuthorRequest{}
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAuthorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAuthorRequest) ProtoMessage() {}
+
+func (x *UpdateAuthorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAuthorRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAuthorRequest) Descriptor() ([]byte, []int) {
+	return file_proto_blog_v1_blog_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *UpdateAuthorRequest) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *UpdateAuthorRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateAuthorRequest) GetBio() string {
+	if x != nil {
+		return x.Bio
+	}
+	return ""
+}
+
+func (x *UpdateAuthorRequest) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+func (x *UpdateAuthorRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateAuthorRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+type UpdateAuthorResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Author        *Author                `protobuf:"bytes,1,opt,name=author,proto3" json:"author,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAuthorResponse) Reset() {
+	*x = UpdateAuthorResponse{}
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAuthorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAuthorResponse) ProtoMessage() {}
+
+func (x *UpdateAuthorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAuthorResponse.ProtoReflect.Descriptor instead.
+func (*UpdateAuthorResponse) Descriptor() ([]byte, []int) {
+	return file_proto_blog_v1_blog_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *UpdateAuthorResponse) GetAuthor() *Author {
+	if x != nil {
+		return x.Author
+	}
+	return nil
+}
+
+// DeleteAuthorRequest removes an author that has no posts; otherwise
+// FAILED_PRECONDITION is returned.
+type DeleteAuthorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuthorId      string                 `protobuf:"bytes,1,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Etag          string                 `protobuf:"bytes,2,opt,name=etag,proto3" json:"etag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAuthorRequest) Reset() {
+	*x = DeleteAuthorRequest{}
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAuthorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAuthorRequest) ProtoMessage() {}
+
+func (x *DeleteAuthorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAuthorRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAuthorRequest) Descriptor() ([]byte, []int) {
+	return file_proto_blog_v1_blog_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *DeleteAuthorRequest) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *DeleteAuthorRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+type DeleteAuthorResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAuthorResponse) Reset() {
+	*x = DeleteAuthorResponse{}
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAuthorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAuthorResponse) ProtoMessage() {}
+
+func (x *DeleteAuthorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAuthorResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAuthorResponse) Descriptor() ([]byte, []int) {
+	return file_proto_blog_v1_blog_proto_rawDescGZIP(), []int{61}
+}
+
+type ListAuthorsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuthorsRequest) Reset() {
+	*x = ListAuthorsRequest{}
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuthorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuthorsRequest) ProtoMessage() {}
+
+func (x *ListAuthorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuthorsRequest.ProtoReflect.Descriptor instead.
+func (*ListAuthorsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_blog_v1_blog_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ListAuthorsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListAuthorsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListAuthorsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Authors       []*Author              `protobuf:"bytes,1,rep,name=authors,proto3" json:"authors,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuthorsResponse) Reset() {
+	*x = ListAuthorsResponse{}
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuthorsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuthorsResponse) ProtoMessage() {}
+
+func (x *ListAuthorsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuthorsResponse.ProtoReflect.Descriptor instead.
+func (*ListAuthorsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_blog_v1_blog_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ListAuthorsResponse) GetAuthors() []*Author {
+	if x != nil {
+		return x.Authors
+	}
+	return nil
+}
+
+func (x *ListAuthorsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type TagCount struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Tag   string                 `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	// Number of posts every reader can see that carry the tag.
+	PostCount     int32 `protobuf:"varint,2,opt,name=post_count,json=postCount,proto3" json:"post_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TagCount) Reset() {
+	*x = TagCount{}
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagCount) ProtoMessage() {}
+
+func (x *TagCount) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagCount.ProtoReflect.Descriptor instead.
+func (*TagCount) Descriptor() ([]byte, []int) {
+	return file_proto_blog_v1_blog_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *TagCount) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *TagCount) GetPostCount() int32 {
+	if x != nil {
+		return x.PostCount
+	}
+	return 0
+}
+
+type ListTagsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	OrderBy       TagOrder               `protobuf:"varint,3,opt,name=order_by,json=orderBy,proto3,enum=blog.v1.TagOrder" json:"order_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTagsRequest) Reset() {
+	*x = ListTagsRequest{}
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagsRequest) ProtoMessage() {}
+
+func (x *ListTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagsRequest.ProtoReflect.Descriptor instead.
+func (*ListTagsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_blog_v1_blog_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ListTagsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListTagsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListTagsRequest) GetOrderBy() TagOrder {
+	if x != nil {
+		return x.OrderBy
+	}
+	return TagOrder_TAG_ORDER_UNSPECIFIED
+}
+
+type ListTagsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tags          []*TagCount            `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTagsResponse) Reset() {
+	*x = ListTagsResponse{}
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagsResponse) ProtoMessage() {}
+
+func (x *ListTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagsResponse.ProtoReflect.Descriptor instead.
+func (*ListTagsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_blog_v1_blog_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ListTagsResponse) GetTags() []*TagCount {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ListTagsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// Replaces a tag on every post; fails with ALREADY_EXISTS if a post already
+// has the new tag. Moderators only.
+type RenameTagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameTagRequest) Reset() {
+	*x = RenameTagRequest{}
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameTagRequest) ProtoMessage() {}
+
+func (x *RenameTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameTagRequest.ProtoReflect.Descriptor instead.
+func (*RenameTagRequest) Descriptor() ([]byte, []int) {
+	return file_proto_blog_v1_blog_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *RenameTagRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *RenameTagRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+type RenameTagResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostsChanged  int32                  `protobuf:"varint,1,opt,name=posts_changed,json=postsChanged,proto3" json:"posts_changed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameTagResponse) Reset() {
+	*x = RenameTagResponse{}
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameTagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameTagResponse) ProtoMessage() {}
+
+func (x *RenameTagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameTagResponse.ProtoReflect.Descriptor instead.
+func (*RenameTagResponse) Descriptor() ([]byte, []int) {
+	return file_proto_blog_v1_blog_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *RenameTagResponse) GetPostsChanged() int32 {
+	if x != nil {
+		return x.PostsChanged
+	}
+	return 0
+}
+
+// Replaces every source tag with the target tag on every post. Moderators
+// only.
+type MergeTagsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sources       []string               `protobuf:"bytes,1,rep,name=sources,proto3" json:"sources,omitempty"`
+	Target        string                 `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeTagsRequest) Reset() {
+	*x = MergeTagsRequest{}
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeTagsRequest) ProtoMessage() {}
+
+func (x *MergeTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeTagsRequest.ProtoReflect.Descriptor instead.
+func (*MergeTagsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_blog_v1_blog_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *MergeTagsRequest) GetSources() []string {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
+}
+
+func (x *MergeTagsRequest) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+type MergeTagsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostsChanged  int32                  `protobuf:"varint,1,opt,name=posts_changed,json=postsChanged,proto3" json:"posts_changed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeTagsResponse) Reset() {
+	*x = MergeTagsResponse{}
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeTagsResponse) ProtoMessage() {}
+
+func (x *MergeTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeTagsResponse.ProtoReflect.Descriptor instead.
+func (*MergeTagsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_blog_v1_blog_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *MergeTagsResponse) GetPostsChanged() int32 {
+	if x != nil {
+		return x.PostsChanged
+	}
+	return 0
+}
+
+type WatchPostsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Author        string                 `protobuf:"bytes,1,opt,name=author,proto3" json:"author,omitempty"`
+	Tag           string                 `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+	ResumeToken   string                 `protobuf:"bytes,3,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchPostsRequest) Reset() {
+	*x = WatchPostsRequest{}
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchPostsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchPostsRequest) ProtoMessage() {}
+
+func (x *WatchPostsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchPostsRequest.ProtoReflect.Descriptor instead.
+func (*WatchPostsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_blog_v1_blog_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *WatchPostsRequest) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *WatchPostsRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *WatchPostsRequest) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+type PostEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          PostEventType          `protobuf:"varint,1,opt,name=type,proto3,enum=blog.v1.PostEventType" json:"type,omitempty"`
+	Post          *Post                  `protobuf:"bytes,2,opt,name=post,proto3" json:"post,omitempty"`
+	ResumeToken   string                 `protobuf:"bytes,3,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PostEvent) Reset() {
+	*x = PostEvent{}
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostEvent) ProtoMessage() {}
+
+func (x *PostEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostEvent.ProtoReflect.Descriptor instead.
+func (*PostEvent) Descriptor() ([]byte, []int) {
+	return file_proto_blog_v1_blog_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *PostEvent) GetType() PostEventType {
+	if x != nil {
+		return x.Type
+	}
+	return PostEventType_POST_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *PostEvent) GetPost() *Post {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+func (x *PostEvent) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+func (x *PostEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+type SearchPostsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchPostsRequest) Reset() {
+	*x = SearchPostsRequest{}
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchPostsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchPostsRequest) ProtoMessage() {}
+
+func (x *SearchPostsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchPostsRequest.ProtoReflect.Descriptor instead.
+func (*SearchPostsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_blog_v1_blog_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *SearchPostsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchPostsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchPostsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type SearchResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Post  *Post                  `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
+	Score float64                `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	// HTML excerpt of the post: the text is escaped and matches are wrapped
+	// in <em></em>.
+	Snippet       string `protobuf:"bytes,3,opt,name=snippet,proto3" json:"snippet,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchResult) Reset() {
+	*x = SearchResult{}
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResult) ProtoMessage() {}
+
+func (x *SearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResult.ProtoReflect.Descriptor instead.
+func (*SearchResult) Descriptor() ([]byte, []int) {
+	return file_proto_blog_v1_blog_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *SearchResult) GetPost() *Post {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+func (x *SearchResult) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *SearchResult) GetSnippet() string {
+	if x != nil {
+		return x.Snippet
+	}
+	return ""
+}
+
+type SearchPostsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*SearchResult        `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchPostsResponse) Reset() {
+	*x = SearchPostsResponse{}
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchPostsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchPostsResponse) ProtoMessage() {}
+
+func (x *SearchPostsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchPostsResponse.ProtoReflect.Descriptor instead.
+func (*SearchPostsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_blog_v1_blog_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *SearchPostsResponse) GetResults() []*SearchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *SearchPostsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// ApiKey is a credential for callers that cannot obtain tokens. It is sent
+// in the x-api-key header and acts as its owner, limited to its scopes:
+// "posts:read", "posts:write" and "admin". Only a hash of the key is stored.
+type ApiKey struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	KeyId string                 `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The caller the key acts as.
+	Owner      string                 `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	Scopes     []string               `protobuf:"bytes,4,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// Unset for keys that do not expire.
+	ExpireTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	// When the key was last used, to within a minute.
+	LastUsedTime  *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_used_time,json=lastUsedTime,proto3" json:"last_used_time,omitempty"`
+	RevokeTime    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=revoke_time,json=revokeTime,proto3" json:"revoke_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApiKey) Reset() {
+	*x = ApiKey{}
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApiKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiKey) ProtoMessage() {}
+
+func (x *ApiKey) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiKey.ProtoReflect.Descriptor instead.
+func (*ApiKey) Descriptor() ([]byte, []int) {
+	return file_proto_blog_v1_blog_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ApiKey) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *ApiKey) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ApiKey) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *ApiKey) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *ApiKey) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *ApiKey) GetExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return nil
+}
+
+func (x *ApiKey) GetLastUsedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedTime
+	}
+	return nil
+}
+
+func (x *ApiKey) GetRevokeTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RevokeTime
+	}
+	return nil
+}
+
+// Admins only, like the other API key calls.
+type IssueApiKeyRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Name   string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Owner  string                 `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	Scopes []string               `protobuf:"bytes,3,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	// Unset for a key that does not expire.
+	Ttl           *durationpb.Duration `protobuf:"bytes,4,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IssueApiKeyRequest) Reset() {
+	*x = IssueApiKeyRequest{}
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueApiKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueApiKeyRequest) ProtoMessage() {}
+
+func (x *IssueApiKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueApiKeyRequest.ProtoReflect.Descriptor instead.
+func (*IssueApiKeyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_blog_v1_blog_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *IssueApiKeyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *IssueApiKeyRequest) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *IssueApiKeyRequest) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *IssueApiKeyRequest) GetTtl() *durationpb.Duration {
+	if x != nil {
+		return x.Ttl
+	}
+	return nil
+}
+
+type IssueApiKeyResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	ApiKey *ApiKey                `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	// The key itself. It is only ever returned here.
+	RawKey        string `protobuf:"bytes,2,opt,name=raw_key,json=rawKey,proto3" json:"raw_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IssueApiKeyResponse) Reset() {
+	*x = IssueApiKeyResponse{}
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueApiKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueApiKeyResponse) ProtoMessage() {}
+
+func (x *IssueApiKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueApiKeyResponse.ProtoReflect.Descriptor instead.
+func (*IssueApiKeyResponse) Descriptor() ([]byte, []int) {
+	return file_proto_blog_v1_blog_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *IssueApiKeyResponse) GetApiKey() *ApiKey {
+	if x != nil {
+		return x.ApiKey
+	}
+	return nil
+}
+
+func (x *IssueApiKeyResponse) GetRawKey() string {
+	if x != nil {
+		return x.RawKey
+	}
+	return ""
+}
+
+type ListApiKeysRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PostId        string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeletePostRequest) Reset() {
-	*x = DeletePostRequest{}
-	mi := &file_proto_blog_v1_blog_proto_msgTypes[7]
+func (x *ListApiKeysRequest) Reset() {
+	*x = ListApiKeysRequest{}
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeletePostRequest) String() string {
+func (x *ListApiKeysRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeletePostRequest) ProtoMessage() {}
+func (*ListApiKeysRequest) ProtoMessage() {}
 
-func (x *DeletePostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_blog_v1_blog_proto_msgTypes[7]
+func (x *ListApiKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -465,40 +5151,49 @@ func (x *DeletePostRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeletePostRequest.ProtoReflect.Descriptor instead.
-func (*DeletePostRequest) Descriptor() ([]byte, []int) {
-	return file_proto_blog_v1_blog_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use ListApiKeysRequest.ProtoReflect.Descriptor instead.
+func (*ListApiKeysRequest) Descriptor() ([]byte, []int) {
+	return file_proto_blog_v1_blog_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *DeletePostRequest) GetPostId() string {
+func (x *ListApiKeysRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.PostId
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListApiKeysRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
 	}
 	return ""
 }
 
-type DeletePostResponse struct {
+// Keys oldest first, revoked and expired ones included.
+type ListApiKeysResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ApiKeys       []*ApiKey              `protobuf:"bytes,1,rep,name=api_keys,json=apiKeys,proto3" json:"api_keys,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeletePostResponse) Reset() {
-	*x = DeletePostResponse{}
-	mi := &file_proto_blog_v1_blog_proto_msgTypes[8]
+func (x *ListApiKeysResponse) Reset() {
+	*x = ListApiKeysResponse{}
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeletePostResponse) String() string {
+func (x *ListApiKeysResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeletePostResponse) ProtoMessage() {}
+func (*ListApiKeysResponse) ProtoMessage() {}
 
-func (x *DeletePostResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_blog_v1_blog_proto_msgTypes[8]
+func (x *ListApiKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -509,62 +5204,550 @@ func (x *DeletePostResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeletePostResponse.ProtoReflect.Descriptor instead.
-func (*DeletePostResponse) Descriptor() ([]byte, []int) {
-	return file_proto_blog_v1_blog_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use ListApiKeysResponse.ProtoReflect.Descriptor instead.
+func (*ListApiKeysResponse) Descriptor() ([]byte, []int) {
+	return file_proto_blog_v1_blog_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *DeletePostResponse) GetSuccess() bool {
+func (x *ListApiKeysResponse) GetApiKeys() []*ApiKey {
 	if x != nil {
-		return x.Success
+		return x.ApiKeys
 	}
-	return false
+	return nil
+}
+
+func (x *ListApiKeysResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type RevokeApiKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KeyId         string                 `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeApiKeyRequest) Reset() {
+	*x = RevokeApiKeyRequest{}
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeApiKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeApiKeyRequest) ProtoMessage() {}
+
+func (x *RevokeApiKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeApiKeyRequest.ProtoReflect.Descriptor instead.
+func (*RevokeApiKeyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_blog_v1_blog_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *RevokeApiKeyRequest) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+type RevokeApiKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiKey        *ApiKey                `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeApiKeyResponse) Reset() {
+	*x = RevokeApiKeyResponse{}
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeApiKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeApiKeyResponse) ProtoMessage() {}
+
+func (x *RevokeApiKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blog_v1_blog_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeApiKeyResponse.ProtoReflect.Descriptor instead.
+func (*RevokeApiKeyResponse) Descriptor() ([]byte, []int) {
+	return file_proto_blog_v1_blog_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *RevokeApiKeyResponse) GetApiKey() *ApiKey {
+	if x != nil {
+		return x.ApiKey
+	}
+	return nil
 }
 
 var File_proto_blog_v1_blog_proto protoreflect.FileDescriptor
 
 const file_proto_blog_v1_blog_proto_rawDesc = "" +
 	"\n" +
-	"\x18proto/blog/v1/blog.proto\x12\ablog.v1\"\xa6\x01\n" +
+	"\x18proto/blog/v1/blog.proto\x12\ablog.v1\x1a\x1egoogle/protobuf/duration.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa0\x04\n" +
 	"\x04Post\x12\x17\n" +
 	"\apost_id\x18\x01 \x01(\tR\x06postId\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
 	"\acontent\x18\x03 \x01(\tR\acontent\x12\x16\n" +
 	"\x06author\x18\x04 \x01(\tR\x06author\x12)\n" +
 	"\x10publication_date\x18\x05 \x01(\tR\x0fpublicationDate\x12\x12\n" +
-	"\x04tags\x18\x06 \x03(\tR\x04tags\"\x9a\x01\n" +
+	"\x04tags\x18\x06 \x03(\tR\x04tags\x12\x18\n" +
+	"\aversion\x18\a \x01(\x03R\aversion\x12\x12\n" +
+	"\x04etag\x18\b \x01(\tR\x04etag\x12+\n" +
+	"\x06status\x18\t \x01(\x0e2\x13.blog.v1.PostStatusR\x06status\x12=\n" +
+	"\fpublish_time\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\vpublishTime\x12;\n" +
+	"\vdelete_time\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"deleteTime\x12C\n" +
+	"\x10moderation_state\x18\f \x01(\x0e2\x18.blog.v1.ModerationStateR\x0fmoderationState\x12+\n" +
+	"\x11moderation_reason\x18\r \x01(\tR\x10moderationReason\x12\x1b\n" +
+	"\tauthor_id\x18\x0e \x01(\tR\bauthorId\x12\x12\n" +
+	"\x04slug\x18\x0f \x01(\tR\x04slug\"\xb7\x01\n" +
 	"\x11CreatePostRequest\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12\x18\n" +
 	"\acontent\x18\x02 \x01(\tR\acontent\x12\x16\n" +
 	"\x06author\x18\x03 \x01(\tR\x06author\x12)\n" +
 	"\x10publication_date\x18\x04 \x01(\tR\x0fpublicationDate\x12\x12\n" +
-	"\x04tags\x18\x05 \x03(\tR\x04tags\"7\n" +
+	"\x04tags\x18\x05 \x03(\tR\x04tags\x12\x1b\n" +
+	"\tauthor_id\x18\x06 \x01(\tR\bauthorId\"7\n" +
 	"\x12CreatePostResponse\x12!\n" +
 	"\x04post\x18\x01 \x01(\v2\r.blog.v1.PostR\x04post\")\n" +
 	"\x0eGetPostRequest\x12\x17\n" +
 	"\apost_id\x18\x01 \x01(\tR\x06postId\"4\n" +
 	"\x0fGetPostResponse\x12!\n" +
-	"\x04post\x18\x01 \x01(\v2\r.blog.v1.PostR\x04post\"\x88\x01\n" +
+	"\x04post\x18\x01 \x01(\v2\r.blog.v1.PostR\x04post\"*\n" +
+	"\x14GetPostBySlugRequest\x12\x12\n" +
+	"\x04slug\x18\x01 \x01(\tR\x04slug\"V\n" +
+	"\x15GetPostBySlugResponse\x12!\n" +
+	"\x04post\x18\x01 \x01(\v2\r.blog.v1.PostR\x04post\x12\x1a\n" +
+	"\bredirect\x18\x02 \x01(\bR\bredirect\"\xce\x02\n" +
 	"\x11UpdatePostRequest\x12\x17\n" +
 	"\apost_id\x18\x01 \x01(\tR\x06postId\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
 	"\acontent\x18\x03 \x01(\tR\acontent\x12\x16\n" +
 	"\x06author\x18\x04 \x01(\tR\x06author\x12\x12\n" +
-	"\x04tags\x18\x05 \x03(\tR\x04tags\"7\n" +
+	"\x04tags\x18\x05 \x03(\tR\x04tags\x12)\n" +
+	"\x10publication_date\x18\x06 \x01(\tR\x0fpublicationDate\x12;\n" +
+	"\vupdate_mask\x18\a \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\x12\x12\n" +
+	"\x04etag\x18\b \x01(\tR\x04etag\x12+\n" +
+	"\x06status\x18\t \x01(\x0e2\x13.blog.v1.PostStatusR\x06status\x12\x1b\n" +
+	"\tauthor_id\x18\n" +
+	" \x01(\tR\bauthorId\"7\n" +
 	"\x12UpdatePostResponse\x12!\n" +
-	"\x04post\x18\x01 \x01(\v2\r.blog.v1.PostR\x04post\",\n" +
+	"\x04post\x18\x01 \x01(\v2\r.blog.v1.PostR\x04post\"@\n" +
 	"\x11DeletePostRequest\x12\x17\n" +
-	"\apost_id\x18\x01 \x01(\tR\x06postId\".\n" +
+	"\apost_id\x18\x01 \x01(\tR\x06postId\x12\x12\n" +
+	"\x04etag\x18\x02 \x01(\tR\x04etag\".\n" +
 	"\x12DeletePostResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess2\xa0\x02\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"A\n" +
+	"\x12RestorePostRequest\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\tR\x06postId\x12\x12\n" +
+	"\x04etag\x18\x02 \x01(\tR\x04etag\"8\n" +
+	"\x13RestorePostResponse\x12!\n" +
+	"\x04post\x18\x01 \x01(\v2\r.blog.v1.PostR\x04post\"?\n" +
+	"\x10PurgePostRequest\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\tR\x06postId\x12\x12\n" +
+	"\x04etag\x18\x02 \x01(\tR\x04etag\"\x13\n" +
+	"\x11PurgePostResponse\"\xe8\x02\n" +
+	"\x10ListPostsRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x16\n" +
+	"\x06author\x18\x03 \x01(\tR\x06author\x12\x10\n" +
+	"\x03tag\x18\x04 \x01(\tR\x03tag\x12'\n" +
+	"\x0fpublished_after\x18\x05 \x01(\tR\x0epublishedAfter\x12)\n" +
+	"\x10published_before\x18\x06 \x01(\tR\x0fpublishedBefore\x12-\n" +
+	"\border_by\x18\a \x01(\x0e2\x12.blog.v1.PostOrderR\aorderBy\x12+\n" +
+	"\x06status\x18\b \x01(\x0e2\x13.blog.v1.PostStatusR\x06status\x12!\n" +
+	"\fshow_deleted\x18\t \x01(\bR\vshowDeleted\x12\x1b\n" +
+	"\tauthor_id\x18\n" +
+	" \x01(\tR\bauthorId\"`\n" +
+	"\x11ListPostsResponse\x12#\n" +
+	"\x05posts\x18\x01 \x03(\v2\r.blog.v1.PostR\x05posts\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"A\n" +
+	"\x12PublishPostRequest\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\tR\x06postId\x12\x12\n" +
+	"\x04etag\x18\x02 \x01(\tR\x04etag\"8\n" +
+	"\x13PublishPostResponse\x12!\n" +
+	"\x04post\x18\x01 \x01(\v2\r.blog.v1.PostR\x04post\"C\n" +
+	"\x14UnpublishPostRequest\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\tR\x06postId\x12\x12\n" +
+	"\x04etag\x18\x02 \x01(\tR\x04etag\":\n" +
+	"\x15UnpublishPostResponse\x12!\n" +
+	"\x04post\x18\x01 \x01(\v2\r.blog.v1.PostR\x04post\"A\n" +
+	"\x12ArchivePostRequest\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\tR\x06postId\x12\x12\n" +
+	"\x04etag\x18\x02 \x01(\tR\x04etag\"8\n" +
+	"\x13ArchivePostResponse\x12!\n" +
+	"\x04post\x18\x01 \x01(\v2\r.blog.v1.PostR\x04post\"}\n" +
+	"\x13SchedulePostRequest\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\tR\x06postId\x129\n" +
+	"\n" +
+	"publish_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tpublishAt\x12\x12\n" +
+	"\x04etag\x18\x03 \x01(\tR\x04etag\"9\n" +
+	"\x14SchedulePostResponse\x12!\n" +
+	"\x04post\x18\x01 \x01(\v2\r.blog.v1.PostR\x04post\"U\n" +
+	"\x17ListPendingPostsRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\"g\n" +
+	"\x18ListPendingPostsResponse\x12#\n" +
+	"\x05posts\x18\x01 \x03(\v2\r.blog.v1.PostR\x05posts\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"A\n" +
+	"\x12ApprovePostRequest\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\tR\x06postId\x12\x12\n" +
+	"\x04etag\x18\x02 \x01(\tR\x04etag\"8\n" +
+	"\x13ApprovePostResponse\x12!\n" +
+	"\x04post\x18\x01 \x01(\v2\r.blog.v1.PostR\x04post\"X\n" +
+	"\x11RejectPostRequest\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\tR\x06postId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12\x12\n" +
+	"\x04etag\x18\x03 \x01(\tR\x04etag\"7\n" +
+	"\x12RejectPostResponse\x12!\n" +
+	"\x04post\x18\x01 \x01(\v2\r.blog.v1.PostR\x04post\"\xe2\x01\n" +
+	"\fPostRevision\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\tR\x06postId\x12\x1a\n" +
+	"\brevision\x18\x02 \x01(\x03R\brevision\x12!\n" +
+	"\x04post\x18\x03 \x01(\v2\r.blog.v1.PostR\x04post\x12\x16\n" +
+	"\x06editor\x18\x04 \x01(\tR\x06editor\x12;\n" +
+	"\vcreate_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x12%\n" +
+	"\x0echanged_fields\x18\x06 \x03(\tR\rchangedFields\"o\n" +
+	"\x18ListPostRevisionsRequest\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\tR\x06postId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"x\n" +
+	"\x19ListPostRevisionsResponse\x123\n" +
+	"\trevisions\x18\x01 \x03(\v2\x15.blog.v1.PostRevisionR\trevisions\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"M\n" +
+	"\x16GetPostRevisionRequest\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\tR\x06postId\x12\x1a\n" +
+	"\brevision\x18\x02 \x01(\x03R\brevision\"L\n" +
+	"\x17GetPostRevisionResponse\x121\n" +
+	"\brevision\x18\x01 \x01(\v2\x15.blog.v1.PostRevisionR\brevision\"e\n" +
+	"\x1aRestorePostRevisionRequest\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\tR\x06postId\x12\x1a\n" +
+	"\brevision\x18\x02 \x01(\x03R\brevision\x12\x12\n" +
+	"\x04etag\x18\x03 \x01(\tR\x04etag\"@\n" +
+	"\x1bRestorePostRevisionResponse\x12!\n" +
+	"\x04post\x18\x01 \x01(\v2\r.blog.v1.PostR\x04post\"\xcb\x01\n" +
+	"\x18DiffPostRevisionsRequest\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\tR\x06postId\x12#\n" +
+	"\rfrom_revision\x18\x02 \x01(\x03R\ffromRevision\x12\x1f\n" +
+	"\vto_revision\x18\x03 \x01(\x03R\n" +
+	"toRevision\x12+\n" +
+	"\x06format\x18\x04 \x01(\x0e2\x13.blog.v1.DiffFormatR\x06format\x12#\n" +
+	"\rcontext_lines\x18\x05 \x01(\x05R\fcontextLines\"B\n" +
+	"\vDiffSegment\x12\x1f\n" +
+	"\x02op\x18\x01 \x01(\x0e2\x0f.blog.v1.DiffOpR\x02op\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\"k\n" +
+	"\bDiffLine\x12\x1f\n" +
+	"\x02op\x18\x01 \x01(\x0e2\x0f.blog.v1.DiffOpR\x02op\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\x12*\n" +
+	"\x05words\x18\x03 \x03(\v2\x14.blog.v1.DiffSegmentR\x05words\"\xa7\x01\n" +
+	"\bDiffHunk\x12\x1b\n" +
+	"\told_start\x18\x01 \x01(\x05R\boldStart\x12\x1b\n" +
+	"\told_lines\x18\x02 \x01(\x05R\boldLines\x12\x1b\n" +
+	"\tnew_start\x18\x03 \x01(\x05R\bnewStart\x12\x1b\n" +
+	"\tnew_lines\x18\x04 \x01(\x05R\bnewLines\x12'\n" +
+	"\x05lines\x18\x05 \x03(\v2\x11.blog.v1.DiffLineR\x05lines\"[\n" +
+	"\tFieldDiff\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x1b\n" +
+	"\told_value\x18\x02 \x01(\tR\boldValue\x12\x1b\n" +
+	"\tnew_value\x18\x03 \x01(\tR\bnewValue\"\xb4\x02\n" +
+	"\x19DiffPostRevisionsResponse\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\tR\x06postId\x12#\n" +
+	"\rfrom_revision\x18\x02 \x01(\x03R\ffromRevision\x12\x1f\n" +
+	"\vto_revision\x18\x03 \x01(\x03R\n" +
+	"toRevision\x12*\n" +
+	"\x06fields\x18\x04 \x03(\v2\x12.blog.v1.FieldDiffR\x06fields\x12\x1d\n" +
+	"\n" +
+	"added_tags\x18\x05 \x03(\tR\taddedTags\x12!\n" +
+	"\fremoved_tags\x18\x06 \x03(\tR\vremovedTags\x12'\n" +
+	"\x05hunks\x18\a \x03(\v2\x11.blog.v1.DiffHunkR\x05hunks\x12!\n" +
+	"\funified_diff\x18\b \x01(\tR\vunifiedDiff\"\x89\x03\n" +
+	"\aComment\x12\x1d\n" +
+	"\n" +
+	"comment_id\x18\x01 \x01(\tR\tcommentId\x12\x17\n" +
+	"\apost_id\x18\x02 \x01(\tR\x06postId\x12\x1b\n" +
+	"\tparent_id\x18\x03 \x01(\tR\bparentId\x12\x16\n" +
+	"\x06author\x18\x04 \x01(\tR\x06author\x12\x18\n" +
+	"\acontent\x18\x05 \x01(\tR\acontent\x12;\n" +
+	"\vcreate_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x12;\n" +
+	"\vupdate_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updateTime\x12\x18\n" +
+	"\aversion\x18\b \x01(\x03R\aversion\x12\x12\n" +
+	"\x04etag\x18\t \x01(\tR\x04etag\x12\x18\n" +
+	"\adeleted\x18\n" +
+	" \x01(\bR\adeleted\x12\x14\n" +
+	"\x05depth\x18\v \x01(\x05R\x05depth\x12\x1f\n" +
+	"\vreply_count\x18\f \x01(\x05R\n" +
+	"replyCount\"{\n" +
+	"\x11AddCommentRequest\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\tR\x06postId\x12\x1b\n" +
+	"\tparent_id\x18\x02 \x01(\tR\bparentId\x12\x16\n" +
+	"\x06author\x18\x03 \x01(\tR\x06author\x12\x18\n" +
+	"\acontent\x18\x04 \x01(\tR\acontent\"@\n" +
+	"\x12AddCommentResponse\x12*\n" +
+	"\acomment\x18\x01 \x01(\v2\x10.blog.v1.CommentR\acomment\"\x87\x01\n" +
+	"\x13ListCommentsRequest\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\tR\x06postId\x12\x1b\n" +
+	"\tparent_id\x18\x02 \x01(\tR\bparentId\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\"l\n" +
+	"\x14ListCommentsResponse\x12,\n" +
+	"\bcomments\x18\x01 \x03(\v2\x10.blog.v1.CommentR\bcomments\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"a\n" +
+	"\x12EditCommentRequest\x12\x1d\n" +
+	"\n" +
+	"comment_id\x18\x01 \x01(\tR\tcommentId\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\x12\x12\n" +
+	"\x04etag\x18\x03 \x01(\tR\x04etag\"A\n" +
+	"\x13EditCommentResponse\x12*\n" +
+	"\acomment\x18\x01 \x01(\v2\x10.blog.v1.CommentR\acomment\"I\n" +
+	"\x14DeleteCommentRequest\x12\x1d\n" +
+	"\n" +
+	"comment_id\x18\x01 \x01(\tR\tcommentId\x12\x12\n" +
+	"\x04etag\x18\x02 \x01(\tR\x04etag\"\x17\n" +
+	"\x15DeleteCommentResponse\"\x92\x02\n" +
+	"\x06Author\x12\x1b\n" +
+	"\tauthor_id\x18\x01 \x01(\tR\bauthorId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x10\n" +
+	"\x03bio\x18\x03 \x01(\tR\x03bio\x12\x1d\n" +
+	"\n" +
+	"avatar_url\x18\x04 \x01(\tR\tavatarUrl\x12;\n" +
+	"\vcreate_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x12;\n" +
+	"\vupdate_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updateTime\x12\x18\n" +
+	"\aversion\x18\a \x01(\x03R\aversion\x12\x12\n" +
+	"\x04etag\x18\b \x01(\tR\x04etag\"Z\n" +
+	"\x13CreateAuthorRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x10\n" +
+	"\x03bio\x18\x02 \x01(\tR\x03bio\x12\x1d\n" +
+	"\n" +
+	"avatar_url\x18\x03 \x01(\tR\tavatarUrl\"?\n" +
+	"\x14CreateAuthorResponse\x12'\n" +
+	"\x06author\x18\x01 \x01(\v2\x0f.blog.v1.AuthorR\x06author\"/\n" +
+	"\x10GetAuthorRequest\x12\x1b\n" +
+	"\tauthor_id\x18\x01 \x01(\tR\bauthorId\"<\n" +
+	"\x11GetAuthorResponse\x12'\n" +
+	"\x06author\x18\x01 \x01(\v2\x0f.blog.v1.AuthorR\x06author\"\xc8\x01\n" +
+	"\x13UpdateAuthorRequest\x12\x1b\n" +
+	"\tauthor_id\x18\x01 \x01(\tR\bauthorId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x10\n" +
+	"\x03bio\x18\x03 \x01(\tR\x03bio\x12\x1d\n" +
+	"\n" +
+	"avatar_url\x18\x04 \x01(\tR\tavatarUrl\x12;\n" +
+	"\vupdate_mask\x18\x05 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\x12\x12\n" +
+	"\x04etag\x18\x06 \x01(\tR\x04etag\"?\n" +
+	"\x14UpdateAuthorResponse\x12'\n" +
+	"\x06author\x18\x01 \x01(\v2\x0f.blog.v1.AuthorR\x06author\"F\n" +
+	"\x13DeleteAuthorRequest\x12\x1b\n" +
+	"\tauthor_id\x18\x01 \x01(\tR\bauthorId\x12\x12\n" +
+	"\x04etag\x18\x02 \x01(\tR\x04etag\"\x16\n" +
+	"\x14DeleteAuthorResponse\"P\n" +
+	"\x12ListAuthorsRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\"h\n" +
+	"\x13ListAuthorsResponse\x12)\n" +
+	"\aauthors\x18\x01 \x03(\v2\x0f.blog.v1.AuthorR\aauthors\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\";\n" +
+	"\bTagCount\x12\x10\n" +
+	"\x03tag\x18\x01 \x01(\tR\x03tag\x12\x1d\n" +
+	"\n" +
+	"post_count\x18\x02 \x01(\x05R\tpostCount\"{\n" +
+	"\x0fListTagsRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12,\n" +
+	"\border_by\x18\x03 \x01(\x0e2\x11.blog.v1.TagOrderR\aorderBy\"a\n" +
+	"\x10ListTagsResponse\x12%\n" +
+	"\x04tags\x18\x01 \x03(\v2\x11.blog.v1.TagCountR\x04tags\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"6\n" +
+	"\x10RenameTagRequest\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\tR\x02to\"8\n" +
+	"\x11RenameTagResponse\x12#\n" +
+	"\rposts_changed\x18\x01 \x01(\x05R\fpostsChanged\"D\n" +
+	"\x10MergeTagsRequest\x12\x18\n" +
+	"\asources\x18\x01 \x03(\tR\asources\x12\x16\n" +
+	"\x06target\x18\x02 \x01(\tR\x06target\"8\n" +
+	"\x11MergeTagsResponse\x12#\n" +
+	"\rposts_changed\x18\x01 \x01(\x05R\fpostsChanged\"`\n" +
+	"\x11WatchPostsRequest\x12\x16\n" +
+	"\x06author\x18\x01 \x01(\tR\x06author\x12\x10\n" +
+	"\x03tag\x18\x02 \x01(\tR\x03tag\x12!\n" +
+	"\fresume_token\x18\x03 \x01(\tR\vresumeToken\"\xba\x01\n" +
+	"\tPostEvent\x12*\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x16.blog.v1.PostEventTypeR\x04type\x12!\n" +
+	"\x04post\x18\x02 \x01(\v2\r.blog.v1.PostR\x04post\x12!\n" +
+	"\fresume_token\x18\x03 \x01(\tR\vresumeToken\x12;\n" +
+	"\voccurred_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\"f\n" +
+	"\x12SearchPostsRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"a\n" +
+	"\fSearchResult\x12!\n" +
+	"\x04post\x18\x01 \x01(\v2\r.blog.v1.PostR\x04post\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x01R\x05score\x12\x18\n" +
+	"\asnippet\x18\x03 \x01(\tR\asnippet\"n\n" +
+	"\x13SearchPostsResponse\x12/\n" +
+	"\aresults\x18\x01 \x03(\v2\x15.blog.v1.SearchResultR\aresults\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xda\x02\n" +
+	"\x06ApiKey\x12\x15\n" +
+	"\x06key_id\x18\x01 \x01(\tR\x05keyId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05owner\x18\x03 \x01(\tR\x05owner\x12\x16\n" +
+	"\x06scopes\x18\x04 \x03(\tR\x06scopes\x12;\n" +
+	"\vcreate_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x12;\n" +
+	"\vexpire_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"expireTime\x12@\n" +
+	"\x0elast_used_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\flastUsedTime\x12;\n" +
+	"\vrevoke_time\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"revokeTime\"\x83\x01\n" +
+	"\x12IssueApiKeyRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05owner\x18\x02 \x01(\tR\x05owner\x12\x16\n" +
+	"\x06scopes\x18\x03 \x03(\tR\x06scopes\x12+\n" +
+	"\x03ttl\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\x03ttl\"]\n" +
+	"\x13IssueApiKeyResponse\x12(\n" +
+	"\aapi_key\x18\x01 \x01(\v2\x0f.blog.v1.ApiKeyR\x06apiKey\x12\x1c\n" +
+	"\araw_key\x18\x02 \x01(\tB\x03\x80\x01\x01R\x06rawKey\"P\n" +
+	"\x12ListApiKeysRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\"i\n" +
+	"\x13ListApiKeysResponse\x12*\n" +
+	"\bapi_keys\x18\x01 \x03(\v2\x0f.blog.v1.ApiKeyR\aapiKeys\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\",\n" +
+	"\x13RevokeApiKeyRequest\x12\x15\n" +
+	"\x06key_id\x18\x01 \x01(\tR\x05keyId\"@\n" +
+	"\x14RevokeApiKeyResponse\x12(\n" +
+	"\aapi_key\x18\x01 \x01(\v2\x0f.blog.v1.ApiKeyR\x06apiKey*\xab\x01\n" +
+	"\n" +
+	"PostStatus\x12\x1b\n" +
+	"\x17POST_STATUS_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11POST_STATUS_DRAFT\x10\x01\x12\x19\n" +
+	"\x15POST_STATUS_IN_REVIEW\x10\x02\x12\x19\n" +
+	"\x15POST_STATUS_SCHEDULED\x10\x03\x12\x19\n" +
+	"\x15POST_STATUS_PUBLISHED\x10\x04\x12\x18\n" +
+	"\x14POST_STATUS_ARCHIVED\x10\x05*\x8f\x01\n" +
+	"\x0fModerationState\x12 \n" +
+	"\x1cMODERATION_STATE_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19MODERATION_STATE_APPROVED\x10\x01\x12\x1c\n" +
+	"\x18MODERATION_STATE_PENDING\x10\x02\x12\x1d\n" +
+	"\x19MODERATION_STATE_REJECTED\x10\x03*\xd6\x01\n" +
+	"\tPostOrder\x12\x1a\n" +
+	"\x16POST_ORDER_UNSPECIFIED\x10\x00\x12$\n" +
+	" POST_ORDER_PUBLICATION_DATE_DESC\x10\x01\x12#\n" +
+	"\x1fPOST_ORDER_PUBLICATION_DATE_ASC\x10\x02\x12\x18\n" +
+	"\x14POST_ORDER_TITLE_ASC\x10\x03\x12\x19\n" +
+	"\x15POST_ORDER_TITLE_DESC\x10\x04\x12\x15\n" +
+	"\x11POST_ORDER_ID_ASC\x10\x05\x12\x16\n" +
+	"\x12POST_ORDER_ID_DESC\x10\x06*Y\n" +
+	"\n" +
+	"DiffFormat\x12\x1b\n" +
+	"\x17DIFF_FORMAT_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11DIFF_FORMAT_HUNKS\x10\x01\x12\x17\n" +
+	"\x13DIFF_FORMAT_UNIFIED\x10\x02*\\\n" +
+	"\x06DiffOp\x12\x17\n" +
+	"\x13DIFF_OP_UNSPECIFIED\x10\x00\x12\x11\n" +
+	"\rDIFF_OP_EQUAL\x10\x01\x12\x12\n" +
+	"\x0eDIFF_OP_INSERT\x10\x02\x12\x12\n" +
+	"\x0eDIFF_OP_DELETE\x10\x03*N\n" +
+	"\bTagOrder\x12\x19\n" +
+	"\x15TAG_ORDER_UNSPECIFIED\x10\x00\x12\x13\n" +
+	"\x0fTAG_ORDER_COUNT\x10\x01\x12\x12\n" +
+	"\x0eTAG_ORDER_NAME\x10\x02*\x87\x01\n" +
+	"\rPostEventType\x12\x1f\n" +
+	"\x1bPOST_EVENT_TYPE_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17POST_EVENT_TYPE_CREATED\x10\x01\x12\x1b\n" +
+	"\x17POST_EVENT_TYPE_UPDATED\x10\x02\x12\x1b\n" +
+	"\x17POST_EVENT_TYPE_DELETED\x10\x032\xa4\x15\n" +
 	"\vBlogService\x12E\n" +
 	"\n" +
 	"CreatePost\x12\x1a.blog.v1.CreatePostRequest\x1a\x1b.blog.v1.CreatePostResponse\x12<\n" +
-	"\aGetPost\x12\x17.blog.v1.GetPostRequest\x1a\x18.blog.v1.GetPostResponse\x12E\n" +
+	"\aGetPost\x12\x17.blog.v1.GetPostRequest\x1a\x18.blog.v1.GetPostResponse\x12N\n" +
+	"\rGetPostBySlug\x12\x1d.blog.v1.GetPostBySlugRequest\x1a\x1e.blog.v1.GetPostBySlugResponse\x12E\n" +
 	"\n" +
 	"UpdatePost\x12\x1a.blog.v1.UpdatePostRequest\x1a\x1b.blog.v1.UpdatePostResponse\x12E\n" +
 	"\n" +
-	"DeletePost\x12\x1a.blog.v1.DeletePostRequest\x1a\x1b.blog.v1.DeletePostResponseB=Z;github.com/BhaveetKumar/gRPC-server-go/proto/blog/v1;blogv1b\x06proto3"
+	"DeletePost\x12\x1a.blog.v1.DeletePostRequest\x1a\x1b.blog.v1.DeletePostResponse\x12H\n" +
+	"\vRestorePost\x12\x1b.blog.v1.RestorePostRequest\x1a\x1c.blog.v1.RestorePostResponse\x12B\n" +
+	"\tPurgePost\x12\x19.blog.v1.PurgePostRequest\x1a\x1a.blog.v1.PurgePostResponse\x12B\n" +
+	"\tListPosts\x12\x19.blog.v1.ListPostsRequest\x1a\x1a.blog.v1.ListPostsResponse\x12>\n" +
+	"\n" +
+	"WatchPosts\x12\x1a.blog.v1.WatchPostsRequest\x1a\x12.blog.v1.PostEvent0\x01\x12H\n" +
+	"\vSearchPosts\x12\x1b.blog.v1.SearchPostsRequest\x1a\x1c.blog.v1.SearchPostsResponse\x12H\n" +
+	"\vPublishPost\x12\x1b.blog.v1.PublishPostRequest\x1a\x1c.blog.v1.PublishPostResponse\x12N\n" +
+	"\rUnpublishPost\x12\x1d.blog.v1.UnpublishPostRequest\x1a\x1e.blog.v1.UnpublishPostResponse\x12H\n" +
+	"\vArchivePost\x12\x1b.blog.v1.ArchivePostRequest\x1a\x1c.blog.v1.ArchivePostResponse\x12K\n" +
+	"\fSchedulePost\x12\x1c.blog.v1.SchedulePostRequest\x1a\x1d.blog.v1.SchedulePostResponse\x12W\n" +
+	"\x10ListPendingPosts\x12 .blog.v1.ListPendingPostsRequest\x1a!.blog.v1.ListPendingPostsResponse\x12H\n" +
+	"\vApprovePost\x12\x1b.blog.v1.ApprovePostRequest\x1a\x1c.blog.v1.ApprovePostResponse\x12E\n" +
+	"\n" +
+	"RejectPost\x12\x1a.blog.v1.RejectPostRequest\x1a\x1b.blog.v1.RejectPostResponse\x12Z\n" +
+	"\x11ListPostRevisions\x12!.blog.v1.ListPostRevisionsRequest\x1a\".blog.v1.ListPostRevisionsResponse\x12T\n" +
+	"\x0fGetPostRevision\x12\x1f.blog.v1.GetPostRevisionRequest\x1a .blog.v1.GetPostRevisionResponse\x12`\n" +
+	"\x13RestorePostRevision\x12#.blog.v1.RestorePostRevisionRequest\x1a$.blog.v1.RestorePostRevisionResponse\x12Z\n" +
+	"\x11DiffPostRevisions\x12!.blog.v1.DiffPostRevisionsRequest\x1a\".blog.v1.DiffPostRevisionsResponse\x12E\n" +
+	"\n" +
+	"AddComment\x12\x1a.blog.v1.AddCommentRequest\x1a\x1b.blog.v1.AddCommentResponse\x12K\n" +
+	"\fListComments\x12\x1c.blog.v1.ListCommentsRequest\x1a\x1d.blog.v1.ListCommentsResponse\x12H\n" +
+	"\vEditComment\x12\x1b.blog.v1.EditCommentRequest\x1a\x1c.blog.v1.EditCommentResponse\x12N\n" +
+	"\rDeleteComment\x12\x1d.blog.v1.DeleteCommentRequest\x1a\x1e.blog.v1.DeleteCommentResponse\x12K\n" +
+	"\fCreateAuthor\x12\x1c.blog.v1.CreateAuthorRequest\x1a\x1d.blog.v1.CreateAuthorResponse\x12B\n" +
+	"\tGetAuthor\x12\x19.blog.v1.GetAuthorRequest\x1a\x1a.blog.v1.GetAuthorResponse\x12K\n" +
+	"\fUpdateAuthor\x12\x1c.blog.v1.UpdateAuthorRequest\x1a\x1d.blog.v1.UpdateAuthorResponse\x12K\n" +
+	"\fDeleteAuthor\x12\x1c.blog.v1.DeleteAuthorRequest\x1a\x1d.blog.v1.DeleteAuthorResponse\x12H\n" +
+	"\vListAuthors\x12\x1b.blog.v1.ListAuthorsRequest\x1a\x1c.blog.v1.ListAuthorsResponse\x12?\n" +
+	"\bListTags\x12\x18.blog.v1.ListTagsRequest\x1a\x19.blog.v1.ListTagsResponse\x12B\n" +
+	"\tRenameTag\x12\x19.blog.v1.RenameTagRequest\x1a\x1a.blog.v1.RenameTagResponse\x12B\n" +
+	"\tMergeTags\x12\x19.blog.v1.MergeTagsRequest\x1a\x1a.blog.v1.MergeTagsResponse\x12H\n" +
+	"\vIssueApiKey\x12\x1b.blog.v1.IssueApiKeyRequest\x1a\x1c.blog.v1.IssueApiKeyResponse\x12H\n" +
+	"\vListApiKeys\x12\x1b.blog.v1.ListApiKeysRequest\x1a\x1c.blog.v1.ListApiKeysResponse\x12K\n" +
+	"\fRevokeApiKey\x12\x1c.blog.v1.RevokeApiKeyRequest\x1a\x1d.blog.v1.RevokeApiKeyResponseB=Z;github.com/BhaveetKumar/gRPC-server-go/proto/blog/v1;blogv1b\x06proto3"
 
 var (
 	file_proto_blog_v1_blog_proto_rawDescOnce sync.Once
@@ -578,35 +5761,242 @@ func file_proto_blog_v1_blog_proto_rawDescGZIP() []byte {
 	return file_proto_blog_v1_blog_proto_rawDescData
 }
 
-var file_proto_blog_v1_blog_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_proto_blog_v1_blog_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_proto_blog_v1_blog_proto_msgTypes = make([]protoimpl.MessageInfo, 83)
 var file_proto_blog_v1_blog_proto_goTypes = []any{
-	(*Post)(nil),               // 0: blog.v1.Post
-	(*CreatePostRequest)(nil),  // 1: blog.v1.CreatePostRequest
-	(*CreatePostResponse)(nil), // 2: blog.v1.CreatePostResponse
-	(*GetPostRequest)(nil),     // 3: blog.v1.GetPostRequest
-	(*GetPostResponse)(nil),    // 4: blog.v1.GetPostResponse
-	(*UpdatePostRequest)(nil),  // 5: blog.v1.UpdatePostRequest
-	(*UpdatePostResponse)(nil), // 6: blog.v1.UpdatePostResponse
-	(*DeletePostRequest)(nil),  // 7: blog.v1.DeletePostRequest
-	(*DeletePostResponse)(nil), // 8: blog.v1.DeletePostResponse
+	(PostStatus)(0),                     // 0: blog.v1.PostStatus
+	(ModerationState)(0),                // 1: blog.v1.ModerationState
+	(PostOrder)(0),                      // 2: blog.v1.PostOrder
+	(DiffFormat)(0),                     // 3: blog.v1.DiffFormat
+	(DiffOp)(0),                         // 4: blog.v1.DiffOp
+	(TagOrder)(0),                       // 5: blog.v1.TagOrder
+	(PostEventType)(0),                  // 6: blog.v1.PostEventType
+	(*Post)(nil),                        // 7: blog.v1.Post
+	(*CreatePostRequest)(nil),           // 8: blog.v1.CreatePostRequest
+	(*CreatePostResponse)(nil),          // 9: blog.v1.CreatePostResponse
+	(*GetPostRequest)(nil),              // 10: blog.v1.GetPostRequest
+	(*GetPostResponse)(nil),             // 11: blog.v1.GetPostResponse
+	(*GetPostBySlugRequest)(nil),        // 12: blog.v1.GetPostBySlugRequest
+	(*GetPostBySlugResponse)(nil),       // 13: blog.v1.GetPostBySlugResponse
+	(*UpdatePostRequest)(nil),           // 14: blog.v1.UpdatePostRequest
+	(*UpdatePostResponse)(nil),          // 15: blog.v1.UpdatePostResponse
+	(*DeletePostRequest)(nil),           // 16: blog.v1.DeletePostRequest
+	(*DeletePostResponse)(nil),          // 17: blog.v1.DeletePostResponse
+	(*RestorePostRequest)(nil),          // 18: blog.v1.RestorePostRequest
+	(*RestorePostResponse)(nil),         // 19: blog.v1.RestorePostResponse
+	(*PurgePostRequest)(nil),            // 20: blog.v1.PurgePostRequest
+	(*PurgePostResponse)(nil),           // 21: blog.v1.PurgePostResponse
+	(*ListPostsRequest)(nil),            // 22: blog.v1.ListPostsRequest
+	(*ListPostsResponse)(nil),           // 23: blog.v1.ListPostsResponse
+	(*PublishPostRequest)(nil),          // 24: blog.v1.PublishPostRequest
+	(*PublishPostResponse)(nil),         // 25: blog.v1.PublishPostResponse
+	(*UnpublishPostRequest)(nil),        // 26: blog.v1.UnpublishPostRequest
+	(*UnpublishPostResponse)(nil),       // 27: blog.v1.UnpublishPostResponse
+	(*ArchivePostRequest)(nil),          // 28: blog.v1.ArchivePostRequest
+	(*ArchivePostResponse)(nil),         // 29: blog.v1.ArchivePostResponse
+	(*SchedulePostRequest)(nil),         // 30: blog.v1.SchedulePostRequest
+	(*SchedulePostResponse)(nil),        // 31: blog.v1.SchedulePostResponse
+	(*ListPendingPostsRequest)(nil),     // 32: blog.v1.ListPendingPostsRequest
+	(*ListPendingPostsResponse)(nil),    // 33: blog.v1.ListPendingPostsResponse
+	(*ApprovePostRequest)(nil),          // 34: blog.v1.ApprovePostRequest
+	(*ApprovePostResponse)(nil),         // 35: blog.v1.ApprovePostResponse
+	(*RejectPostRequest)(nil),           // 36: blog.v1.RejectPostRequest
+	(*RejectPostResponse)(nil),          // 37: blog.v1.RejectPostResponse
+	(*PostRevision)(nil),                // 38: blog.v1.PostRevision
+	(*ListPostRevisionsRequest)(nil),    // 39: blog.v1.ListPostRevisionsRequest
+	(*ListPostRevisionsResponse)(nil),   // 40: blog.v1.ListPostRevisionsResponse
+	(*GetPostRevisionRequest)(nil),      // 41: blog.v1.GetPostRevisionRequest
+	(*GetPostRevisionResponse)(nil),     // 42: blog.v1.GetPostRevisionResponse
+	(*RestorePostRevisionRequest)(nil),  // 43: blog.v1.RestorePostRevisionRequest
+	(*RestorePostRevisionResponse)(nil), // 44: blog.v1.RestorePostRevisionResponse
+	(*DiffPostRevisionsRequest)(nil),    // 45: blog.v1.DiffPostRevisionsRequest
+	(*DiffSegment)(nil),                 // 46: blog.v1.DiffSegment
+	(*DiffLine)(nil),                    // 47: blog.v1.DiffLine
+	(*DiffHunk)(nil),                    // 48: blog.v1.DiffHunk
+	(*FieldDiff)(nil),                   // 49: blog.v1.FieldDiff
+	(*DiffPostRevisionsResponse)(nil),   // 50: blog.v1.DiffPostRevisionsResponse
+	(*Comment)(nil),                     // 51: blog.v1.Comment
+	(*AddCommentRequest)(nil),           // 52: blog.v1.AddCommentRequest
+	(*AddCommentResponse)(nil),          // 53: blog.v1.AddCommentResponse
+	(*ListCommentsRequest)(nil),         // 54: blog.v1.ListCommentsRequest
+	(*ListCommentsResponse)(nil),        // 55: blog.v1.ListCommentsResponse
+	(*EditCommentRequest)(nil),          // 56: blog.v1.EditCommentRequest
+	(*EditCommentResponse)(nil),         // 57: blog.v1.EditCommentResponse
+	(*DeleteCommentRequest)(nil),        // 58: blog.v1.DeleteCommentRequest
+	(*DeleteCommentResponse)(nil),       // 59: blog.v1.DeleteCommentResponse
+	(*Author)(nil),                      // 60: blog.v1.Author
+	(*CreateAuthorRequest)(nil),         // 61: blog.v1.CreateAuthorRequest
+	(*CreateAuthorResponse)(nil),        // 62: blog.v1.CreateAuthorResponse
+	(*GetAuthorRequest)(nil),            // 63: blog.v1.GetAuthorRequest
+	(*GetAuthorResponse)(nil),           // 64: blog.v1.GetAuthorResponse
+	(*UpdateAuthorRequest)(nil),         // 65: blog.v1.UpdateAuthorRequest
+	(*UpdateAuthorResponse)(nil),        // 66: blog.v1.UpdateAuthorResponse
+	(*DeleteAuthorRequest)(nil),         // 67: blog.v1.DeleteAuthorRequest
+	(*DeleteAuthorResponse)(nil),        // 68: blog.v1.DeleteAuthorResponse
+	(*ListAuthorsRequest)(nil),          // 69: blog.v1.ListAuthorsRequest
+	(*ListAuthorsResponse)(nil),         // 70: blog.v1.ListAuthorsResponse
+	(*TagCount)(nil),                    // 71: blog.v1.TagCount
+	(*ListTagsRequest)(nil),             // 72: blog.v1.ListTagsRequest
+	(*ListTagsResponse)(nil),            // 73: blog.v1.ListTagsResponse
+	(*RenameTagRequest)(nil),            // 74: blog.v1.RenameTagRequest
+	(*RenameTagResponse)(nil),           // 75: blog.v1.RenameTagResponse
+	(*MergeTagsRequest)(nil),            // 76: blog.v1.MergeTagsRequest
+	(*MergeTagsResponse)(nil),           // 77: blog.v1.MergeTagsResponse
+	(*WatchPostsRequest)(nil),           // 78: blog.v1.WatchPostsRequest
+	(*PostEvent)(nil),                   // 79: blog.v1.PostEvent
+	(*SearchPostsRequest)(nil),          // 80: blog.v1.SearchPostsRequest
+	(*SearchResult)(nil),                // 81: blog.v1.SearchResult
+	(*SearchPostsResponse)(nil),         // 82: blog.v1.SearchPostsResponse
+	(*ApiKey)(nil),                      // 83: blog.v1.ApiKey
+	(*IssueApiKeyRequest)(nil),          // 84: blog.v1.IssueApiKeyRequest
+	(*IssueApiKeyResponse)(nil),         // 85: blog.v1.IssueApiKeyResponse
+	(*ListApiKeysRequest)(nil),          // 86: blog.v1.ListApiKeysRequest
+	(*ListApiKeysResponse)(nil),         // 87: blog.v1.ListApiKeysResponse
+	(*RevokeApiKeyRequest)(nil),         // 88: blog.v1.RevokeApiKeyRequest
+	(*RevokeApiKeyResponse)(nil),        // 89: blog.v1.RevokeApiKeyResponse
+	(*timestamppb.Timestamp)(nil),       // 90: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),       // 91: google.protobuf.FieldMask
+	(*durationpb.Duration)(nil),         // 92: google.protobuf.Duration
 }
 var file_proto_blog_v1_blog_proto_depIdxs = []int32{
-	0, // 0: blog.v1.CreatePostResponse.post:type_name -> blog.v1.Post
-	0, // 1: blog.v1.GetPostResponse.post:type_name -> blog.v1.Post
-	0, // 2: blog.v1.UpdatePostResponse.post:type_name -> blog.v1.Post
-	1, // 3: blog.v1.BlogService.CreatePost:input_type -> blog.v1.CreatePostRequest
-	3, // 4: blog.v1.BlogService.GetPost:input_type -> blog.v1.GetPostRequest
-	5, // 5: blog.v1.BlogService.UpdatePost:input_type -> blog.v1.UpdatePostRequest
-	7, // 6: blog.v1.BlogService.DeletePost:input_type -> blog.v1.DeletePostRequest
-	2, // 7: blog.v1.BlogService.CreatePost:output_type -> blog.v1.CreatePostResponse
-	4, // 8: blog.v1.BlogService.GetPost:output_type -> blog.v1.GetPostResponse
-	6, // 9: blog.v1.BlogService.UpdatePost:output_type -> blog.v1.UpdatePostResponse
-	8, // 10: blog.v1.BlogService.DeletePost:output_type -> blog.v1.DeletePostResponse
-	7, // [7:11] is the sub-list for method output_type
-	3, // [3:7] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	0,  // 0: blog.v1.Post.status:type_name -> blog.v1.PostStatus
+	90, // 1: blog.v1.Post.publish_time:type_name -> google.protobuf.Timestamp
+	90, // 2: blog.v1.Post.delete_time:type_name -> google.protobuf.Timestamp
+	1,  // 3: blog.v1.Post.moderation_state:type_name -> blog.v1.ModerationState
+	7,  // 4: blog.v1.CreatePostResponse.post:type_name -> blog.v1.Post
+	7,  // 5: blog.v1.GetPostResponse.post:type_name -> blog.v1.Post
+	7,  // 6: blog.v1.GetPostBySlugResponse.post:type_name -> blog.v1.Post
+	91, // 7: blog.v1.UpdatePostRequest.update_mask:type_name -> google.protobuf.FieldMask
+	0,  // 8: blog.v1.UpdatePostRequest.status:type_name -> blog.v1.PostStatus
+	7,  // 9: blog.v1.UpdatePostResponse.post:type_name -> blog.v1.Post
+	7,  // 10: blog.v1.RestorePostResponse.post:type_name -> blog.v1.Post
+	2,  // 11: blog.v1.ListPostsRequest.order_by:type_name -> blog.v1.PostOrder
+	0,  // 12: blog.v1.ListPostsRequest.status:type_name -> blog.v1.PostStatus
+	7,  // 13: blog.v1.ListPostsResponse.posts:type_name -> blog.v1.Post
+	7,  // 14: blog.v1.PublishPostResponse.post:type_name -> blog.v1.Post
+	7,  // 15: blog.v1.UnpublishPostResponse.post:type_name -> blog.v1.Post
+	7,  // 16: blog.v1.ArchivePostResponse.post:type_name -> blog.v1.Post
+	90, // 17: blog.v1.SchedulePostRequest.publish_at:type_name -> google.protobuf.Timestamp
+	7,  // 18: blog.v1.SchedulePostResponse.post:type_name -> blog.v1.Post
+	7,  // 19: blog.v1.ListPendingPostsResponse.posts:type_name -> blog.v1.Post
+	7,  // 20: blog.v1.ApprovePostResponse.post:type_name -> blog.v1.Post
+	7,  // 21: blog.v1.RejectPostResponse.post:type_name -> blog.v1.Post
+	7,  // 22: blog.v1.PostRevision.post:type_name -> blog.v1.Post
+	90, // 23: blog.v1.PostRevision.create_time:type_name -> google.protobuf.Timestamp
+	38, // 24: blog.v1.ListPostRevisionsResponse.revisions:type_name -> blog.v1.PostRevision
+	38, // 25: blog.v1.GetPostRevisionResponse.revision:type_name -> blog.v1.PostRevision
+	7,  // 26: blog.v1.RestorePostRevisionResponse.post:type_name -> blog.v1.Post
+	3,  // 27: blog.v1.DiffPostRevisionsRequest.format:type_name -> blog.v1.DiffFormat
+	4,  // 28: blog.v1.DiffSegment.op:type_name -> blog.v1.DiffOp
+	4,  // 29: blog.v1.DiffLine.op:type_name -> blog.v1.DiffOp
+	46, // 30: blog.v1.DiffLine.words:type_name -> blog.v1.DiffSegment
+	47, // 31: blog.v1.DiffHunk.lines:type_name -> blog.v1.DiffLine
+	49, // 32: blog.v1.DiffPostRevisionsResponse.fields:type_name -> blog.v1.FieldDiff
+	48, // 33: blog.v1.DiffPostRevisionsResponse.hunks:type_name -> blog.v1.DiffHunk
+	90, // 34: blog.v1.Comment.create_time:type_name -> google.protobuf.Timestamp
+	90, // 35: blog.v1.Comment.update_time:type_name -> google.protobuf.Timestamp
+	51, // 36: blog.v1.AddCommentResponse.comment:type_name -> blog.v1.Comment
+	51, // 37: blog.v1.ListCommentsResponse.comments:type_name -> blog.v1.Comment
+	51, // 38: blog.v1.EditCommentResponse.comment:type_name -> blog.v1.Comment
+	90, // 39: blog.v1.Author.create_time:type_name -> google.protobuf.Timestamp
+	90, // 40: blog.v1.Author.update_time:type_name -> google.protobuf.Timestamp
+	60, // 41: blog.v1.CreateAuthorResponse.author:type_name -> blog.v1.Author
+	60, // 42: blog.v1.GetAuthorResponse.author:type_name -> blog.v1.Author
+	91, // 43: blog.v1.UpdateAuthorRequest.update_mask:type_name -> google.protobuf.FieldMask
+	60, // 44: blog.v1.UpdateAuthorResponse.author:type_name -> blog.v1.Author
+	60, // 45: blog.v1.ListAuthorsResponse.authors:type_name -> blog.v1.Author
+	5,  // 46: blog.v1.ListTagsRequest.order_by:type_name -> blog.v1.TagOrder
+	71, // 47: blog.v1.ListTagsResponse.tags:type_name -> blog.v1.TagCount
+	6,  // 48: blog.v1.PostEvent.type:type_name -> blog.v1.PostEventType
+	7,  // 49: blog.v1.PostEvent.post:type_name -> blog.v1.Post
+	90, // 50: blog.v1.PostEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	7,  // 51: blog.v1.SearchResult.post:type_name -> blog.v1.Post
+	81, // 52: blog.v1.SearchPostsResponse.results:type_name -> blog.v1.SearchResult
+	90, // 53: blog.v1.ApiKey.create_time:type_name -> google.protobuf.Timestamp
+	90, // 54: blog.v1.ApiKey.expire_time:type_name -> google.protobuf.Timestamp
+	90, // 55: blog.v1.ApiKey.last_used_time:type_name -> google.protobuf.Timestamp
+	90, // 56: blog.v1.ApiKey.revoke_time:type_name -> google.protobuf.Timestamp
+	92, // 57: blog.v1.IssueApiKeyRequest.ttl:type_name -> google.protobuf.Duration
+	83, // 58: blog.v1.IssueApiKeyResponse.api_key:type_name -> blog.v1.ApiKey
+	83, // 59: blog.v1.ListApiKeysResponse.api_keys:type_name -> blog.v1.ApiKey
+	83, // 60: blog.v1.RevokeApiKeyResponse.api_key:type_name -> blog.v1.ApiKey
+	8,  // 61: blog.v1.BlogService.CreatePost:input_type -> blog.v1.CreatePostRequest
+	10, // 62: blog.v1.BlogService.GetPost:input_type -> blog.v1.GetPostRequest
+	12, // 63: blog.v1.BlogService.GetPostBySlug:input_type -> blog.v1.GetPostBySlugRequest
+	14, // 64: blog.v1.BlogService.UpdatePost:input_type -> blog.v1.UpdatePostRequest
+	16, // 65: blog.v1.BlogService.DeletePost:input_type -> blog.v1.DeletePostRequest
+	18, // 66: blog.v1.BlogService.RestorePost:input_type -> blog.v1.RestorePostRequest
+	20, // 67: blog.v1.BlogService.PurgePost:input_type -> blog.v1.PurgePostRequest
+	22, // 68: blog.v1.BlogService.ListPosts:input_type -> blog.v1.ListPostsRequest
+	78, // 69: blog.v1.BlogService.WatchPosts:input_type -> blog.v1.WatchPostsRequest
+	80, // 70: blog.v1.BlogService.SearchPosts:input_type -> blog.v1.SearchPostsRequest
+	24, // 71: blog.v1.BlogService.PublishPost:input_type -> blog.v1.PublishPostRequest
+	26, // 72: blog.v1.BlogService.UnpublishPost:input_type -> blog.v1.UnpublishPostRequest
+	28, // 73: blog.v1.BlogService.ArchivePost:input_type -> blog.v1.ArchivePostRequest
+	30, // 74: blog.v1.BlogService.SchedulePost:input_type -> blog.v1.SchedulePostRequest
+	32, // 75: blog.v1.BlogService.ListPendingPosts:input_type -> blog.v1.ListPendingPostsRequest
+	34, // 76: blog.v1.BlogService.ApprovePost:input_type -> blog.v1.ApprovePostRequest
+	36, // 77: blog.v1.BlogService.RejectPost:input_type -> blog.v1.RejectPostRequest
+	39, // 78: blog.v1.BlogService.ListPostRevisions:input_type -> blog.v1.ListPostRevisionsRequest
+	41, // 79: blog.v1.BlogService.GetPostRevision:input_type -> blog.v1.GetPostRevisionRequest
+	43, // 80: blog.v1.BlogService.RestorePostRevision:input_type -> blog.v1.RestorePostRevisionRequest
+	45, // 81: blog.v1.BlogService.DiffPostRevisions:input_type -> blog.v1.DiffPostRevisionsRequest
+	52, // 82: blog.v1.BlogService.AddComment:input_type -> blog.v1.AddCommentRequest
+	54, // 83: blog.v1.BlogService.ListComments:input_type -> blog.v1.ListCommentsRequest
+	56, // 84: blog.v1.BlogService.EditComment:input_type -> blog.v1.EditCommentRequest
+	58, // 85: blog.v1.BlogService.DeleteComment:input_type -> blog.v1.DeleteCommentRequest
+	61, // 86: blog.v1.BlogService.CreateAuthor:input_type -> blog.v1.CreateAuthorRequest
+	63, // 87: blog.v1.BlogService.GetAuthor:input_type -> blog.v1.GetAuthorRequest
+	65, // 88: blog.v1.BlogService.UpdateAuthor:input_type -> blog.v1.UpdateAuthorRequest
+	67, // 89: blog.v1.BlogService.DeleteAuthor:input_type -> blog.v1.DeleteAuthorRequest
+	69, // 90: blog.v1.BlogService.ListAuthors:input_type -> blog.v1.ListAuthorsRequest
+	72, // 91: blog.v1.BlogService.ListTags:input_type -> blog.v1.ListTagsRequest
+	74, // 92: blog.v1.BlogService.RenameTag:input_type -> blog.v1.RenameTagRequest
+	76, // 93: blog.v1.BlogService.MergeTags:input_type -> blog.v1.MergeTagsRequest
+	84, // 94: blog.v1.BlogService.IssueApiKey:input_type -> blog.v1.IssueApiKeyRequest
+	86, // 95: blog.v1.BlogService.ListApiKeys:input_type -> blog.v1.ListApiKeysRequest
+	88, // 96: blog.v1.BlogService.RevokeApiKey:input_type -> blog.v1.RevokeApiKeyRequest
+	9,  // 97: blog.v1.BlogService.CreatePost:output_type -> blog.v1.CreatePostResponse
+	11, // 98: blog.v1.BlogService.GetPost:output_type -> blog.v1.GetPostResponse
+	13, // 99: blog.v1.BlogService.GetPostBySlug:output_type -> blog.v1.GetPostBySlugResponse
+	15, // 100: blog.v1.BlogService.UpdatePost:output_type -> blog.v1.UpdatePostResponse
+	17, // 101: blog.v1.BlogService.DeletePost:output_type -> blog.v1.DeletePostResponse
+	19, // 102: blog.v1.BlogService.RestorePost:output_type -> blog.v1.RestorePostResponse
+	21, // 103: blog.v1.BlogService.PurgePost:output_type -> blog.v1.PurgePostResponse
+	23, // 104: blog.v1.BlogService.ListPosts:output_type -> blog.v1.ListPostsResponse
+	79, // 105: blog.v1.BlogService.WatchPosts:output_type -> blog.v1.PostEvent
+	82, // 106: blog.v1.BlogService.SearchPosts:output_type -> blog.v1.SearchPostsResponse
+	25, // 107: blog.v1.BlogService.PublishPost:output_type -> blog.v1.PublishPostResponse
+	27, // 108: blog.v1.BlogService.UnpublishPost:output_type -> blog.v1.UnpublishPostResponse
+	29, // 109: blog.v1.BlogService.ArchivePost:output_type -> blog.v1.ArchivePostResponse
+	31, // 110: blog.v1.BlogService.SchedulePost:output_type -> blog.v1.SchedulePostResponse
+	33, // 111: blog.v1.BlogService.ListPendingPosts:output_type -> blog.v1.ListPendingPostsResponse
+	35, // 112: blog.v1.BlogService.ApprovePost:output_type -> blog.v1.ApprovePostResponse
+	37, // 113: blog.v1.BlogService.RejectPost:output_type -> blog.v1.RejectPostResponse
+	40, // 114: blog.v1.BlogService.ListPostRevisions:output_type -> blog.v1.ListPostRevisionsResponse
+	42, // 115: blog.v1.BlogService.GetPostRevision:output_type -> blog.v1.GetPostRevisionResponse
+	44, // 116: blog.v1.BlogService.RestorePostRevision:output_type -> blog.v1.RestorePostRevisionResponse
+	50, // 117: blog.v1.BlogService.DiffPostRevisions:output_type -> blog.v1.DiffPostRevisionsResponse
+	53, // 118: blog.v1.BlogService.AddComment:output_type -> blog.v1.AddCommentResponse
+	55, // 119: blog.v1.BlogService.ListComments:output_type -> blog.v1.ListCommentsResponse
+	57, // 120: blog.v1.BlogService.EditComment:output_type -> blog.v1.EditCommentResponse
+	59, // 121: blog.v1.BlogService.DeleteComment:output_type -> blog.v1.DeleteCommentResponse
+	62, // 122: blog.v1.BlogService.CreateAuthor:output_type -> blog.v1.CreateAuthorResponse
+	64, // 123: blog.v1.BlogService.GetAuthor:output_type -> blog.v1.GetAuthorResponse
+	66, // 124: blog.v1.BlogService.UpdateAuthor:output_type -> blog.v1.UpdateAuthorResponse
+	68, // 125: blog.v1.BlogService.DeleteAuthor:output_type -> blog.v1.DeleteAuthorResponse
+	70, // 126: blog.v1.BlogService.ListAuthors:output_type -> blog.v1.ListAuthorsResponse
+	73, // 127: blog.v1.BlogService.ListTags:output_type -> blog.v1.ListTagsResponse
+	75, // 128: blog.v1.BlogService.RenameTag:output_type -> blog.v1.RenameTagResponse
+	77, // 129: blog.v1.BlogService.MergeTags:output_type -> blog.v1.MergeTagsResponse
+	85, // 130: blog.v1.BlogService.IssueApiKey:output_type -> blog.v1.IssueApiKeyResponse
+	87, // 131: blog.v1.BlogService.ListApiKeys:output_type -> blog.v1.ListApiKeysResponse
+	89, // 132: blog.v1.BlogService.RevokeApiKey:output_type -> blog.v1.RevokeApiKeyResponse
+	97, // [97:133] is the sub-list for method output_type
+	61, // [61:97] is the sub-list for method input_type
+	61, // [61:61] is the sub-list for extension type_name
+	61, // [61:61] is the sub-list for extension extendee
+	0,  // [0:61] is the sub-list for field type_name
 }
 
 func init() { file_proto_blog_v1_blog_proto_init() }
@@ -619,13 +6009,14 @@ func file_proto_blog_v1_blog_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_blog_v1_blog_proto_rawDesc), len(file_proto_blog_v1_blog_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   9,
+			NumEnums:      7,
+			NumMessages:   83,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_proto_blog_v1_blog_proto_goTypes,
 		DependencyIndexes: file_proto_blog_v1_blog_proto_depIdxs,
+		EnumInfos:         file_proto_blog_v1_blog_proto_enumTypes,
 		MessageInfos:      file_proto_blog_v1_blog_proto_msgTypes,
 	}.Build()
 	File_proto_blog_v1_blog_proto = out.File
@@ -20,6 +20,7 @@ echo "$CREATE_OUTPUT"
 
 POST_ID=$(echo "$CREATE_OUTPUT" | grep -o 'post_id:"[^"]*"' | sed 's/post_id:"\(.*\)"/\1/')
 echo "Created Post ID: $POST_ID"
+go run ./cmd/client publish -id "$POST_ID"
 echo ""
 
 sleep 1
@@ -0,0 +1,95 @@
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	blogv1 "github.com/BhaveetKumar/gRPC-server-go/proto/blog/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func withAPIKey(ctx context.Context, key string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, "x-api-key", key)
+}
+
+func TestBlogService_APIKeys(t *testing.T) {
+	client := startAuthServer(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	root := bearer(ctx, mintToken(t, "root", time.Hour, "admin"))
+
+	issued, err := client.IssueApiKey(root, &blogv1.IssueApiKeyRequest{
+		Name:   "nightly import",
+		Owner:  "batch",
+		Scopes: []string{"posts:read", "posts:write"},
+		Ttl:    durationpb.New(time.Hour),
+	})
+	if err != nil {
+		t.Fatalf("issue: %v", err)
+	}
+	if issued.GetRawKey() == "" || issued.GetApiKey().GetExpireTime() == nil {
+		t.Fatalf("expected a raw key that expires, got %+v", issued)
+	}
+	batch := withAPIKey(ctx, issued.GetRawKey())
+
+	// The key acts as its owner, and only as its owner.
+	created, err := client.CreatePost(batch, &blogv1.CreatePostRequest{Title: "imported", Content: "content", Author: "batch"})
+	if err != nil {
+		t.Fatalf("expected the key to write as its owner, got %v", err)
+	}
+	if _, err := client.GetPost(batch, &blogv1.GetPostRequest{PostId: created.GetPost().GetPostId()}); err != nil {
+		t.Fatalf("expected the key to see its owner's draft, got %v", err)
+	}
+	if _, err := client.CreatePost(batch, &blogv1.CreatePostRequest{Title: "forged", Content: "content", Author: "alice"}); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("expected the key to be refused someone else's byline, got %v", err)
+	}
+	if _, err := client.ListApiKeys(batch, &blogv1.ListApiKeysRequest{}); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("expected a key without the admin scope to be refused, got %v", err)
+	}
+
+	readOnly, err := client.IssueApiKey(root, &blogv1.IssueApiKeyRequest{Name: "reports", Owner: "batch", Scopes: []string{"posts:read"}})
+	if err != nil {
+		t.Fatalf("issue: %v", err)
+	}
+	reports := withAPIKey(ctx, readOnly.GetRawKey())
+	if _, err := client.ListPosts(reports, &blogv1.ListPostsRequest{}); err != nil {
+		t.Fatalf("expected a read key to read, got %v", err)
+	}
+	if _, err := client.CreatePost(reports, &blogv1.CreatePostRequest{Title: "t", Content: "c", Author: "batch"}); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("expected a read key to be refused writes, got %v", err)
+	}
+
+	admin, err := client.IssueApiKey(root, &blogv1.IssueApiKeyRequest{Name: "ops", Owner: "ops", Scopes: []string{"admin"}})
+	if err != nil {
+		t.Fatalf("issue: %v", err)
+	}
+	listed, err := client.ListApiKeys(withAPIKey(ctx, admin.GetRawKey()), &blogv1.ListApiKeysRequest{})
+	if err != nil {
+		t.Fatalf("expected an admin key to list keys, got %v", err)
+	}
+	if len(listed.GetApiKeys()) != 3 || listed.GetApiKeys()[0].GetKeyId() != issued.GetApiKey().GetKeyId() {
+		t.Fatalf("expected the three keys oldest first, got %+v", listed.GetApiKeys())
+	}
+	if listed.GetApiKeys()[0].GetLastUsedTime() == nil {
+		t.Fatal("expected the used key to have a last use")
+	}
+
+	revoked, err := client.RevokeApiKey(root, &blogv1.RevokeApiKeyRequest{KeyId: issued.GetApiKey().GetKeyId()})
+	if err != nil || revoked.GetApiKey().GetRevokeTime() == nil {
+		t.Fatalf("revoke: %+v, %v", revoked, err)
+	}
+	if _, err := client.ListPosts(batch, &blogv1.ListPostsRequest{}); status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("expected a revoked key to be refused, got %v", err)
+	}
+	if _, err := client.RevokeApiKey(root, &blogv1.RevokeApiKeyRequest{KeyId: "missing"}); status.Code(err) != codes.NotFound {
+		t.Fatalf("expected not found for an unknown key, got %v", err)
+	}
+	if _, err := client.IssueApiKey(bearer(ctx, mintToken(t, "alice", time.Hour, "editor")), &blogv1.IssueApiKeyRequest{Name: "mine", Owner: "alice", Scopes: []string{"admin"}}); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("expected only admins to issue keys, got %v", err)
+	}
+}
@@ -0,0 +1,151 @@
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/BhaveetKumar/gRPC-server-go/internal/auth"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/authz"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/clock"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/handler"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/logger"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/service"
+	blogv1 "github.com/BhaveetKumar/gRPC-server-go/proto/blog/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+var testSecret = []byte("integration secret")
+
+func mintToken(t *testing.T, subject string, ttl time.Duration, roles ...string) string {
+	t.Helper()
+
+	token, err := auth.Mint(auth.Claims{Subject: subject, ExpiresAt: time.Now().Add(ttl).Unix(), Roles: roles}, testSecret, "")
+	if err != nil {
+		t.Fatalf("mint: %v", err)
+	}
+	return token
+}
+
+func bearer(ctx context.Context, token string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+token)
+}
+
+// startAuthServer starts a server that authenticates with testSecret,
+// giving tokens without roles the author role, or with the API keys it
+// issues, and authorizes with the handler's policies.
+func startAuthServer(t *testing.T) blogv1.BlogServiceClient {
+	t.Helper()
+
+	verifier, err := auth.NewVerifier(clock.Real(), auth.WithHS256Secret(testSecret), auth.WithDefaultRole(auth.RoleAuthor))
+	if err != nil {
+		t.Fatalf("new verifier: %v", err)
+	}
+	baseLogger := logger.New()
+	addr := serveBlog(t, func(h *handler.BlogHandler, keys service.APIKeyService) []grpc.ServerOption {
+		policies := h.Policies()
+		authenticator := auth.NewAuthenticator(verifier, keys, true)
+		return []grpc.ServerOption{
+			grpc.ChainUnaryInterceptor(auth.UnaryServerInterceptor(authenticator, baseLogger), authz.UnaryServerInterceptor(policies, baseLogger)),
+			grpc.ChainStreamInterceptor(auth.StreamServerInterceptor(authenticator, baseLogger), authz.StreamServerInterceptor(policies, baseLogger)),
+		}
+	})
+
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return blogv1.NewBlogServiceClient(conn)
+}
+
+func TestBlogService_Authentication(t *testing.T) {
+	client := startAuthServer(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	alice := bearer(ctx, mintToken(t, "alice", time.Hour))
+
+	draft, err := client.CreatePost(alice, &blogv1.CreatePostRequest{Title: "draft", Content: "content", Author: "alice"})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+
+	// Drafts are only visible to their author, so this shows who the
+	// server thinks is calling.
+	if _, err := client.GetPost(alice, &blogv1.GetPostRequest{PostId: draft.GetPost().GetPostId()}); err != nil {
+		t.Fatalf("expected alice to see her draft, got %v", err)
+	}
+	spoofed := metadata.AppendToOutgoingContext(ctx, "x-author", "alice")
+	if _, err := client.GetPost(spoofed, &blogv1.GetPostRequest{PostId: draft.GetPost().GetPostId()}); status.Code(err) != codes.NotFound {
+		t.Fatalf("expected the author header to be ignored, got %v", err)
+	}
+
+	for name, token := range map[string]string{
+		"expired":      mintToken(t, "alice", -time.Hour),
+		"forged":       mintToken(t, "alice", time.Hour) + "x",
+		"not a bearer": "",
+	} {
+		if _, err := client.ListPosts(bearer(ctx, token), &blogv1.ListPostsRequest{}); status.Code(err) != codes.Unauthenticated {
+			t.Fatalf("%s: expected unauthenticated, got %v", name, err)
+		}
+	}
+
+	stream, err := client.WatchPosts(bearer(ctx, "nonsense"), &blogv1.WatchPostsRequest{})
+	if err == nil {
+		_, err = stream.Recv()
+	}
+	if status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("expected a stream with a bad token to be rejected, got %v", err)
+	}
+}
+
+func TestBlogService_Authorization(t *testing.T) {
+	client := startAuthServer(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	alice := bearer(ctx, mintToken(t, "alice", time.Hour))
+	bob := bearer(ctx, mintToken(t, "bob", time.Hour))
+	editor := bearer(ctx, mintToken(t, "erin", time.Hour, "editor"))
+	reader := bearer(ctx, mintToken(t, "rita", time.Hour, "reader"))
+
+	if _, err := client.CreatePost(ctx, &blogv1.CreatePostRequest{Title: "t", Content: "c", Author: "alice"}); status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("expected anonymous writes to need a token, got %v", err)
+	}
+	if _, err := client.CreatePost(reader, &blogv1.CreatePostRequest{Title: "t", Content: "c", Author: "rita"}); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("expected readers not to write posts, got %v", err)
+	}
+	if _, err := client.CreatePost(bob, &blogv1.CreatePostRequest{Title: "t", Content: "c", Author: "alice"}); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("expected authors not to write as someone else, got %v", err)
+	}
+
+	created, err := client.CreatePost(alice, &blogv1.CreatePostRequest{Title: "t", Content: "c", Author: "alice"})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	id := created.GetPost().GetPostId()
+	if _, err := client.PublishPost(alice, &blogv1.PublishPostRequest{PostId: id}); err != nil {
+		t.Fatalf("publish: %v", err)
+	}
+
+	if _, err := client.UpdatePost(bob, &blogv1.UpdatePostRequest{PostId: id, Title: "mine now", Content: "c", Author: "bob"}); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("expected another author's update to be denied, got %v", err)
+	}
+	if _, err := client.DeletePost(bob, &blogv1.DeletePostRequest{PostId: id}); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("expected another author's delete to be denied, got %v", err)
+	}
+	if _, err := client.UpdatePost(editor, &blogv1.UpdatePostRequest{PostId: id, Title: "edited", Content: "c", Author: "alice"}); err != nil {
+		t.Fatalf("expected an editor to update any post, got %v", err)
+	}
+	if _, err := client.DeletePost(alice, &blogv1.DeletePostRequest{PostId: id}); err != nil {
+		t.Fatalf("expected the author to delete her post, got %v", err)
+	}
+	if _, err := client.ListPosts(ctx, &blogv1.ListPostsRequest{}); err != nil {
+		t.Fatalf("expected anonymous reads to be allowed, got %v", err)
+	}
+}
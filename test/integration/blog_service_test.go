@@ -3,6 +3,7 @@ package integration
 import (
 	"context"
 	"net"
+	"strings"
 	"testing"
 	"time"
 
@@ -12,19 +13,35 @@ import (
 	"github.com/BhaveetKumar/gRPC-server-go/internal/service"
 	blogv1 "github.com/BhaveetKumar/gRPC-server-go/proto/blog/v1"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
-func startTestServer(t *testing.T) (blogv1.BlogServiceClient, func()) {
+// serveBlog starts a blog server on a local port and returns its address.
+// extra, when set, returns options to add for the handler being served and
+// the API keys it issues.
+func serveBlog(t *testing.T, extra func(*handler.BlogHandler, service.APIKeyService) []grpc.ServerOption) string {
 	t.Helper()
 
 	baseLogger := logger.New()
 	repo := memory.NewPostRepository()
-	postService := service.NewPostService(repo)
-	blogHandler := handler.NewBlogHandler(postService, baseLogger)
+	comments := memory.NewCommentRepository()
+	authors := memory.NewAuthorRepository()
+	postService := service.NewPostService(repo, service.WithComments(comments), service.WithAuthors(authors))
+	commentService := service.NewCommentService(comments, postService)
+	authorService := service.NewAuthorService(authors, postService)
+	apiKeyService := service.NewAPIKeyService(memory.NewAPIKeyRepository())
+	blogHandler := handler.NewBlogHandler(postService, commentService, authorService, apiKeyService, baseLogger)
 
-	grpcServer := grpc.NewServer(
+	opts := []grpc.ServerOption{
 		grpc.UnaryInterceptor(logger.UnaryServerInterceptor(baseLogger)),
-	)
+		grpc.StreamInterceptor(logger.StreamServerInterceptor(baseLogger)),
+	}
+	if extra != nil {
+		opts = append(opts, extra(blogHandler, apiKeyService)...)
+	}
+	grpcServer := grpc.NewServer(opts...)
 	blogv1.RegisterBlogServiceServer(grpcServer, blogHandler)
 
 	lis, err := net.Listen("tcp", "127.0.0.1:0")
@@ -35,11 +52,20 @@ func startTestServer(t *testing.T) (blogv1.BlogServiceClient, func()) {
 	go func() {
 		_ = grpcServer.Serve(lis)
 	}()
-
-	conn, err := grpc.Dial(lis.Addr().String(), grpc.WithInsecure(), grpc.WithBlock(), grpc.WithTimeout(5*time.Second))
-	if err != nil {
+	t.Cleanup(func() {
 		grpcServer.Stop()
 		lis.Close()
+	})
+
+	return lis.Addr().String()
+}
+
+func startTestServer(t *testing.T) (blogv1.BlogServiceClient, func()) {
+	t.Helper()
+
+	addr := serveBlog(t, nil)
+	conn, err := grpc.Dial(addr, grpc.WithInsecure(), grpc.WithBlock(), grpc.WithTimeout(5*time.Second))
+	if err != nil {
 		t.Fatalf("dial: %v", err)
 	}
 
@@ -47,8 +73,6 @@ func startTestServer(t *testing.T) (blogv1.BlogServiceClient, func()) {
 
 	cleanup := func() {
 		conn.Close()
-		grpcServer.Stop()
-		lis.Close()
 	}
 
 	return client, cleanup
@@ -72,6 +96,21 @@ func TestBlogService_EndToEnd(t *testing.T) {
 
 	id := created.GetPost().GetPostId()
 
+	_, err = client.GetPost(ctx, &blogv1.GetPostRequest{PostId: id})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("expected draft to be hidden from anonymous callers, got %v", err)
+	}
+
+	_, err = client.GetPost(metadata.AppendToOutgoingContext(ctx, "x-author", "author"), &blogv1.GetPostRequest{PostId: id})
+	if err != nil {
+		t.Fatalf("get own draft: %v", err)
+	}
+
+	_, err = client.PublishPost(ctx, &blogv1.PublishPostRequest{PostId: id})
+	if err != nil {
+		t.Fatalf("publish: %v", err)
+	}
+
 	got, err := client.GetPost(ctx, &blogv1.GetPostRequest{PostId: id})
 	if err != nil {
 		t.Fatalf("get: %v", err)
@@ -91,8 +130,136 @@ func TestBlogService_EndToEnd(t *testing.T) {
 		t.Fatalf("update: %v", err)
 	}
 
+	listed, err := client.ListPosts(ctx, &blogv1.ListPostsRequest{Author: "author"})
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+
+	if len(listed.GetPosts()) != 1 || listed.GetPosts()[0].GetPostId() != id {
+		t.Fatalf("unexpected list result: %v", listed.GetPosts())
+	}
+
+	found, err := client.SearchPosts(ctx, &blogv1.SearchPostsRequest{Query: "new"})
+	if err != nil {
+		t.Fatalf("search: %v", err)
+	}
+
+	if len(found.GetResults()) != 1 || found.GetResults()[0].GetPost().GetPostId() != id {
+		t.Fatalf("unexpected search result: %v", found.GetResults())
+	}
+
+	_, err = client.DeletePost(ctx, &blogv1.DeletePostRequest{PostId: id})
+	if err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+}
+
+func TestBlogService_CommentThreads(t *testing.T) {
+	client, cleanup := startTestServer(t)
+	defer cleanup()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	created, err := client.CreatePost(ctx, &blogv1.CreatePostRequest{Title: "title", Content: "content", Author: "author"})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	id := created.GetPost().GetPostId()
+	if _, err := client.PublishPost(ctx, &blogv1.PublishPostRequest{PostId: id}); err != nil {
+		t.Fatalf("publish: %v", err)
+	}
+
+	// Build a thread three levels deep, with a second top-level comment.
+	parent := ""
+	for _, content := range []string{"first", "reply", "reply to reply"} {
+		added, err := client.AddComment(ctx, &blogv1.AddCommentRequest{PostId: id, ParentId: parent, Author: "reader", Content: content})
+		if err != nil {
+			t.Fatalf("add comment %q: %v", content, err)
+		}
+		parent = added.GetComment().GetCommentId()
+	}
+	if _, err := client.AddComment(ctx, &blogv1.AddCommentRequest{PostId: id, Author: "reader", Content: "second"}); err != nil {
+		t.Fatalf("add comment: %v", err)
+	}
+
+	var thread []*blogv1.Comment
+	token := ""
+	for {
+		page, err := client.ListComments(ctx, &blogv1.ListCommentsRequest{PostId: id, PageSize: 3, PageToken: token})
+		if err != nil {
+			t.Fatalf("list comments: %v", err)
+		}
+		thread = append(thread, page.GetComments()...)
+		if token = page.GetNextPageToken(); token == "" {
+			break
+		}
+	}
+
+	wantContent := []string{"first", "reply", "reply to reply", "second"}
+	wantDepth := []int32{0, 1, 2, 0}
+	if len(thread) != len(wantContent) {
+		t.Fatalf("expected %d comments, got %v", len(wantContent), thread)
+	}
+	for i, comment := range thread {
+		if comment.GetContent() != wantContent[i] || comment.GetDepth() != wantDepth[i] {
+			t.Fatalf("unexpected comment %d: %v", i, comment)
+		}
+	}
+
+	// Trashing the post hides its comments; purging it removes them.
+	if _, err := client.DeletePost(ctx, &blogv1.DeletePostRequest{PostId: id}); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	_, err = client.ListComments(ctx, &blogv1.ListCommentsRequest{PostId: id})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("expected comments of a trashed post to be hidden, got %v", err)
+	}
+	if _, err := client.PurgePost(ctx, &blogv1.PurgePostRequest{PostId: id}); err != nil {
+		t.Fatalf("purge: %v", err)
+	}
+	_, err = client.EditComment(ctx, &blogv1.EditCommentRequest{CommentId: parent, Content: "edited"})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("expected comments to be purged with their post, got %v", err)
+	}
+}
+
+func TestBlogService_WatchPosts(t *testing.T) {
+	client, cleanup := startTestServer(t)
+	defer cleanup()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	created, err := client.CreatePost(ctx, &blogv1.CreatePostRequest{Title: "title", Content: "content", Author: "author"})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	id := created.GetPost().GetPostId()
+
+	stream, err := client.WatchPosts(metadata.AppendToOutgoingContext(ctx, "x-author", "author"), &blogv1.WatchPostsRequest{ResumeToken: "0"})
+	if err != nil {
+		t.Fatalf("watch: %v", err)
+	}
+
+	event, err := stream.Recv()
+	if err != nil {
+		t.Fatalf("recv: %v", err)
+	}
+	if event.GetType() != blogv1.PostEventType_POST_EVENT_TYPE_CREATED || event.GetPost().GetPostId() != id {
+		t.Fatalf("unexpected event: %v", event)
+	}
+
 	_, err = client.DeletePost(ctx, &blogv1.DeletePostRequest{PostId: id})
 	if err != nil {
 		t.Fatalf("delete: %v", err)
 	}
+
+	event, err = stream.Recv()
+	if err != nil {
+		t.Fatalf("recv: %v", err)
+	}
+	if event.GetType() != blogv1.PostEventType_POST_EVENT_TYPE_DELETED || !strings.HasSuffix(event.GetResumeToken(), ".2") {
+		t.Fatalf("unexpected event: %v", event)
+	}
 }
@@ -0,0 +1,77 @@
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/BhaveetKumar/gRPC-server-go/internal/auth"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/clock"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/handler"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/logger"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/ratelimit"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/service"
+	blogv1 "github.com/BhaveetKumar/gRPC-server-go/proto/blog/v1"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+func TestBlogService_RateLimit(t *testing.T) {
+	verifier, err := auth.NewVerifier(clock.Real(), auth.WithHS256Secret(testSecret), auth.WithDefaultRole(auth.RoleAuthor))
+	if err != nil {
+		t.Fatalf("new verifier: %v", err)
+	}
+	limiter := ratelimit.NewLimiter(ratelimit.Limits{
+		Methods: map[string]ratelimit.Limit{"CreatePost": {Count: 2, Per: time.Hour}},
+	}, clock.Real())
+	baseLogger := logger.New()
+	addr := serveBlog(t, func(h *handler.BlogHandler, keys service.APIKeyService) []grpc.ServerOption {
+		authenticator := auth.NewAuthenticator(verifier, keys, true)
+		return []grpc.ServerOption{
+			grpc.ChainUnaryInterceptor(auth.UnaryServerInterceptor(authenticator, baseLogger), ratelimit.UnaryServerInterceptor(limiter, baseLogger)),
+			grpc.ChainStreamInterceptor(auth.StreamServerInterceptor(authenticator, baseLogger), ratelimit.StreamServerInterceptor(limiter, baseLogger)),
+		}
+	})
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+	defer conn.Close()
+	client := blogv1.NewBlogServiceClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	alice := bearer(ctx, mintToken(t, "alice", time.Hour))
+
+	for i := 0; i < 2; i++ {
+		if _, err := client.CreatePost(alice, &blogv1.CreatePostRequest{Title: "post", Content: "content", Author: "alice"}); err != nil {
+			t.Fatalf("create %d: %v", i+1, err)
+		}
+	}
+	_, err = client.CreatePost(alice, &blogv1.CreatePostRequest{Title: "post", Content: "content", Author: "alice"})
+	st := status.Convert(err)
+	if st.Code() != codes.ResourceExhausted {
+		t.Fatalf("expected resource exhausted, got %v", err)
+	}
+	var retry *errdetails.RetryInfo
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.RetryInfo); ok {
+			retry = info
+		}
+	}
+	if retry == nil || retry.GetRetryDelay().AsDuration() <= 0 || retry.GetRetryDelay().AsDuration() > 30*time.Minute {
+		t.Fatalf("expected a retry info detail, got %v", st.Details())
+	}
+
+	// Limits are per caller and per method.
+	bob := bearer(ctx, mintToken(t, "bob", time.Hour))
+	if _, err := client.CreatePost(bob, &blogv1.CreatePostRequest{Title: "post", Content: "content", Author: "bob"}); err != nil {
+		t.Fatalf("expected another caller to have their own limit, got %v", err)
+	}
+	if _, err := client.ListPosts(alice, &blogv1.ListPostsRequest{}); err != nil {
+		t.Fatalf("expected other methods to be unlimited, got %v", err)
+	}
+}
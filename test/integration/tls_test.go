@@ -0,0 +1,139 @@
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/BhaveetKumar/gRPC-server-go/internal/clock"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/handler"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/service"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/tlsconfig"
+	"github.com/BhaveetKumar/gRPC-server-go/internal/tlsconfig/tlstest"
+	blogv1 "github.com/BhaveetKumar/gRPC-server-go/proto/blog/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// tlsFiles writes a certificate issued by ca for name, and optionally ca
+// itself as the CA bundle, to dir.
+func tlsFiles(t *testing.T, dir string, ca *tlstest.CA, name string, withCA bool) tlsconfig.Files {
+	t.Helper()
+
+	certPEM, keyPEM := ca.Issue(t, name)
+	files := tlsconfig.Files{
+		CertFile: tlstest.WriteFile(t, dir, name+"-cert.pem", certPEM),
+		KeyFile:  tlstest.WriteFile(t, dir, name+"-key.pem", keyPEM),
+	}
+	if withCA {
+		files.CAFile = tlstest.WriteFile(t, dir, name+"-ca.pem", ca.PEM)
+	}
+	return files
+}
+
+func serveTLS(t *testing.T, files tlsconfig.Files, requireClientCert bool) (string, *tlsconfig.Reloader) {
+	t.Helper()
+
+	reloader, err := tlsconfig.NewReloader(files, clock.Real())
+	if err != nil {
+		t.Fatalf("new server reloader: %v", err)
+	}
+	cfg, err := reloader.ServerConfig(requireClientCert)
+	if err != nil {
+		t.Fatalf("server tls config: %v", err)
+	}
+	return serveBlog(t, func(*handler.BlogHandler, service.APIKeyService) []grpc.ServerOption {
+		return []grpc.ServerOption{grpc.Creds(credentials.NewTLS(cfg))}
+	}), reloader
+}
+
+func tlsCredentials(t *testing.T, files tlsconfig.Files) credentials.TransportCredentials {
+	t.Helper()
+
+	reloader, err := tlsconfig.NewReloader(files, clock.Real())
+	if err != nil {
+		t.Fatalf("new client reloader: %v", err)
+	}
+	return credentials.NewTLS(reloader.ClientConfig(""))
+}
+
+// ping makes one call over a new connection with creds.
+func ping(t *testing.T, addr string, creds credentials.TransportCredentials) error {
+	t.Helper()
+
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	_, err = blogv1.NewBlogServiceClient(conn).ListPosts(ctx, &blogv1.ListPostsRequest{})
+	return err
+}
+
+func TestBlogService_TLS(t *testing.T) {
+	dir := t.TempDir()
+	ca := tlstest.NewCA(t, "blog ca")
+	addr, _ := serveTLS(t, tlsFiles(t, dir, ca, "server", false), false)
+
+	if err := ping(t, addr, tlsCredentials(t, tlsconfig.Files{CAFile: tlstest.WriteFile(t, dir, "ca.pem", ca.PEM)})); err != nil {
+		t.Fatalf("expected a client trusting the ca to connect, got %v", err)
+	}
+	if err := ping(t, addr, insecure.NewCredentials()); err == nil {
+		t.Fatal("expected a plaintext client to be refused")
+	}
+
+	other := tlstest.NewCA(t, "other ca")
+	if err := ping(t, addr, tlsCredentials(t, tlsconfig.Files{CAFile: tlstest.WriteFile(t, dir, "other.pem", other.PEM)})); err == nil {
+		t.Fatal("expected a client trusting another ca to refuse the server")
+	}
+}
+
+func TestBlogService_MutualTLS(t *testing.T) {
+	dir := t.TempDir()
+	ca := tlstest.NewCA(t, "blog ca")
+	addr, _ := serveTLS(t, tlsFiles(t, dir, ca, "server", true), true)
+	caFile := tlstest.WriteFile(t, dir, "ca.pem", ca.PEM)
+
+	client := tlsFiles(t, dir, ca, "client", false)
+	client.CAFile = caFile
+	if err := ping(t, addr, tlsCredentials(t, client)); err != nil {
+		t.Fatalf("expected a client with a certificate to connect, got %v", err)
+	}
+
+	if err := ping(t, addr, tlsCredentials(t, tlsconfig.Files{CAFile: caFile})); err == nil {
+		t.Fatal("expected a client without a certificate to be refused")
+	}
+
+	stranger := tlsFiles(t, dir, tlstest.NewCA(t, "other ca"), "stranger", false)
+	stranger.CAFile = caFile
+	if err := ping(t, addr, tlsCredentials(t, stranger)); err == nil {
+		t.Fatal("expected a client certificate from another ca to be refused")
+	}
+}
+
+func TestBlogService_TLSRotation(t *testing.T) {
+	dir := t.TempDir()
+	oldCA, newCA := tlstest.NewCA(t, "old ca"), tlstest.NewCA(t, "new ca")
+	server := tlsFiles(t, dir, oldCA, "server", false)
+	addr, reloader := serveTLS(t, server, false)
+
+	trustsNew := tlsCredentials(t, tlsconfig.Files{CAFile: tlstest.WriteFile(t, dir, "new-ca.pem", newCA.PEM)})
+	if err := ping(t, addr, trustsNew); err == nil {
+		t.Fatal("expected the old server certificate to be refused")
+	}
+
+	certPEM, keyPEM := newCA.Issue(t, "server")
+	tlstest.WriteFile(t, dir, "server-cert.pem", certPEM)
+	tlstest.WriteFile(t, dir, "server-key.pem", keyPEM)
+	if err := reloader.Reload(); err != nil {
+		t.Fatalf("reload failed: %v", err)
+	}
+
+	if err := ping(t, addr, trustsNew); err != nil {
+		t.Fatalf("expected the rotated certificate to be served without a restart, got %v", err)
+	}
+}